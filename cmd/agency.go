@@ -0,0 +1,224 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	debugCli "github.com/arangodb/kube-arangodb/pkg/debug_package/cli"
+	"github.com/arangodb/kube-arangodb/pkg/debug_package/shared"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency/state"
+	"github.com/arangodb/kube-arangodb/pkg/util/cli"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+func init() {
+	cmdOps.AddCommand(cmdAgency)
+
+	cmdAgency.AddCommand(cmdAgencyDump)
+	debugCli.RegisterNamespace(cmdAgencyDump)
+	cmdAgencyDump.Flags().StringVarP(&agencyInput.DeploymentName, ArgDeploymentName, "d", "",
+		"Name of the ArangoDeployment - necessary when more than one deployment exist within one namespace")
+	cmdAgencyDump.Flags().StringVarP(&agencyInput.Output, "output", "o", "agency.json", "Output of the agency dump file. If set to `-` then stdout is used")
+
+	cmdAgency.AddCommand(cmdAgencyAnalyze)
+	cmdAgencyAnalyze.Flags().DurationVar(&agencyInput.StuckJobTimeout, "stuck-job-timeout", time.Hour, "Age after which ToDo and Pending supervision jobs are reported as stuck")
+	cmdAgencyAnalyze.Flags().BoolVar(&agencyInput.JSON, "json", false, "Print the analysis in JSON format")
+}
+
+var agencyInput struct {
+	DeploymentName string
+	Output         string
+
+	StuckJobTimeout time.Duration
+	JSON            bool
+}
+
+var cmdAgency = &cobra.Command{
+	Use:   "agency",
+	Short: "Agency dump operations",
+	RunE:  cli.Usage,
+}
+
+var cmdAgencyDump = &cobra.Command{
+	Use:   "dump",
+	Short: "Capture agency dump",
+	Long:  "Captures the agency dump of the live deployment (via the Operator pod) into the file",
+	RunE:  agencyDumpE,
+}
+
+var cmdAgencyAnalyze = &cobra.Command{
+	Use:   "analyze [file]",
+	Short: "Analyze agency dump",
+	Long:  "Analyzes the agency dump (or agency state) file offline. If file is set to `-` then stdin is used",
+	Args:  cobra.ExactArgs(1),
+	RunE:  agencyAnalyzeE,
+}
+
+func agencyDumpE(cmd *cobra.Command, _ []string) (returnError error) {
+	handler, err := shared.DiscoverExecFunc()
+	if err != nil {
+		return err
+	}
+
+	args := []string{"admin", "agency", "dump"}
+	if agencyInput.DeploymentName != "" {
+		args = append(args, "-d", agencyInput.DeploymentName)
+	}
+
+	data, stderr, err := handler(log.Logger, args...)
+	if err != nil {
+		if msg := strings.TrimSpace(string(stderr)); msg != "" {
+			return errors.Wrapf(err, "Unable to capture agency dump: %s", msg)
+		}
+		return errors.Wrapf(err, "Unable to capture agency dump")
+	}
+
+	if agencyInput.Output == "-" {
+		_, err := cmd.OutOrStdout().Write(data)
+		return err
+	}
+
+	if err := os.WriteFile(agencyInput.Output, data, 0644); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.ErrOrStderr(), "Agency dump saved in %s\n", agencyInput.Output)
+
+	return nil
+}
+
+func agencyAnalyzeE(cmd *cobra.Command, args []string) error {
+	var data []byte
+	var err error
+
+	if args[0] == "-" {
+		data, err = io.ReadAll(cmd.InOrStdin())
+	} else {
+		data, err = os.ReadFile(args[0])
+	}
+	if err != nil {
+		return err
+	}
+
+	root, err := state.ParseDump(data)
+	if err != nil {
+		return err
+	}
+
+	analysis := root.Arango.Analyze(time.Now(), agencyInput.StuckJobTimeout)
+
+	if agencyInput.JSON {
+		out, err := json.MarshalIndent(analysis, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), string(out))
+		return err
+	}
+
+	return renderAgencyAnalysis(cmd.OutOrStdout(), analysis)
+}
+
+func renderAgencyAnalysis(w io.Writer, a state.Analysis) error {
+	var b strings.Builder
+
+	if a.IsHealthy() {
+		b.WriteString("No issues found\n")
+	}
+
+	if a.SupervisionMaintenance {
+		b.WriteString("Supervision maintenance mode is enabled\n")
+	}
+
+	if len(a.MaintenanceServers) > 0 {
+		fmt.Fprintf(&b, "DBServers in maintenance mode (%d): %s\n", len(a.MaintenanceServers), joinServers(a.MaintenanceServers))
+	}
+
+	if len(a.OutOfSyncShards) > 0 {
+		fmt.Fprintf(&b, "Out-of-sync shards (%d):\n", len(a.OutOfSyncShards))
+		for _, s := range a.OutOfSyncShards {
+			fmt.Fprintf(&b, "  %s/%s/%s: planned [%s], current [%s]\n", s.Database, s.Collection, s.Shard, joinServers(s.Planned), joinServers(s.Current))
+		}
+	}
+
+	if len(a.LeaderlessShards) > 0 {
+		fmt.Fprintf(&b, "Leaderless shards (%d):\n", len(a.LeaderlessShards))
+		for _, s := range a.LeaderlessShards {
+			fmt.Fprintf(&b, "  %s/%s/%s: %s\n", s.Database, s.Collection, s.Shard, s.Reason)
+		}
+	}
+
+	if len(a.StuckJobs) > 0 {
+		fmt.Fprintf(&b, "Stuck supervision jobs (%d):\n", len(a.StuckJobs))
+		for _, j := range a.StuckJobs {
+			fmt.Fprintf(&b, "  %s, age %s\n", describeAgencyJob(j), j.Age.Round(time.Second))
+		}
+	}
+
+	if len(a.FailedJobs) > 0 {
+		fmt.Fprintf(&b, "Failed supervision jobs (%d):\n", len(a.FailedJobs))
+		for _, j := range a.FailedJobs {
+			fmt.Fprintf(&b, "  %s\n", describeAgencyJob(j))
+		}
+	}
+
+	if len(a.OverReplicatedCollections) > 0 {
+		fmt.Fprintf(&b, "Collections with replicationFactor exceeding available DBServers (%d):\n", len(a.OverReplicatedCollections))
+		for _, c := range a.OverReplicatedCollections {
+			fmt.Fprintf(&b, "  %s/%s: replicationFactor %d, available DBServers %d\n", c.Database, c.Collection, c.ReplicationFactor, c.DBServers)
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func describeAgencyJob(j state.AnalysisJob) string {
+	r := fmt.Sprintf("%s: %s (%s)", j.ID, j.Type, j.Phase)
+
+	if j.Server != "" {
+		r = fmt.Sprintf("%s on server %s", r, j.Server)
+	}
+
+	if j.Reason != "" {
+		r = fmt.Sprintf("%s: %s", r, j.Reason)
+	}
+
+	return r
+}
+
+func joinServers(servers state.Servers) string {
+	s := make([]string, len(servers))
+	for id, server := range servers {
+		s[id] = string(server)
+	}
+	return strings.Join(s, ", ")
+}
@@ -49,11 +49,14 @@ import (
 	deploymentApi "github.com/arangodb/kube-arangodb/pkg/apis/deployment"
 	shared "github.com/arangodb/kube-arangodb/pkg/apis/shared"
 	"github.com/arangodb/kube-arangodb/pkg/crd"
+	"github.com/arangodb/kube-arangodb/pkg/deployment"
 	agencyConfig "github.com/arangodb/kube-arangodb/pkg/deployment/agency/config"
 	"github.com/arangodb/kube-arangodb/pkg/deployment/features"
 	"github.com/arangodb/kube-arangodb/pkg/deployment/reconcile"
 	"github.com/arangodb/kube-arangodb/pkg/generated/clientset/versioned/scheme"
+	"github.com/arangodb/kube-arangodb/pkg/handlers/backup"
 	"github.com/arangodb/kube-arangodb/pkg/handlers/permission"
+	"github.com/arangodb/kube-arangodb/pkg/handlers/policy"
 	"github.com/arangodb/kube-arangodb/pkg/handlers/scheduler"
 	"github.com/arangodb/kube-arangodb/pkg/logging"
 	"github.com/arangodb/kube-arangodb/pkg/metrics/collector"
@@ -498,6 +501,9 @@ func executeMain(cmd *cobra.Command, args []string) {
 
 			svcConfig := impl.NewConfiguration().With(func(in impl.Configuration) impl.Configuration {
 				in.LivenessProbe = &livenessProbe
+				if cfg.EnableDeployment {
+					in.Deployments = impl.NewDeployments[*deployment.Deployment](o)
+				}
 				return in
 			}).
 				WithReadinessProbe("deployment", cfg.EnableDeployment, &deploymentProbe).
@@ -764,6 +770,9 @@ func buildAdmissionsHooks(client kclient.Client) webhook.Admissions {
 
 	admissions = append(admissions, scheduler.WebhookAdmissions(client)...)
 	admissions = append(admissions, permission.WebhookAdmissions(client)...)
+	admissions = append(admissions, deployment.WebhookAdmissions()...)
+	admissions = append(admissions, backup.WebhookAdmissions()...)
+	admissions = append(admissions, policy.WebhookAdmissions()...)
 
 	return admissions
 }
@@ -0,0 +1,157 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/kms"
+)
+
+type cmdEncryptionKMSInitContainersInputStruct struct {
+	source, target, provider string
+
+	vault struct {
+		address, mountPath, keyName, namespace, tokenPath, caPath string
+	}
+
+	file struct {
+		path string
+	}
+}
+
+var (
+	cmdEncryptionKMSInitContainers = &cobra.Command{
+		Use:  "encryption-kms",
+		RunE: cmdEncryptionKMSInitContainersInput.Run,
+	}
+
+	cmdEncryptionKMSInitContainersInput cmdEncryptionKMSInitContainersInputStruct
+)
+
+func init() {
+	cmdInitContainers.AddCommand(cmdEncryptionKMSInitContainers)
+	f := cmdEncryptionKMSInitContainers.Flags()
+	f.StringVar(&cmdEncryptionKMSInitContainersInput.source, "source", "", "Path to the keyfolder with the wrapped encryption keys")
+	f.StringVar(&cmdEncryptionKMSInitContainersInput.target, "target", "", "Path to the keyfolder where unwrapped encryption keys are stored")
+	f.StringVar(&cmdEncryptionKMSInitContainersInput.provider, "provider", "", "KMS provider, one of: Vault, File")
+	f.StringVar(&cmdEncryptionKMSInitContainersInput.vault.address, "vault.address", "", "Address of the Vault server")
+	f.StringVar(&cmdEncryptionKMSInitContainersInput.vault.mountPath, "vault.mount-path", api.DefaultRocksDBEncryptionKMSVaultMountPath, "Mount path of the Vault Transit secrets engine")
+	f.StringVar(&cmdEncryptionKMSInitContainersInput.vault.keyName, "vault.key-name", "", "Name of the Vault Transit key")
+	f.StringVar(&cmdEncryptionKMSInitContainersInput.vault.namespace, "vault.namespace", "", "Vault Enterprise namespace")
+	f.StringVar(&cmdEncryptionKMSInitContainersInput.vault.tokenPath, "vault.token-path", "", "Path to the file with the Vault token")
+	f.StringVar(&cmdEncryptionKMSInitContainersInput.vault.caPath, "vault.ca-path", "", "Path to the file with the Vault CA certificate")
+	f.StringVar(&cmdEncryptionKMSInitContainersInput.file.path, "file.path", "", "Path to the directory with the key-encryption keys")
+}
+
+func (c *cmdEncryptionKMSInitContainersInputStruct) Run(cmd *cobra.Command, args []string) error {
+	if c.source == "" || c.target == "" {
+		return errors.Errorf("Source and target paths cannot be empty")
+	}
+
+	provider, err := c.newProvider()
+	if err != nil {
+		return err
+	}
+
+	ctx := util.CreateSignalContext(context.Background())
+
+	files, err := os.ReadDir(c.source)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to read keyfolder")
+	}
+
+	count := 0
+
+	for _, f := range files {
+		// Skip the internal files and directories of the Secret volume
+		if f.IsDir() || strings.HasPrefix(f.Name(), ".") {
+			continue
+		}
+
+		wrapped, err := os.ReadFile(filepath.Join(c.source, f.Name()))
+		if err != nil {
+			return errors.Wrapf(err, "Unable to read wrapped key %s", f.Name())
+		}
+
+		key, err := provider.Unwrap(ctx, strings.TrimSpace(string(wrapped)))
+		if err != nil {
+			return errors.Wrapf(err, "Unable to unwrap key %s", f.Name())
+		}
+
+		if sha := fmt.Sprintf("%0x", sha256.Sum256(key)); len(key) != 32 || sha != f.Name() {
+			return errors.Errorf("Unwrapped key %s is not valid", f.Name())
+		}
+
+		if err := os.WriteFile(filepath.Join(c.target, f.Name()), key, 0600); err != nil {
+			return errors.Wrapf(err, "Unable to save key %s", f.Name())
+		}
+
+		count++
+	}
+
+	log.Info().Int("keys", count).Msg("Encryption keys unwrapped")
+
+	return nil
+}
+
+func (c *cmdEncryptionKMSInitContainersInputStruct) newProvider() (kms.Provider, error) {
+	switch api.RocksDBEncryptionKMSProvider(c.provider) {
+	case api.RocksDBEncryptionKMSProviderVault:
+		token, err := os.ReadFile(c.vault.tokenPath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Unable to read Vault token")
+		}
+
+		cfg := kms.VaultConfig{
+			Address:   c.vault.address,
+			MountPath: c.vault.mountPath,
+			KeyName:   c.vault.keyName,
+			Namespace: c.vault.namespace,
+			Token:     strings.TrimSpace(string(token)),
+		}
+
+		if c.vault.caPath != "" {
+			ca, err := os.ReadFile(c.vault.caPath)
+			if err != nil {
+				return nil, errors.Wrapf(err, "Unable to read Vault CA")
+			}
+			cfg.CA = ca
+		}
+
+		return kms.NewVaultProvider(cfg)
+	case api.RocksDBEncryptionKMSProviderFile:
+		return kms.NewFileProviderFromDirectory(c.file.path)
+	default:
+		return nil, errors.Errorf("Unsupported KMS provider: %s", c.provider)
+	}
+}
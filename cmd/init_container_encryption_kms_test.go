@@ -0,0 +1,89 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package cmd
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/kms"
+)
+
+func Test_EncryptionKMSInitContainer(t *testing.T) {
+	kek := make([]byte, 32)
+	_, err := rand.Read(kek)
+	require.NoError(t, err)
+
+	dek := make([]byte, 32)
+	_, err = rand.Read(dek)
+	require.NoError(t, err)
+
+	sha := fmt.Sprintf("%0x", sha256.Sum256(dek))
+
+	provider, err := kms.NewFileProvider(map[string][]byte{"1": kek})
+	require.NoError(t, err)
+
+	wrapped, err := provider.Wrap(context.Background(), dek)
+	require.NoError(t, err)
+
+	newInput := func(t *testing.T, name string) *cmdEncryptionKMSInitContainersInputStruct {
+		var in cmdEncryptionKMSInitContainersInputStruct
+
+		in.source, in.target, in.file.path = t.TempDir(), t.TempDir(), t.TempDir()
+		in.provider = string(api.RocksDBEncryptionKMSProviderFile)
+
+		require.NoError(t, os.WriteFile(filepath.Join(in.file.path, "1"), kek, 0600))
+		require.NoError(t, os.WriteFile(filepath.Join(in.source, name), []byte(wrapped), 0600))
+
+		return &in
+	}
+
+	t.Run("Unwrap", func(t *testing.T) {
+		in := newInput(t, sha)
+
+		require.NoError(t, in.Run(nil, nil))
+
+		data, err := os.ReadFile(filepath.Join(in.target, sha))
+		require.NoError(t, err)
+		require.Equal(t, dek, data)
+	})
+
+	t.Run("Invalid name", func(t *testing.T) {
+		in := newInput(t, "invalid")
+
+		require.Error(t, in.Run(nil, nil))
+	})
+
+	t.Run("Invalid provider", func(t *testing.T) {
+		in := newInput(t, sha)
+		in.provider = "Unknown"
+
+		require.Error(t, in.Run(nil, nil))
+	})
+}
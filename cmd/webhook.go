@@ -27,7 +27,10 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/arangodb/kube-arangodb/pkg/deployment"
+	"github.com/arangodb/kube-arangodb/pkg/handlers/backup"
 	"github.com/arangodb/kube-arangodb/pkg/handlers/permission"
+	"github.com/arangodb/kube-arangodb/pkg/handlers/policy"
 	"github.com/arangodb/kube-arangodb/pkg/handlers/scheduler"
 	"github.com/arangodb/kube-arangodb/pkg/util"
 	utilConstants "github.com/arangodb/kube-arangodb/pkg/util/constants"
@@ -80,6 +83,9 @@ func cmdWebhookCheckE() error {
 
 	admissions = append(admissions, scheduler.WebhookAdmissions(client)...)
 	admissions = append(admissions, permission.WebhookAdmissions(client)...)
+	admissions = append(admissions, deployment.WebhookAdmissions()...)
+	admissions = append(admissions, backup.WebhookAdmissions()...)
+	admissions = append(admissions, policy.WebhookAdmissions()...)
 
 	server, err := webhookServer(ctx, client, admissions...)
 	if err != nil {
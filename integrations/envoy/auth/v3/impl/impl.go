@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2025 ArangoDB GmbH, Cologne, Germany
+// Copyright 2025-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -28,6 +28,7 @@ import (
 	"github.com/arangodb/kube-arangodb/integrations/envoy/auth/v3/impl/pass_mode"
 	"github.com/arangodb/kube-arangodb/integrations/envoy/auth/v3/impl/request_id"
 	"github.com/arangodb/kube-arangodb/integrations/envoy/auth/v3/impl/required"
+	"github.com/arangodb/kube-arangodb/integrations/envoy/auth/v3/impl/user_header"
 	"github.com/arangodb/kube-arangodb/integrations/envoy/auth/v3/impl/users"
 	pbImplEnvoyAuthV3Shared "github.com/arangodb/kube-arangodb/integrations/envoy/auth/v3/shared"
 )
@@ -41,6 +42,7 @@ func Factory() pbImplEnvoyAuthV3Shared.Factory {
 		auth_custom.New,
 		auth_required.New,
 		pass_mode.New,
+		user_header.New,
 		users.New,
 	)
 }
@@ -0,0 +1,64 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package user_header
+
+import (
+	"context"
+	"testing"
+
+	pbEnvoyCoreV3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	pbEnvoyAuthV3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	"github.com/stretchr/testify/require"
+
+	pbImplEnvoyAuthV3Shared "github.com/arangodb/kube-arangodb/integrations/envoy/auth/v3/shared"
+)
+
+func Test_Handle_Authenticated(t *testing.T) {
+	h, ok, err := New(context.Background(), pbImplEnvoyAuthV3Shared.Configuration{})
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	current := &pbImplEnvoyAuthV3Shared.Response{
+		User: &pbImplEnvoyAuthV3Shared.ResponseAuth{User: "root"},
+	}
+
+	require.NoError(t, h.Handle(context.Background(), &pbEnvoyAuthV3.CheckRequest{}, current))
+
+	require.Len(t, current.Headers, 1)
+	require.Equal(t, pbImplEnvoyAuthV3Shared.AuthUsernameHeader, current.Headers[0].GetHeader().GetKey())
+	require.Equal(t, "root", current.Headers[0].GetHeader().GetValue())
+	require.Equal(t, pbEnvoyCoreV3.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD, current.Headers[0].GetAppendAction())
+}
+
+func Test_Handle_NotAuthenticated(t *testing.T) {
+	h, ok, err := New(context.Background(), pbImplEnvoyAuthV3Shared.Configuration{})
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	current := &pbImplEnvoyAuthV3Shared.Response{}
+
+	require.NoError(t, h.Handle(context.Background(), &pbEnvoyAuthV3.CheckRequest{}, current))
+
+	require.Len(t, current.Headers, 1)
+	require.Equal(t, pbImplEnvoyAuthV3Shared.AuthUsernameHeader, current.Headers[0].GetHeader().GetKey())
+	require.Empty(t, current.Headers[0].GetHeader().GetValue())
+	require.False(t, current.Headers[0].GetKeepEmptyValue())
+}
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2025 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -143,6 +143,18 @@ func (i ActionsInput) Internal() map[string]string {
 	return r
 }
 
+func (i ActionsInput) Disruptive() map[string]string {
+	r := map[string]string{}
+
+	for a, spec := range i.Actions {
+		if spec.IsDisruptive {
+			r[a] = "true"
+		}
+	}
+
+	return r
+}
+
 func (i ActionsInput) HighestScopes() map[string]string {
 	r := map[string]string{}
 	for k, a := range i.Scopes() {
@@ -209,6 +221,8 @@ type Action struct {
 
 	IsInternal bool `json:"isInternal"`
 
+	IsDisruptive bool `json:"isDisruptive"`
+
 	Optional bool `json:"optional"`
 
 	Configurable bool `json:"configurable"`
@@ -255,6 +269,7 @@ func RenderActions(root string) error {
 			"scopes":         in.Scopes(),
 			"highestScopes":  in.HighestScopes(),
 			"internal":       in.Internal(),
+			"disruptive":     in.Disruptive(),
 			"timeouts":       in.Timeouts(),
 			"descriptions":   in.Descriptions(),
 			"optionals":      in.Optionals(),
@@ -353,6 +368,7 @@ func RenderActions(root string) error {
 		type actionRow struct {
 			Action      string `table:"Action" table_align:"center"`
 			Internal    string `table:"Internal" table_align:"center"`
+			Disruptive  string `table:"Disruptive" table_align:"center"`
 			Timeout     string `table:"Timeout" table_align:"center"`
 			Optional    string `table:"Optional" table_align:"center"`
 			Edition     string `table:"Edition" table_align:"center"`
@@ -385,12 +401,17 @@ func RenderActions(root string) error {
 			if !a.Optional {
 				opt = "no"
 			}
+			dis := "yes"
+			if !a.IsDisruptive {
+				dis = "no"
+			}
 
 			out.Add(actionRow{
 				Action:      name,
 				Timeout:     v,
 				Description: a.Description,
 				Internal:    int,
+				Disruptive:  dis,
 				Optional:    opt,
 				Edition:     vr,
 			})
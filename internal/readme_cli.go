@@ -78,6 +78,18 @@ func GenerateCLIArangoDBOperatorOpsReadme(root string) error {
 		readmeSections["arangodb_operator_ops_cmd_debug_package"] = section
 	}
 
+	if section, err := GenerateHelpQuoted(cmd.CommandOps(), "agency", "dump"); err != nil {
+		return err
+	} else {
+		readmeSections["arangodb_operator_ops_cmd_agency_dump"] = section
+	}
+
+	if section, err := GenerateHelpQuoted(cmd.CommandOps(), "agency", "analyze"); err != nil {
+		return err
+	} else {
+		readmeSections["arangodb_operator_ops_cmd_agency_analyze"] = section
+	}
+
 	if err := pretty.ReplaceSectionsInFile(path.Join(root, "docs", "cli", "arangodb_operator_ops.md"), readmeSections); err != nil {
 		return err
 	}
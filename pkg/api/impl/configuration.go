@@ -21,6 +21,12 @@
 package impl
 
 import (
+	"context"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency/state"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/reconcile"
 	"github.com/arangodb/kube-arangodb/pkg/util"
 	"github.com/arangodb/kube-arangodb/pkg/util/probe"
 )
@@ -29,9 +35,63 @@ func NewConfiguration() Configuration {
 	return Configuration{}
 }
 
+// Deployment provides access to the single ArangoDeployment handler
+type Deployment interface {
+	GetName() string
+	GetNamespace() string
+	GetMode() api.DeploymentMode
+	GetSpec() api.DeploymentSpec
+	GetStatus() api.DeploymentStatus
+
+	GetAgencyHealth() (agency.Health, bool)
+	WithAgencyCache(action func(state.State)) bool
+	ShardsInSyncMap() (state.ShardsSyncStatus, bool)
+
+	DryRunPlan(ctx context.Context, spec api.DeploymentSpec) (reconcile.PlanDryRun, error)
+	GetPlanExecutionControl() reconcile.PlanExecutionControl
+	UpdatePlanExecutionControl(ctx context.Context, control reconcile.PlanExecutionControl) error
+}
+
+// Deployments provides access to the ArangoDeployments managed by the Operator
+type Deployments interface {
+	GetDeployment(name string) (Deployment, bool)
+	ListDeployments() []Deployment
+}
+
+// DeploymentsProvider provides access to the ArangoDeployment handlers of the given type
+type DeploymentsProvider[T Deployment] interface {
+	GetDeployment(name string) (T, bool)
+	ListDeployments() []T
+}
+
+// NewDeployments returns Deployments backed by the given provider
+func NewDeployments[T Deployment](in DeploymentsProvider[T]) Deployments {
+	return deployments[T]{in: in}
+}
+
+type deployments[T Deployment] struct {
+	in DeploymentsProvider[T]
+}
+
+func (d deployments[T]) GetDeployment(name string) (Deployment, bool) {
+	depl, ok := d.in.GetDeployment(name)
+	if !ok {
+		return nil, false
+	}
+
+	return depl, true
+}
+
+func (d deployments[T]) ListDeployments() []Deployment {
+	return util.FormatList(d.in.ListDeployments(), func(a T) Deployment {
+		return a
+	})
+}
+
 type Configuration struct {
 	LivenessProbe   *probe.LivenessProbe
 	ReadinessProbes map[string]*probe.ReadyProbe
+	Deployments     Deployments
 }
 
 func (c Configuration) With(mods ...util.ModR[Configuration]) Configuration {
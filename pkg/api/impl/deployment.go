@@ -0,0 +1,106 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package impl
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sigs.k8s.io/yaml"
+
+	pb "github.com/arangodb/kube-arangodb/pkg/api/server"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/reconcile"
+	"github.com/arangodb/kube-arangodb/pkg/util/svc/authenticator"
+)
+
+func (i *implementation) DeploymentPlanDryRun(ctx context.Context, req *pb.DeploymentPlanDryRunRequest) (*pb.DeploymentPlanDryRunResponse, error) {
+	depl, err := i.getDeployment(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	var spec api.DeploymentSpec
+	if err := yaml.Unmarshal(req.GetSpec(), &spec); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to parse spec: %s", err.Error())
+	}
+
+	result, err := depl.DryRunPlan(ctx, spec)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Unable to generate plan: %s", err.Error())
+	}
+
+	var resp pb.DeploymentPlanDryRunResponse
+
+	resp.High = planToGRPC(result.High)
+	resp.Resources = planToGRPC(result.Resources)
+	resp.Normal = planToGRPC(result.Normal)
+
+	for _, m := range result.Members {
+		resp.Members = append(resp.Members, &pb.DeploymentMemberRotation{
+			Group:  m.Group.AsRole(),
+			Member: m.ID,
+			Mode:   pb.DeploymentMemberRotationMode(m.Mode),
+			Reason: m.Reason,
+		})
+	}
+
+	return &resp, nil
+}
+
+func (i *implementation) getDeployment(ctx context.Context, name string) (Deployment, error) {
+	if auth := authenticator.GetIdentity(ctx); auth == nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	if i.cfg.Deployments == nil {
+		return nil, status.Error(codes.Unavailable, "Deployment operator is not enabled")
+	}
+
+	depl, ok := i.cfg.Deployments.GetDeployment(name)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "ArangoDeployment %s not found", name)
+	}
+
+	return depl, nil
+}
+
+func planToGRPC(plan api.Plan) []*pb.DeploymentPlanAction {
+	if len(plan) == 0 {
+		return nil
+	}
+
+	r := make([]*pb.DeploymentPlanAction, len(plan))
+
+	for id, a := range plan {
+		r[id] = &pb.DeploymentPlanAction{
+			Type:   a.Type.String(),
+			Group:  a.Group.AsRole(),
+			Member: a.MemberID,
+			Reason: a.Reason,
+			Id:     a.ID,
+			Held:   reconcile.IsPlanDryRunActionHeld(a),
+		}
+	}
+
+	return r
+}
@@ -0,0 +1,252 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package impl
+
+import (
+	"context"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pbSharedV1 "github.com/arangodb/kube-arangodb/integrations/shared/v1/definition"
+	pb "github.com/arangodb/kube-arangodb/pkg/api/server"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency/state"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/svc/authenticator"
+)
+
+func (i *implementation) ListDeployments(ctx context.Context, _ *pbSharedV1.Empty) (*pb.DeploymentList, error) {
+	if auth := authenticator.GetIdentity(ctx); auth == nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	if i.cfg.Deployments == nil {
+		return nil, status.Error(codes.Unavailable, "Deployment operator is not enabled")
+	}
+
+	var resp pb.DeploymentList
+
+	for _, depl := range i.cfg.Deployments.ListDeployments() {
+		s := depl.GetStatus()
+
+		summary := &pb.DeploymentSummary{
+			Name:        depl.GetName(),
+			Namespace:   depl.GetNamespace(),
+			Mode:        string(depl.GetMode()),
+			Phase:       string(s.Phase),
+			Ready:       s.Conditions.IsTrue(api.ConditionTypeReady),
+			Members:     int32(len(s.Members.AsList())),
+			PlanActions: int32(len(s.HighPriorityPlan) + len(s.ResourcesPlan) + len(s.Plan)),
+		}
+
+		if img := s.CurrentImage; img != nil {
+			summary.Image = img.Image
+			summary.Version = string(img.ArangoDBVersion)
+		}
+
+		resp.Deployments = append(resp.Deployments, summary)
+	}
+
+	return &resp, nil
+}
+
+func (i *implementation) DeploymentMembers(ctx context.Context, req *pb.DeploymentRequest) (*pb.DeploymentMemberList, error) {
+	depl, err := i.getDeployment(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	var resp pb.DeploymentMemberList
+
+	for _, m := range depl.GetStatus().Members.AsList() {
+		member := &pb.DeploymentMember{
+			Group:     m.Group.AsRole(),
+			Id:        m.Member.ID,
+			Phase:     m.Member.Phase.String(),
+			Pod:       m.Member.Pod.GetName(),
+			Version:   string(m.Member.ArangoVersion),
+			CreatedAt: timestamppb.New(m.Member.CreatedAt.Time),
+		}
+
+		if img := m.Member.Image; img != nil {
+			member.Image = img.Image
+		}
+
+		for _, c := range m.Member.Conditions {
+			member.Conditions = append(member.Conditions, &pb.DeploymentCondition{
+				Type:               string(c.Type),
+				Status:             string(c.Status),
+				Reason:             c.Reason,
+				Message:            c.Message,
+				LastTransitionTime: timestamppb.New(c.LastTransitionTime.Time),
+			})
+		}
+
+		resp.Members = append(resp.Members, member)
+	}
+
+	return &resp, nil
+}
+
+func (i *implementation) DeploymentAgencyHealth(ctx context.Context, req *pb.DeploymentRequest) (*pb.DeploymentAgencyHealth, error) {
+	depl, err := i.getDeployment(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	health, ok := depl.GetAgencyHealth()
+	if !ok {
+		return nil, status.Error(codes.Unavailable, "Agency health is not available")
+	}
+
+	resp := pb.DeploymentAgencyHealth{
+		Leader: health.LeaderID(),
+	}
+
+	if err := health.Serving(); err != nil {
+		resp.ServingError = util.NewType(err.Error())
+	} else {
+		resp.Serving = true
+	}
+
+	if err := health.Healthy(); err != nil {
+		resp.HealthyError = util.NewType(err.Error())
+	} else {
+		resp.Healthy = true
+	}
+
+	for _, a := range health.Agents() {
+		if a.ID == resp.Leader {
+			resp.CommitIndex = a.CommitIndex
+		}
+
+		resp.Agents = append(resp.Agents, &pb.DeploymentAgent{
+			Id:          a.ID,
+			Serving:     a.Serving,
+			Leader:      a.ID == resp.Leader,
+			CommitIndex: a.CommitIndex,
+		})
+	}
+
+	return &resp, nil
+}
+
+func (i *implementation) DeploymentShards(ctx context.Context, req *pb.DeploymentShardsRequest) (*pb.DeploymentShardList, error) {
+	depl, err := i.getDeployment(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	inSync, _ := depl.ShardsInSyncMap()
+
+	var resp pb.DeploymentShardList
+
+	if !depl.WithAgencyCache(func(s state.State) {
+		for db, collections := range s.Plan.Collections {
+			for colID, col := range collections {
+				for shard, planned := range col.Shards {
+					current := s.Current.Collections[db][colID][shard].Servers
+					synced := s.IsShardInSync(db, colID, shard, planned)
+
+					resp.Total++
+					if !synced {
+						resp.OutOfSync++
+					}
+
+					if d := req.Database; d != nil && *d != db {
+						continue
+					}
+
+					if req.GetOutOfSync() && synced {
+						continue
+					}
+
+					item := &pb.DeploymentShard{
+						Database:   db,
+						Collection: col.GetName(colID),
+						Shard:      shard,
+						Planned:    serversToStrings(planned),
+						Current:    serversToStrings(current),
+						InSync:     synced,
+					}
+
+					if t, ok := inSync[shard]; ok && !t.IsZero() {
+						item.LastInSync = timestamppb.New(t)
+					}
+
+					resp.Shards = append(resp.Shards, item)
+				}
+			}
+		}
+	}) {
+		return nil, status.Error(codes.Unavailable, "Agency cache is not available")
+	}
+
+	sort.Slice(resp.Shards, func(i, j int) bool {
+		a, b := resp.Shards[i], resp.Shards[j]
+		if a.Database != b.Database {
+			return a.Database < b.Database
+		}
+		if a.Collection != b.Collection {
+			return a.Collection < b.Collection
+		}
+		return a.Shard < b.Shard
+	})
+
+	return &resp, nil
+}
+
+func (i *implementation) DeploymentRebalancer(ctx context.Context, req *pb.DeploymentRequest) (*pb.DeploymentRebalancer, error) {
+	depl, err := i.getDeployment(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	spec := depl.GetSpec().Rebalancer
+
+	resp := pb.DeploymentRebalancer{
+		Enabled:       spec.IsEnabled(),
+		ParallelMoves: int32(spec.GetParallelMoves()),
+	}
+
+	if s := depl.GetStatus().Rebalancer; s != nil {
+		if t := s.LastCheckTime; t != nil {
+			resp.LastCheckTime = timestamppb.New(t.Time)
+		}
+
+		resp.MoveJobs = s.MoveJobs
+	}
+
+	return &resp, nil
+}
+
+func serversToStrings(in state.Servers) []string {
+	if len(in) == 0 {
+		return nil
+	}
+
+	return util.FormatList(in, func(a state.Server) string {
+		return string(a)
+	})
+}
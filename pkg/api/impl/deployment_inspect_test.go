@@ -0,0 +1,432 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	goHttp "net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	pbSharedV1 "github.com/arangodb/kube-arangodb/integrations/shared/v1/definition"
+	"github.com/arangodb/kube-arangodb/pkg/api/server"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency/state"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/reconcile"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/metrics"
+	"github.com/arangodb/kube-arangodb/pkg/util/tests/tgrpc"
+)
+
+const testDeploymentAgencyState = `{
+  "Plan": {
+    "Collections": {
+      "_system": {
+        "101": {"name": "users", "shards": {"s102": ["PRMR-1", "PRMR-2"]}}
+      },
+      "db": {
+        "201": {"name": "orders", "shards": {"s202": ["PRMR-2", "PRMR-1"], "s203": ["PRMR-1"]}}
+      }
+    }
+  },
+  "Current": {
+    "Collections": {
+      "_system": {
+        "101": {"s102": {"servers": ["PRMR-1", "PRMR-2"]}}
+      },
+      "db": {
+        "201": {"s202": {"servers": ["PRMR-2"]}, "s203": {"servers": ["PRMR-1"]}}
+      }
+    }
+  }
+}`
+
+type testDeploymentHealth struct {
+	leader  string
+	agents  []agency.HealthAgent
+	healthy error
+}
+
+func (h testDeploymentHealth) Healthy() error {
+	return h.healthy
+}
+
+func (h testDeploymentHealth) Serving() error {
+	return nil
+}
+
+func (h testDeploymentHealth) LeaderID() string {
+	return h.leader
+}
+
+func (h testDeploymentHealth) Agents() []agency.HealthAgent {
+	return h.agents
+}
+
+func (h testDeploymentHealth) CollectMetrics(m metrics.PushMetric) {}
+
+type testDeployment struct {
+	name   string
+	spec   api.DeploymentSpec
+	status api.DeploymentStatus
+
+	health  agency.Health
+	state   *state.State
+	inSync  state.ShardsSyncStatus
+	control reconcile.PlanExecutionControl
+}
+
+func (d *testDeployment) GetName() string {
+	return d.name
+}
+
+func (d *testDeployment) GetNamespace() string {
+	return "default"
+}
+
+func (d *testDeployment) GetMode() api.DeploymentMode {
+	return d.spec.GetMode()
+}
+
+func (d *testDeployment) GetSpec() api.DeploymentSpec {
+	return d.spec
+}
+
+func (d *testDeployment) GetStatus() api.DeploymentStatus {
+	return *d.status.DeepCopy()
+}
+
+func (d *testDeployment) GetAgencyHealth() (agency.Health, bool) {
+	return d.health, d.health != nil
+}
+
+func (d *testDeployment) WithAgencyCache(action func(state.State)) bool {
+	if d.state == nil {
+		return false
+	}
+
+	action(*d.state)
+	return true
+}
+
+func (d *testDeployment) ShardsInSyncMap() (state.ShardsSyncStatus, bool) {
+	return d.inSync, d.inSync != nil
+}
+
+func (d *testDeployment) DryRunPlan(ctx context.Context, spec api.DeploymentSpec) (reconcile.PlanDryRun, error) {
+	return reconcile.PlanDryRun{}, errors.Errorf("not supported")
+}
+
+func (d *testDeployment) GetPlanExecutionControl() reconcile.PlanExecutionControl {
+	return d.control
+}
+
+func (d *testDeployment) UpdatePlanExecutionControl(ctx context.Context, control reconcile.PlanExecutionControl) error {
+	d.control = control
+	return nil
+}
+
+type testDeployments map[string]*testDeployment
+
+func (t testDeployments) GetDeployment(name string) (*testDeployment, bool) {
+	d, ok := t[name]
+	return d, ok
+}
+
+func (t testDeployments) ListDeployments() []*testDeployment {
+	r := make([]*testDeployment, 0, len(t))
+	for _, k := range util.SortKeys(t) {
+		r = append(r, t[k])
+	}
+	return r
+}
+
+func newTestDeployment(t *testing.T) *testDeployment {
+	var s state.State
+	require.NoError(t, json.Unmarshal([]byte(testDeploymentAgencyState), &s))
+
+	created := meta.NewTime(time.Date(2026, time.January, 1, 10, 0, 0, 0, time.UTC))
+
+	d := &testDeployment{
+		name: "example",
+		spec: api.DeploymentSpec{
+			Mode: api.NewMode(api.DeploymentModeCluster),
+			Rebalancer: &api.ArangoDeploymentRebalancerSpec{
+				Enabled: util.NewType(true),
+			},
+		},
+		status: api.DeploymentStatus{
+			Phase: api.DeploymentPhaseRunning,
+			CurrentImage: &api.ImageInfo{
+				Image:           "arangodb/enterprise:3.12.4",
+				ArangoDBVersion: "3.12.4",
+			},
+			Plan: api.Plan{
+				{ID: "n1", Type: api.ActionTypeRotateMember, MemberID: "PRMR-1", Group: api.ServerGroupDBServers},
+			},
+			Rebalancer: &api.ArangoDeploymentRebalancerStatus{
+				MoveJobs: []string{"1-1024"},
+			},
+		},
+		health: testDeploymentHealth{
+			leader: "AGNT-2",
+			agents: []agency.HealthAgent{
+				{ID: "AGNT-1", Serving: true, CommitIndex: 10},
+				{ID: "AGNT-2", Serving: true, CommitIndex: 12},
+				{ID: "AGNT-3", Serving: false},
+			},
+			healthy: errors.Errorf("Agent AGNT-3 is not serving"),
+		},
+		state: &s,
+		inSync: state.ShardsSyncStatus{
+			"s102": created.Time,
+		},
+	}
+
+	d.status.Conditions.Update(api.ConditionTypeReady, true, "Ready", "")
+
+	require.NoError(t, d.status.Members.Add(api.MemberStatus{ID: "AGNT-1", Phase: api.MemberPhaseCreated, CreatedAt: created}, api.ServerGroupAgents))
+	require.NoError(t, d.status.Members.Add(api.MemberStatus{ID: "PRMR-1", Phase: api.MemberPhaseCreated, CreatedAt: created, ArangoVersion: "3.12.4",
+		Image: &api.ImageInfo{Image: "arangodb/enterprise:3.12.4"},
+		Conditions: api.ConditionList{
+			{Type: api.ConditionTypeReady, Status: "True", Reason: "Pod Ready", LastTransitionTime: created},
+		},
+	}, api.ServerGroupDBServers))
+	require.NoError(t, d.status.Members.Add(api.MemberStatus{ID: "PRMR-2", Phase: api.MemberPhaseFailed, CreatedAt: created}, api.ServerGroupDBServers))
+
+	return d
+}
+
+func deploymentInspectClient(t *testing.T, ctx context.Context, deployments testDeployments) server.OperatorClient {
+	q := Server(t, ctx, func(in Configuration) Configuration {
+		in.Deployments = NewDeployments[*testDeployment](deployments)
+		return in
+	})
+
+	return tgrpc.NewGRPCClient(t, ctx, server.NewOperatorClient, q.Address())
+}
+
+func Test_DeploymentInspect_Disabled(t *testing.T) {
+	ctx, c := context.WithCancel(t.Context())
+	defer c()
+
+	q := Server(t, ctx)
+
+	get := func(t *testing.T, path string, auth bool) int {
+		req, err := goHttp.NewRequestWithContext(ctx, goHttp.MethodGet, fmt.Sprintf("http://%s%s", q.HTTPAddress(), path), nil)
+		require.NoError(t, err)
+
+		if auth {
+			req.SetBasicAuth("root", "test")
+		}
+
+		resp, err := goHttp.DefaultClient.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+
+		return resp.StatusCode
+	}
+
+	for _, path := range []string{
+		"/deployment",
+		"/deployment/example/members",
+		"/deployment/example/agency/health",
+		"/deployment/example/shards",
+		"/deployment/example/rebalancer",
+	} {
+		t.Run(path, func(t *testing.T) {
+			require.Equal(t, goHttp.StatusUnauthorized, get(t, path, false))
+			require.Equal(t, goHttp.StatusServiceUnavailable, get(t, path, true))
+		})
+	}
+}
+
+func Test_DeploymentInspect_List(t *testing.T) {
+	ctx, c := context.WithCancel(t.Context())
+	defer c()
+
+	client := deploymentInspectClient(t, ctx, testDeployments{"example": newTestDeployment(t)})
+
+	resp, err := client.ListDeployments(AuthenticatedContext(t, "root", "test"), &pbSharedV1.Empty{})
+	require.NoError(t, err)
+	require.Len(t, resp.GetDeployments(), 1)
+
+	d := resp.GetDeployments()[0]
+	require.Equal(t, "example", d.GetName())
+	require.Equal(t, "default", d.GetNamespace())
+	require.Equal(t, string(api.DeploymentModeCluster), d.GetMode())
+	require.Equal(t, string(api.DeploymentPhaseRunning), d.GetPhase())
+	require.True(t, d.GetReady())
+	require.EqualValues(t, 3, d.GetMembers())
+	require.EqualValues(t, 1, d.GetPlanActions())
+	require.Equal(t, "arangodb/enterprise:3.12.4", d.GetImage())
+	require.Equal(t, "3.12.4", d.GetVersion())
+}
+
+func Test_DeploymentInspect_Members(t *testing.T) {
+	ctx, c := context.WithCancel(t.Context())
+	defer c()
+
+	client := deploymentInspectClient(t, ctx, testDeployments{"example": newTestDeployment(t)})
+	actx := AuthenticatedContext(t, "root", "test")
+
+	_, err := client.DeploymentMembers(actx, &server.DeploymentRequest{Name: "unknown"})
+	tgrpc.AsGRPCError(t, err).Code(t, codes.NotFound)
+
+	resp, err := client.DeploymentMembers(actx, &server.DeploymentRequest{Name: "example"})
+	require.NoError(t, err)
+
+	members := map[string]*server.DeploymentMember{}
+	for _, m := range resp.GetMembers() {
+		members[m.GetId()] = m
+	}
+	require.Len(t, members, 3)
+
+	require.Equal(t, api.ServerGroupAgents.AsRole(), members["AGNT-1"].GetGroup())
+
+	m := members["PRMR-1"]
+	require.Equal(t, api.ServerGroupDBServers.AsRole(), m.GetGroup())
+	require.Equal(t, api.MemberPhaseCreated.String(), m.GetPhase())
+	require.Equal(t, "3.12.4", m.GetVersion())
+	require.Equal(t, "arangodb/enterprise:3.12.4", m.GetImage())
+	require.Equal(t, time.Date(2026, time.January, 1, 10, 0, 0, 0, time.UTC), m.GetCreatedAt().AsTime())
+	require.Len(t, m.GetConditions(), 1)
+	require.Equal(t, string(api.ConditionTypeReady), m.GetConditions()[0].GetType())
+	require.Equal(t, "True", m.GetConditions()[0].GetStatus())
+	require.Equal(t, "Pod Ready", m.GetConditions()[0].GetReason())
+
+	require.Equal(t, api.MemberPhaseFailed.String(), members["PRMR-2"].GetPhase())
+	require.Empty(t, members["PRMR-2"].GetConditions())
+}
+
+func Test_DeploymentInspect_AgencyHealth(t *testing.T) {
+	ctx, c := context.WithCancel(t.Context())
+	defer c()
+
+	depl := newTestDeployment(t)
+	noHealth := newTestDeployment(t)
+	noHealth.name = "no-health"
+	noHealth.health = nil
+
+	client := deploymentInspectClient(t, ctx, testDeployments{depl.name: depl, noHealth.name: noHealth})
+	actx := AuthenticatedContext(t, "root", "test")
+
+	_, err := client.DeploymentAgencyHealth(actx, &server.DeploymentRequest{Name: "no-health"})
+	tgrpc.AsGRPCError(t, err).Code(t, codes.Unavailable)
+
+	resp, err := client.DeploymentAgencyHealth(actx, &server.DeploymentRequest{Name: "example"})
+	require.NoError(t, err)
+
+	require.Equal(t, "AGNT-2", resp.GetLeader())
+	require.True(t, resp.GetServing())
+	require.Nil(t, resp.ServingError)
+	require.False(t, resp.GetHealthy())
+	require.Equal(t, "Agent AGNT-3 is not serving", resp.GetHealthyError())
+	require.EqualValues(t, 12, resp.GetCommitIndex())
+
+	require.Len(t, resp.GetAgents(), 3)
+	require.Equal(t, "AGNT-1", resp.GetAgents()[0].GetId())
+	require.False(t, resp.GetAgents()[0].GetLeader())
+	require.True(t, resp.GetAgents()[1].GetLeader())
+	require.EqualValues(t, 12, resp.GetAgents()[1].GetCommitIndex())
+	require.False(t, resp.GetAgents()[2].GetServing())
+}
+
+func Test_DeploymentInspect_Shards(t *testing.T) {
+	ctx, c := context.WithCancel(t.Context())
+	defer c()
+
+	depl := newTestDeployment(t)
+	noCache := newTestDeployment(t)
+	noCache.name = "no-cache"
+	noCache.state = nil
+
+	client := deploymentInspectClient(t, ctx, testDeployments{depl.name: depl, noCache.name: noCache})
+	actx := AuthenticatedContext(t, "root", "test")
+
+	_, err := client.DeploymentShards(actx, &server.DeploymentShardsRequest{Name: "no-cache"})
+	tgrpc.AsGRPCError(t, err).Code(t, codes.Unavailable)
+
+	t.Run("All", func(t *testing.T) {
+		resp, err := client.DeploymentShards(actx, &server.DeploymentShardsRequest{Name: "example"})
+		require.NoError(t, err)
+
+		require.EqualValues(t, 3, resp.GetTotal())
+		require.EqualValues(t, 1, resp.GetOutOfSync())
+		require.Len(t, resp.GetShards(), 3)
+
+		s := resp.GetShards()[0]
+		require.Equal(t, "_system", s.GetDatabase())
+		require.Equal(t, "users", s.GetCollection())
+		require.Equal(t, "s102", s.GetShard())
+		require.Equal(t, []string{"PRMR-1", "PRMR-2"}, s.GetPlanned())
+		require.Equal(t, []string{"PRMR-1", "PRMR-2"}, s.GetCurrent())
+		require.True(t, s.GetInSync())
+		require.Equal(t, time.Date(2026, time.January, 1, 10, 0, 0, 0, time.UTC), s.GetLastInSync().AsTime())
+
+		require.Equal(t, "s202", resp.GetShards()[1].GetShard())
+		require.False(t, resp.GetShards()[1].GetInSync())
+		require.Nil(t, resp.GetShards()[1].GetLastInSync())
+
+		require.Equal(t, "s203", resp.GetShards()[2].GetShard())
+		require.True(t, resp.GetShards()[2].GetInSync())
+	})
+
+	t.Run("Database", func(t *testing.T) {
+		resp, err := client.DeploymentShards(actx, &server.DeploymentShardsRequest{Name: "example", Database: util.NewType("db")})
+		require.NoError(t, err)
+
+		require.EqualValues(t, 3, resp.GetTotal())
+		require.Len(t, resp.GetShards(), 2)
+		require.Equal(t, "orders", resp.GetShards()[0].GetCollection())
+	})
+
+	t.Run("Out of sync", func(t *testing.T) {
+		resp, err := client.DeploymentShards(actx, &server.DeploymentShardsRequest{Name: "example", OutOfSync: true})
+		require.NoError(t, err)
+
+		require.Len(t, resp.GetShards(), 1)
+		require.Equal(t, "s202", resp.GetShards()[0].GetShard())
+		require.Equal(t, []string{"PRMR-2", "PRMR-1"}, resp.GetShards()[0].GetPlanned())
+		require.Equal(t, []string{"PRMR-2"}, resp.GetShards()[0].GetCurrent())
+	})
+}
+
+func Test_DeploymentInspect_Rebalancer(t *testing.T) {
+	ctx, c := context.WithCancel(t.Context())
+	defer c()
+
+	client := deploymentInspectClient(t, ctx, testDeployments{"example": newTestDeployment(t)})
+
+	resp, err := client.DeploymentRebalancer(AuthenticatedContext(t, "root", "test"), &server.DeploymentRequest{Name: "example"})
+	require.NoError(t, err)
+
+	require.True(t, resp.GetEnabled())
+	require.Equal(t, []string{"1-1024"}, resp.GetMoveJobs())
+}
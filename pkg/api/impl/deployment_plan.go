@@ -0,0 +1,201 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package impl
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/arangodb/kube-arangodb/pkg/api/server"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/reconcile"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func (i *implementation) DeploymentPlan(ctx context.Context, req *pb.DeploymentPlanRequest) (*pb.DeploymentPlanStatus, error) {
+	depl, err := i.getDeployment(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	return planStatusToGRPC(depl), nil
+}
+
+func (i *implementation) DeploymentPlanPause(ctx context.Context, req *pb.DeploymentPlanRequest) (*pb.DeploymentPlanStatus, error) {
+	depl, err := i.getDeployment(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	control := depl.GetPlanExecutionControl()
+	control.Paused = true
+
+	if err := depl.UpdatePlanExecutionControl(ctx, control); err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to pause plan: %s", err.Error())
+	}
+
+	return planStatusToGRPC(depl), nil
+}
+
+func (i *implementation) DeploymentPlanResume(ctx context.Context, req *pb.DeploymentPlanRequest) (*pb.DeploymentPlanStatus, error) {
+	depl, err := i.getDeployment(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	control := depl.GetPlanExecutionControl()
+	control.Paused = false
+	control.Step = ""
+
+	if err := depl.UpdatePlanExecutionControl(ctx, control); err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to resume plan: %s", err.Error())
+	}
+
+	return planStatusToGRPC(depl), nil
+}
+
+func (i *implementation) DeploymentPlanSkip(ctx context.Context, req *pb.DeploymentPlanActionRequest) (*pb.DeploymentPlanStatus, error) {
+	depl, err := i.getDeployment(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Action ID is required")
+	}
+
+	action, ok := reconcile.PlanGetAction(depl.GetStatus(), req.GetId())
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "Action %s not found in plan", req.GetId())
+	}
+
+	if action.StartTime != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Action %s is already started", req.GetId())
+	}
+
+	control := depl.GetPlanExecutionControl()
+	control.Skip = req.GetId()
+
+	if err := depl.UpdatePlanExecutionControl(ctx, control); err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to skip action: %s", err.Error())
+	}
+
+	return planStatusToGRPC(depl), nil
+}
+
+func (i *implementation) DeploymentPlanStep(ctx context.Context, req *pb.DeploymentPlanActionRequest) (*pb.DeploymentPlanStatus, error) {
+	depl, err := i.getDeployment(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	control := depl.GetPlanExecutionControl()
+	if !control.Paused {
+		return nil, status.Error(codes.FailedPrecondition, "Plan execution is not paused")
+	}
+
+	deplStatus := depl.GetStatus()
+
+	id := req.GetId()
+	if id == "" {
+		action, ok := reconcile.PlanFirstPendingAction(deplStatus)
+		if !ok {
+			return nil, status.Error(codes.FailedPrecondition, "There are no pending actions")
+		}
+
+		id = action.ID
+	} else if !reconcile.PlanContainsAction(deplStatus, id) {
+		return nil, status.Errorf(codes.NotFound, "Action %s not found in plan", id)
+	}
+
+	control.Step = id
+
+	if err := depl.UpdatePlanExecutionControl(ctx, control); err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to step action: %s", err.Error())
+	}
+
+	return planStatusToGRPC(depl), nil
+}
+
+func (i *implementation) DeploymentPlanHistory(ctx context.Context, req *pb.DeploymentPlanHistoryRequest) (*pb.DeploymentPlanHistory, error) {
+	depl, err := i.getDeployment(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetLimit() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Limit cannot be negative")
+	}
+
+	return &pb.DeploymentPlanHistory{
+		Entries: planHistoryToGRPC(depl.GetStatus().PlanHistory.Last(int(req.GetLimit()))),
+	}, nil
+}
+
+func planHistoryToGRPC(history api.PlanHistory) []*pb.DeploymentPlanHistoryEntry {
+	if len(history) == 0 {
+		return nil
+	}
+
+	r := make([]*pb.DeploymentPlanHistoryEntry, len(history))
+
+	for id, e := range history {
+		r[id] = &pb.DeploymentPlanHistoryEntry{
+			Id:       e.ID,
+			Type:     e.Type.String(),
+			Priority: e.Priority,
+			Group:    e.Group.AsRole(),
+			Member:   e.MemberID,
+			Reason:   e.Reason,
+			Result:   string(e.Result),
+		}
+
+		if e.StartTime != nil {
+			r[id].StartTime = timestamppb.New(e.StartTime.Time)
+		}
+
+		if e.EndTime != nil {
+			r[id].EndTime = timestamppb.New(e.EndTime.Time)
+		}
+
+		if e.Error != "" {
+			r[id].Error = util.NewType(e.Error)
+		}
+	}
+
+	return r
+}
+
+func planStatusToGRPC(depl Deployment) *pb.DeploymentPlanStatus {
+	control := depl.GetPlanExecutionControl()
+	s := depl.GetStatus()
+
+	return &pb.DeploymentPlanStatus{
+		Paused:    control.Paused,
+		Step:      control.Step,
+		High:      planToGRPC(s.HighPriorityPlan),
+		Resources: planToGRPC(s.ResourcesPlan),
+		Normal:    planToGRPC(s.Plan),
+	}
+}
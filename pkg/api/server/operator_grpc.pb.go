@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -46,6 +46,18 @@ const (
 	Operator_OperatorLiveness_FullMethodName         = "/server.Operator/OperatorLiveness"
 	Operator_OperatorReadiness_FullMethodName        = "/server.Operator/OperatorReadiness"
 	Operator_OperatorServiceReadiness_FullMethodName = "/server.Operator/OperatorServiceReadiness"
+	Operator_DeploymentPlanDryRun_FullMethodName     = "/server.Operator/DeploymentPlanDryRun"
+	Operator_DeploymentPlan_FullMethodName           = "/server.Operator/DeploymentPlan"
+	Operator_DeploymentPlanPause_FullMethodName      = "/server.Operator/DeploymentPlanPause"
+	Operator_DeploymentPlanResume_FullMethodName     = "/server.Operator/DeploymentPlanResume"
+	Operator_DeploymentPlanSkip_FullMethodName       = "/server.Operator/DeploymentPlanSkip"
+	Operator_DeploymentPlanStep_FullMethodName       = "/server.Operator/DeploymentPlanStep"
+	Operator_DeploymentPlanHistory_FullMethodName    = "/server.Operator/DeploymentPlanHistory"
+	Operator_ListDeployments_FullMethodName          = "/server.Operator/ListDeployments"
+	Operator_DeploymentMembers_FullMethodName        = "/server.Operator/DeploymentMembers"
+	Operator_DeploymentAgencyHealth_FullMethodName   = "/server.Operator/DeploymentAgencyHealth"
+	Operator_DeploymentShards_FullMethodName         = "/server.Operator/DeploymentShards"
+	Operator_DeploymentRebalancer_FullMethodName     = "/server.Operator/DeploymentRebalancer"
 )
 
 // OperatorClient is the client API for Operator service.
@@ -66,6 +78,30 @@ type OperatorClient interface {
 	OperatorReadiness(ctx context.Context, in *definition.Empty, opts ...grpc.CallOption) (*definition.Empty, error)
 	// Returns health details
 	OperatorServiceReadiness(ctx context.Context, in *OperatorService, opts ...grpc.CallOption) (*definition.Empty, error)
+	// DeploymentPlanDryRun returns the plan which would be generated for the candidate spec of the ArangoDeployment
+	DeploymentPlanDryRun(ctx context.Context, in *DeploymentPlanDryRunRequest, opts ...grpc.CallOption) (*DeploymentPlanDryRunResponse, error)
+	// DeploymentPlan returns the plan execution status of the ArangoDeployment
+	DeploymentPlan(ctx context.Context, in *DeploymentPlanRequest, opts ...grpc.CallOption) (*DeploymentPlanStatus, error)
+	// DeploymentPlanPause pauses the plan execution of the ArangoDeployment
+	DeploymentPlanPause(ctx context.Context, in *DeploymentPlanRequest, opts ...grpc.CallOption) (*DeploymentPlanStatus, error)
+	// DeploymentPlanResume resumes the plan execution of the ArangoDeployment
+	DeploymentPlanResume(ctx context.Context, in *DeploymentPlanRequest, opts ...grpc.CallOption) (*DeploymentPlanStatus, error)
+	// DeploymentPlanSkip removes the action from the plan of the ArangoDeployment
+	DeploymentPlanSkip(ctx context.Context, in *DeploymentPlanActionRequest, opts ...grpc.CallOption) (*DeploymentPlanStatus, error)
+	// DeploymentPlanStep allows the single action to be executed while the plan execution is paused
+	DeploymentPlanStep(ctx context.Context, in *DeploymentPlanActionRequest, opts ...grpc.CallOption) (*DeploymentPlanStatus, error)
+	// DeploymentPlanHistory returns the recently executed actions of the ArangoDeployment, newest first
+	DeploymentPlanHistory(ctx context.Context, in *DeploymentPlanHistoryRequest, opts ...grpc.CallOption) (*DeploymentPlanHistory, error)
+	// ListDeployments returns the ArangoDeployments managed by the Operator
+	ListDeployments(ctx context.Context, in *definition.Empty, opts ...grpc.CallOption) (*DeploymentList, error)
+	// DeploymentMembers returns the members of the ArangoDeployment with their phase and conditions
+	DeploymentMembers(ctx context.Context, in *DeploymentRequest, opts ...grpc.CallOption) (*DeploymentMemberList, error)
+	// DeploymentAgencyHealth returns the agency health summary of the ArangoDeployment
+	DeploymentAgencyHealth(ctx context.Context, in *DeploymentRequest, opts ...grpc.CallOption) (*DeploymentAgencyHealth, error)
+	// DeploymentShards returns the shard sync status of the ArangoDeployment
+	DeploymentShards(ctx context.Context, in *DeploymentShardsRequest, opts ...grpc.CallOption) (*DeploymentShardList, error)
+	// DeploymentRebalancer returns the rebalancer state of the ArangoDeployment
+	DeploymentRebalancer(ctx context.Context, in *DeploymentRequest, opts ...grpc.CallOption) (*DeploymentRebalancer, error)
 }
 
 type operatorClient struct {
@@ -136,6 +172,126 @@ func (c *operatorClient) OperatorServiceReadiness(ctx context.Context, in *Opera
 	return out, nil
 }
 
+func (c *operatorClient) DeploymentPlanDryRun(ctx context.Context, in *DeploymentPlanDryRunRequest, opts ...grpc.CallOption) (*DeploymentPlanDryRunResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeploymentPlanDryRunResponse)
+	err := c.cc.Invoke(ctx, Operator_DeploymentPlanDryRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) DeploymentPlan(ctx context.Context, in *DeploymentPlanRequest, opts ...grpc.CallOption) (*DeploymentPlanStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeploymentPlanStatus)
+	err := c.cc.Invoke(ctx, Operator_DeploymentPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) DeploymentPlanPause(ctx context.Context, in *DeploymentPlanRequest, opts ...grpc.CallOption) (*DeploymentPlanStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeploymentPlanStatus)
+	err := c.cc.Invoke(ctx, Operator_DeploymentPlanPause_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) DeploymentPlanResume(ctx context.Context, in *DeploymentPlanRequest, opts ...grpc.CallOption) (*DeploymentPlanStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeploymentPlanStatus)
+	err := c.cc.Invoke(ctx, Operator_DeploymentPlanResume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) DeploymentPlanSkip(ctx context.Context, in *DeploymentPlanActionRequest, opts ...grpc.CallOption) (*DeploymentPlanStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeploymentPlanStatus)
+	err := c.cc.Invoke(ctx, Operator_DeploymentPlanSkip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) DeploymentPlanStep(ctx context.Context, in *DeploymentPlanActionRequest, opts ...grpc.CallOption) (*DeploymentPlanStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeploymentPlanStatus)
+	err := c.cc.Invoke(ctx, Operator_DeploymentPlanStep_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) DeploymentPlanHistory(ctx context.Context, in *DeploymentPlanHistoryRequest, opts ...grpc.CallOption) (*DeploymentPlanHistory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeploymentPlanHistory)
+	err := c.cc.Invoke(ctx, Operator_DeploymentPlanHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) ListDeployments(ctx context.Context, in *definition.Empty, opts ...grpc.CallOption) (*DeploymentList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeploymentList)
+	err := c.cc.Invoke(ctx, Operator_ListDeployments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) DeploymentMembers(ctx context.Context, in *DeploymentRequest, opts ...grpc.CallOption) (*DeploymentMemberList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeploymentMemberList)
+	err := c.cc.Invoke(ctx, Operator_DeploymentMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) DeploymentAgencyHealth(ctx context.Context, in *DeploymentRequest, opts ...grpc.CallOption) (*DeploymentAgencyHealth, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeploymentAgencyHealth)
+	err := c.cc.Invoke(ctx, Operator_DeploymentAgencyHealth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) DeploymentShards(ctx context.Context, in *DeploymentShardsRequest, opts ...grpc.CallOption) (*DeploymentShardList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeploymentShardList)
+	err := c.cc.Invoke(ctx, Operator_DeploymentShards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) DeploymentRebalancer(ctx context.Context, in *DeploymentRequest, opts ...grpc.CallOption) (*DeploymentRebalancer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeploymentRebalancer)
+	err := c.cc.Invoke(ctx, Operator_DeploymentRebalancer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OperatorServer is the server API for Operator service.
 // All implementations must embed UnimplementedOperatorServer
 // for forward compatibility.
@@ -154,6 +310,30 @@ type OperatorServer interface {
 	OperatorReadiness(context.Context, *definition.Empty) (*definition.Empty, error)
 	// Returns health details
 	OperatorServiceReadiness(context.Context, *OperatorService) (*definition.Empty, error)
+	// DeploymentPlanDryRun returns the plan which would be generated for the candidate spec of the ArangoDeployment
+	DeploymentPlanDryRun(context.Context, *DeploymentPlanDryRunRequest) (*DeploymentPlanDryRunResponse, error)
+	// DeploymentPlan returns the plan execution status of the ArangoDeployment
+	DeploymentPlan(context.Context, *DeploymentPlanRequest) (*DeploymentPlanStatus, error)
+	// DeploymentPlanPause pauses the plan execution of the ArangoDeployment
+	DeploymentPlanPause(context.Context, *DeploymentPlanRequest) (*DeploymentPlanStatus, error)
+	// DeploymentPlanResume resumes the plan execution of the ArangoDeployment
+	DeploymentPlanResume(context.Context, *DeploymentPlanRequest) (*DeploymentPlanStatus, error)
+	// DeploymentPlanSkip removes the action from the plan of the ArangoDeployment
+	DeploymentPlanSkip(context.Context, *DeploymentPlanActionRequest) (*DeploymentPlanStatus, error)
+	// DeploymentPlanStep allows the single action to be executed while the plan execution is paused
+	DeploymentPlanStep(context.Context, *DeploymentPlanActionRequest) (*DeploymentPlanStatus, error)
+	// DeploymentPlanHistory returns the recently executed actions of the ArangoDeployment, newest first
+	DeploymentPlanHistory(context.Context, *DeploymentPlanHistoryRequest) (*DeploymentPlanHistory, error)
+	// ListDeployments returns the ArangoDeployments managed by the Operator
+	ListDeployments(context.Context, *definition.Empty) (*DeploymentList, error)
+	// DeploymentMembers returns the members of the ArangoDeployment with their phase and conditions
+	DeploymentMembers(context.Context, *DeploymentRequest) (*DeploymentMemberList, error)
+	// DeploymentAgencyHealth returns the agency health summary of the ArangoDeployment
+	DeploymentAgencyHealth(context.Context, *DeploymentRequest) (*DeploymentAgencyHealth, error)
+	// DeploymentShards returns the shard sync status of the ArangoDeployment
+	DeploymentShards(context.Context, *DeploymentShardsRequest) (*DeploymentShardList, error)
+	// DeploymentRebalancer returns the rebalancer state of the ArangoDeployment
+	DeploymentRebalancer(context.Context, *DeploymentRequest) (*DeploymentRebalancer, error)
 	mustEmbedUnimplementedOperatorServer()
 }
 
@@ -182,6 +362,42 @@ func (UnimplementedOperatorServer) OperatorReadiness(context.Context, *definitio
 func (UnimplementedOperatorServer) OperatorServiceReadiness(context.Context, *OperatorService) (*definition.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OperatorServiceReadiness not implemented")
 }
+func (UnimplementedOperatorServer) DeploymentPlanDryRun(context.Context, *DeploymentPlanDryRunRequest) (*DeploymentPlanDryRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeploymentPlanDryRun not implemented")
+}
+func (UnimplementedOperatorServer) DeploymentPlan(context.Context, *DeploymentPlanRequest) (*DeploymentPlanStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeploymentPlan not implemented")
+}
+func (UnimplementedOperatorServer) DeploymentPlanPause(context.Context, *DeploymentPlanRequest) (*DeploymentPlanStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeploymentPlanPause not implemented")
+}
+func (UnimplementedOperatorServer) DeploymentPlanResume(context.Context, *DeploymentPlanRequest) (*DeploymentPlanStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeploymentPlanResume not implemented")
+}
+func (UnimplementedOperatorServer) DeploymentPlanSkip(context.Context, *DeploymentPlanActionRequest) (*DeploymentPlanStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeploymentPlanSkip not implemented")
+}
+func (UnimplementedOperatorServer) DeploymentPlanStep(context.Context, *DeploymentPlanActionRequest) (*DeploymentPlanStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeploymentPlanStep not implemented")
+}
+func (UnimplementedOperatorServer) DeploymentPlanHistory(context.Context, *DeploymentPlanHistoryRequest) (*DeploymentPlanHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeploymentPlanHistory not implemented")
+}
+func (UnimplementedOperatorServer) ListDeployments(context.Context, *definition.Empty) (*DeploymentList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeployments not implemented")
+}
+func (UnimplementedOperatorServer) DeploymentMembers(context.Context, *DeploymentRequest) (*DeploymentMemberList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeploymentMembers not implemented")
+}
+func (UnimplementedOperatorServer) DeploymentAgencyHealth(context.Context, *DeploymentRequest) (*DeploymentAgencyHealth, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeploymentAgencyHealth not implemented")
+}
+func (UnimplementedOperatorServer) DeploymentShards(context.Context, *DeploymentShardsRequest) (*DeploymentShardList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeploymentShards not implemented")
+}
+func (UnimplementedOperatorServer) DeploymentRebalancer(context.Context, *DeploymentRequest) (*DeploymentRebalancer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeploymentRebalancer not implemented")
+}
 func (UnimplementedOperatorServer) mustEmbedUnimplementedOperatorServer() {}
 func (UnimplementedOperatorServer) testEmbeddedByValue()                  {}
 
@@ -311,6 +527,222 @@ func _Operator_OperatorServiceReadiness_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Operator_DeploymentPlanDryRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeploymentPlanDryRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).DeploymentPlanDryRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Operator_DeploymentPlanDryRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).DeploymentPlanDryRun(ctx, req.(*DeploymentPlanDryRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_DeploymentPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeploymentPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).DeploymentPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Operator_DeploymentPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).DeploymentPlan(ctx, req.(*DeploymentPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_DeploymentPlanPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeploymentPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).DeploymentPlanPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Operator_DeploymentPlanPause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).DeploymentPlanPause(ctx, req.(*DeploymentPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_DeploymentPlanResume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeploymentPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).DeploymentPlanResume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Operator_DeploymentPlanResume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).DeploymentPlanResume(ctx, req.(*DeploymentPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_DeploymentPlanSkip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeploymentPlanActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).DeploymentPlanSkip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Operator_DeploymentPlanSkip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).DeploymentPlanSkip(ctx, req.(*DeploymentPlanActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_DeploymentPlanStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeploymentPlanActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).DeploymentPlanStep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Operator_DeploymentPlanStep_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).DeploymentPlanStep(ctx, req.(*DeploymentPlanActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_DeploymentPlanHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeploymentPlanHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).DeploymentPlanHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Operator_DeploymentPlanHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).DeploymentPlanHistory(ctx, req.(*DeploymentPlanHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_ListDeployments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(definition.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).ListDeployments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Operator_ListDeployments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).ListDeployments(ctx, req.(*definition.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_DeploymentMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).DeploymentMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Operator_DeploymentMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).DeploymentMembers(ctx, req.(*DeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_DeploymentAgencyHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).DeploymentAgencyHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Operator_DeploymentAgencyHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).DeploymentAgencyHealth(ctx, req.(*DeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_DeploymentShards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeploymentShardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).DeploymentShards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Operator_DeploymentShards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).DeploymentShards(ctx, req.(*DeploymentShardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_DeploymentRebalancer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).DeploymentRebalancer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Operator_DeploymentRebalancer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).DeploymentRebalancer(ctx, req.(*DeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Operator_ServiceDesc is the grpc.ServiceDesc for Operator service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -342,6 +774,54 @@ var Operator_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "OperatorServiceReadiness",
 			Handler:    _Operator_OperatorServiceReadiness_Handler,
 		},
+		{
+			MethodName: "DeploymentPlanDryRun",
+			Handler:    _Operator_DeploymentPlanDryRun_Handler,
+		},
+		{
+			MethodName: "DeploymentPlan",
+			Handler:    _Operator_DeploymentPlan_Handler,
+		},
+		{
+			MethodName: "DeploymentPlanPause",
+			Handler:    _Operator_DeploymentPlanPause_Handler,
+		},
+		{
+			MethodName: "DeploymentPlanResume",
+			Handler:    _Operator_DeploymentPlanResume_Handler,
+		},
+		{
+			MethodName: "DeploymentPlanSkip",
+			Handler:    _Operator_DeploymentPlanSkip_Handler,
+		},
+		{
+			MethodName: "DeploymentPlanStep",
+			Handler:    _Operator_DeploymentPlanStep_Handler,
+		},
+		{
+			MethodName: "DeploymentPlanHistory",
+			Handler:    _Operator_DeploymentPlanHistory_Handler,
+		},
+		{
+			MethodName: "ListDeployments",
+			Handler:    _Operator_ListDeployments_Handler,
+		},
+		{
+			MethodName: "DeploymentMembers",
+			Handler:    _Operator_DeploymentMembers_Handler,
+		},
+		{
+			MethodName: "DeploymentAgencyHealth",
+			Handler:    _Operator_DeploymentAgencyHealth_Handler,
+		},
+		{
+			MethodName: "DeploymentShards",
+			Handler:    _Operator_DeploymentShards_Handler,
+		},
+		{
+			MethodName: "DeploymentRebalancer",
+			Handler:    _Operator_DeploymentRebalancer_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/api/server/operator.proto",
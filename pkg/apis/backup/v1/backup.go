@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2023 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -28,6 +28,9 @@ import (
 
 const (
 	FinalizerArangoBackup = backup.ArangoBackupCRDName + "/cleanup"
+
+	// LabelArangoBackupVerification is set on the resources created for the verification of the ArangoBackup
+	LabelArangoBackupVerification = backup.ArangoBackupCRDName + "/verification"
 )
 
 var (
@@ -69,3 +72,15 @@ func (a *ArangoBackup) GetStatus() ArangoBackupStatus {
 func (a *ArangoBackup) SetStatus(status ArangoBackupStatus) {
 	a.Status = status
 }
+
+// AsOwner creates an OwnerReference for the given backup
+func (a *ArangoBackup) AsOwner() meta.OwnerReference {
+	trueVar := true
+	return meta.OwnerReference{
+		APIVersion: SchemeGroupVersion.String(),
+		Kind:       backup.ArangoBackupResourceKind,
+		Name:       a.Name,
+		UID:        a.UID,
+		Controller: &trueVar,
+	}
+}
@@ -58,11 +58,12 @@ func (a *ArangoBackupPolicy) NewBackup(d *api.ArangoDeployment) *ArangoBackup {
 		Deployment: ArangoBackupSpecDeployment{
 			Name: d.Name,
 		},
-		Upload:     a.Spec.BackupTemplate.Upload.DeepCopy(),
-		Options:    a.Spec.BackupTemplate.Options.DeepCopy(),
-		Backoff:    a.Spec.BackupTemplate.Backoff.DeepCopy(),
-		Lifetime:   a.Spec.BackupTemplate.Lifetime.DeepCopy(),
-		PolicyName: &policyName,
+		Upload:       a.Spec.BackupTemplate.Upload.DeepCopy(),
+		Options:      a.Spec.BackupTemplate.Options.DeepCopy(),
+		Backoff:      a.Spec.BackupTemplate.Backoff.DeepCopy(),
+		Lifetime:     a.Spec.BackupTemplate.Lifetime.DeepCopy(),
+		Verification: a.Spec.BackupTemplate.Verification.DeepCopy(),
+		PolicyName:   &policyName,
 	}
 
 	return &ArangoBackup{
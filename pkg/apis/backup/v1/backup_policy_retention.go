@@ -0,0 +1,164 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	shared "github.com/arangodb/kube-arangodb/pkg/apis/shared"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+type ArangoBackupPolicyRetentionTier string
+
+const (
+	// ArangoBackupPolicyRetentionTierLatest keeps the most recent backups
+	ArangoBackupPolicyRetentionTierLatest ArangoBackupPolicyRetentionTier = "latest"
+	// ArangoBackupPolicyRetentionTierHourly keeps the newest backup of each hour
+	ArangoBackupPolicyRetentionTierHourly ArangoBackupPolicyRetentionTier = "hourly"
+	// ArangoBackupPolicyRetentionTierDaily keeps the newest backup of each day
+	ArangoBackupPolicyRetentionTierDaily ArangoBackupPolicyRetentionTier = "daily"
+	// ArangoBackupPolicyRetentionTierWeekly keeps the newest backup of each ISO week
+	ArangoBackupPolicyRetentionTierWeekly ArangoBackupPolicyRetentionTier = "weekly"
+	// ArangoBackupPolicyRetentionTierMonthly keeps the newest backup of each month
+	ArangoBackupPolicyRetentionTierMonthly ArangoBackupPolicyRetentionTier = "monthly"
+	// ArangoBackupPolicyRetentionTierYearly keeps the newest backup of each year
+	ArangoBackupPolicyRetentionTierYearly ArangoBackupPolicyRetentionTier = "yearly"
+	// ArangoBackupPolicyRetentionTierUploadPending protects backups which are requested to be uploaded, but are not uploaded yet
+	ArangoBackupPolicyRetentionTierUploadPending ArangoBackupPolicyRetentionTier = "upload-pending"
+)
+
+// ArangoBackupPolicyRetention defines the grandfather-father-son retention of the backups created by the policy (per deployment).
+// Backup is kept if it is selected by at least one tier, all other healthy backups are deleted.
+// Time periods are calculated in UTC.
+type ArangoBackupPolicyRetention struct {
+	// Latest defines how many most recent backups are kept
+	// +doc/default: 0
+	Latest *int `json:"latest,omitempty"`
+	// Hourly defines for how many last hours the newest backup of the hour is kept
+	// +doc/default: 0
+	Hourly *int `json:"hourly,omitempty"`
+	// Daily defines for how many last days the newest backup of the day is kept
+	// +doc/default: 0
+	Daily *int `json:"daily,omitempty"`
+	// Weekly defines for how many last ISO weeks the newest backup of the week is kept
+	// +doc/default: 0
+	Weekly *int `json:"weekly,omitempty"`
+	// Monthly defines for how many last months the newest backup of the month is kept
+	// +doc/default: 0
+	Monthly *int `json:"monthly,omitempty"`
+	// Yearly defines for how many last years the newest backup of the year is kept
+	// +doc/default: 0
+	Yearly *int `json:"yearly,omitempty"`
+}
+
+// GetTier returns the number of the periods kept by the tier
+func (a *ArangoBackupPolicyRetention) GetTier(tier ArangoBackupPolicyRetentionTier) int {
+	if a == nil {
+		return 0
+	}
+
+	switch tier {
+	case ArangoBackupPolicyRetentionTierLatest:
+		return util.TypeOrDefault(a.Latest, 0)
+	case ArangoBackupPolicyRetentionTierHourly:
+		return util.TypeOrDefault(a.Hourly, 0)
+	case ArangoBackupPolicyRetentionTierDaily:
+		return util.TypeOrDefault(a.Daily, 0)
+	case ArangoBackupPolicyRetentionTierWeekly:
+		return util.TypeOrDefault(a.Weekly, 0)
+	case ArangoBackupPolicyRetentionTierMonthly:
+		return util.TypeOrDefault(a.Monthly, 0)
+	case ArangoBackupPolicyRetentionTierYearly:
+		return util.TypeOrDefault(a.Yearly, 0)
+	default:
+		return 0
+	}
+}
+
+// IsEnabled returns true if at least one tier keeps the backups
+func (a *ArangoBackupPolicyRetention) IsEnabled() bool {
+	for _, tier := range ArangoBackupPolicyRetentionTiers() {
+		if a.GetTier(tier) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ArangoBackupPolicyRetentionTiers returns the time based retention tiers in the evaluation order
+func ArangoBackupPolicyRetentionTiers() []ArangoBackupPolicyRetentionTier {
+	return []ArangoBackupPolicyRetentionTier{
+		ArangoBackupPolicyRetentionTierLatest,
+		ArangoBackupPolicyRetentionTierHourly,
+		ArangoBackupPolicyRetentionTierDaily,
+		ArangoBackupPolicyRetentionTierWeekly,
+		ArangoBackupPolicyRetentionTierMonthly,
+		ArangoBackupPolicyRetentionTierYearly,
+	}
+}
+
+func (a *ArangoBackupPolicyRetention) Validate() error {
+	if a == nil {
+		return nil
+	}
+
+	var errs []error
+
+	for _, tier := range ArangoBackupPolicyRetentionTiers() {
+		if a.GetTier(tier) < 0 {
+			errs = append(errs, shared.PrefixResourceErrors(string(tier), errors.Errorf("Value needs to be greater or equal to 0")))
+		}
+	}
+
+	if len(errs) > 0 {
+		return shared.WithErrors(errs...)
+	}
+
+	if !a.IsEnabled() {
+		return errors.Errorf("At least one retention tier needs to be defined")
+	}
+
+	return nil
+}
+
+// ArangoBackupPolicyRetentionStatus keeps the result of the last retention run
+type ArangoBackupPolicyRetentionStatus struct {
+	// NextPrune Next time in UTC when the retention is applied
+	// +doc/type: meta.Time
+	NextPrune *meta.Time `json:"nextPrune,omitempty"`
+	// Backups kept by the retention tiers
+	Backups []ArangoBackupPolicyRetentionBackup `json:"backups,omitempty"`
+}
+
+// ArangoBackupPolicyRetentionBackup defines the backup kept by the retention
+type ArangoBackupPolicyRetentionBackup struct {
+	// Name of the ArangoBackup
+	Name string `json:"name"`
+	// Deployment name of the ArangoDeployment
+	Deployment string `json:"deployment"`
+	// Uploaded is true if the backup has been uploaded
+	Uploaded bool `json:"uploaded,omitempty"`
+	// Tiers which keep the backup
+	Tiers []ArangoBackupPolicyRetentionTier `json:"tiers"`
+}
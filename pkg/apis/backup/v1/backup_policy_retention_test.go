@@ -0,0 +1,46 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func Test_ArangoBackupPolicy_Retention_Validate(t *testing.T) {
+	spec := func(maxBackups int, retention *ArangoBackupPolicyRetention) *ArangoBackupPolicySpec {
+		return &ArangoBackupPolicySpec{
+			Schedule:   "*/5 * * * *",
+			MaxBackups: maxBackups,
+			Retention:  retention,
+		}
+	}
+
+	require.NoError(t, spec(0, nil).Validate())
+	require.NoError(t, spec(0, &ArangoBackupPolicyRetention{Hourly: util.NewType(24), Daily: util.NewType(7)}).Validate())
+
+	require.EqualError(t, spec(5, &ArangoBackupPolicyRetention{Hourly: util.NewType(24)}).Validate(), "maxBackups and retention can not be defined together")
+	require.EqualError(t, spec(0, &ArangoBackupPolicyRetention{}).Validate(), "invalid retention: At least one retention tier needs to be defined")
+	require.EqualError(t, spec(0, &ArangoBackupPolicyRetention{Daily: util.NewType(-1), Weekly: util.NewType(4)}).Validate(), "invalid retention: Received 1 errors: daily: Value needs to be greater or equal to 0")
+}
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2025 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -41,6 +41,9 @@ type ArangoBackupPolicySpec struct {
 	// If not specified or 0 then no limit is applied
 	// +doc/default: 0
 	MaxBackups int `json:"maxBackups,omitempty"`
+	// Retention defines the grandfather-father-son retention of the backups (per deployment). Oldest healthy Backups which are not
+	// kept by any of the tiers will be deleted. Can not be used together with MaxBackups
+	Retention *ArangoBackupPolicyRetention `json:"retention,omitempty"`
 	// ArangoBackupTemplate specifies additional options for newly created ArangoBackup
 	BackupTemplate ArangoBackupTemplate `json:"template"`
 }
@@ -59,4 +62,7 @@ type ArangoBackupTemplate struct {
 
 	// Lifetime is the time after which the backup will be deleted. Format: "1.5h" or "2h45m".
 	Lifetime *meta.Duration `json:"lifetime,omitempty"`
+
+	// Verification defines the restore verification of the backup in the temporary deployment
+	Verification *ArangoBackupSpecVerification `json:"verification,omitempty"`
 }
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2023 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -31,4 +31,6 @@ type ArangoBackupPolicyStatus struct {
 	Scheduled meta.Time `json:"scheduled,omitempty"`
 	// Message from the operator in case of failures - schedule not valid, ArangoBackupPolicy not valid
 	Message string `json:"message,omitempty"`
+	// Retention keeps the result of the last retention run
+	Retention *ArangoBackupPolicyRetentionStatus `json:"retention,omitempty"`
 }
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2024 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -43,5 +43,15 @@ func (a *ArangoBackupPolicySpec) Validate() error {
 		return errors.Errorf("invalid schedule format")
 	}
 
+	if a.Retention != nil {
+		if a.MaxBackups > 0 {
+			return errors.Errorf("maxBackups and retention can not be defined together")
+		}
+
+		if err := a.Retention.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid retention")
+		}
+	}
+
 	return nil
 }
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2025 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -20,7 +20,11 @@
 
 package v1
 
-import meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+import (
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	sharedApi "github.com/arangodb/kube-arangodb/pkg/apis/shared/v1"
+)
 
 // ArangoBackupSpec Spec of the ArangoBackup Custom Resource
 type ArangoBackupSpec struct {
@@ -46,6 +50,9 @@ type ArangoBackupSpec struct {
 
 	// Lifetime is the time after which the backup will be deleted. Format: "1.5h" or "2h45m".
 	Lifetime *meta.Duration `json:"lifetime,omitempty"`
+
+	// Verification defines the restore verification of the backup in the temporary deployment
+	Verification *ArangoBackupSpecVerification `json:"verification,omitempty"`
 }
 
 // ArangoBackupSpecDeployment describes the deployment which should have a backup
@@ -75,11 +82,18 @@ type ArangoBackupSpecOperation struct {
 	// +doc/example: azure://test
 	// +doc/immutable: can't be changed after backup creation
 	// +doc/link: rclone.org|https://rclone.org/docs/#syntax-of-remote-paths
-	RepositoryURL string `json:"repositoryURL"`
+	RepositoryURL string `json:"repositoryURL,omitempty"`
 	// CredentialsSecretName is the name of the secret used while accessing repository
 	// +doc/immutable: can't be changed after backup creation
 	// +doc/link: Defining a secret for backup upload or download|../backup-resource.md#defining-a-secret-for-backup-upload-or-download
 	CredentialsSecretName string `json:"credentialsSecretName,omitempty"`
+	// Storage references the ArangoPlatformStorage used as a repository. The repository URL and credentials are generated from the
+	// ArangoPlatformStorage backend and credentials Secret. Mutually exclusive with `repositoryURL` and `credentialsSecretName`.
+	// +doc/immutable: can't be changed after backup creation
+	// +doc/skip: namespace
+	// +doc/skip: uid
+	// +doc/skip: checksum
+	Storage *sharedApi.Object `json:"storage,omitempty"`
 	// AutoDelete removes the ArangoBackup resource (which removes the backup from the cluster) after successful upload
 	// +doc/default: false
 	AutoDelete *bool `json:"autoDelete,omitempty"`
@@ -0,0 +1,85 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"reflect"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+// ResetImmutableFields replaces all immutable fields in the given target with values from the source spec.
+// It returns a list of fields that have been reset.
+func (a ArangoBackupSpec) ResetImmutableFields(target *ArangoBackupSpec) []string {
+	var resetFields []string
+
+	if a.Deployment.Name != target.Deployment.Name {
+		target.Deployment.Name = a.Deployment.Name
+		resetFields = append(resetFields, "deployment.name")
+	}
+
+	if !reflect.DeepEqual(a.Options, target.Options) {
+		target.Options = a.Options.DeepCopy()
+		resetFields = append(resetFields, "options")
+	}
+
+	if !reflect.DeepEqual(a.PolicyName, target.PolicyName) {
+		target.PolicyName = util.NewTypeOrNil[string](a.PolicyName)
+		resetFields = append(resetFields, "policyName")
+	}
+
+	if !reflect.DeepEqual(a.Download, target.Download) {
+		target.Download = a.Download.DeepCopy()
+		resetFields = append(resetFields, "download")
+	}
+
+	// Upload can be removed and defined again, only the change of the existing definition is restricted
+	if a.Upload != nil && target.Upload != nil {
+		if l := a.Upload.ResetImmutableFields("upload", target.Upload); l != nil {
+			resetFields = append(resetFields, l...)
+		}
+	}
+
+	return resetFields
+}
+
+// ResetImmutableFields replaces all immutable fields in the given target with values from the source spec.
+// It returns a list of fields that have been reset.
+func (a ArangoBackupSpecOperation) ResetImmutableFields(fieldPrefix string, target *ArangoBackupSpecOperation) []string {
+	var resetFields []string
+
+	if a.RepositoryURL != target.RepositoryURL {
+		target.RepositoryURL = a.RepositoryURL
+		resetFields = append(resetFields, fieldPrefix+".repositoryURL")
+	}
+
+	if a.CredentialsSecretName != target.CredentialsSecretName {
+		target.CredentialsSecretName = a.CredentialsSecretName
+		resetFields = append(resetFields, fieldPrefix+".credentialsSecretName")
+	}
+
+	if !reflect.DeepEqual(a.Storage, target.Storage) {
+		target.Storage = a.Storage.DeepCopy()
+		resetFields = append(resetFields, fieldPrefix+".storage")
+	}
+
+	return resetFields
+}
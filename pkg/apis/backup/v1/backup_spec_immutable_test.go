@@ -0,0 +1,65 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func Test_ArangoBackupSpec_ResetImmutableFields(t *testing.T) {
+	source := ArangoBackupSpec{
+		Deployment: ArangoBackupSpecDeployment{Name: "deployment"},
+		PolicyName: util.NewType("policy"),
+		Upload: &ArangoBackupSpecOperation{
+			RepositoryURL: "s3://bucket",
+		},
+	}
+
+	t.Run("Unchanged", func(t *testing.T) {
+		target := source.DeepCopy()
+		target.Lifetime = nil
+		require.Empty(t, source.ResetImmutableFields(target))
+	})
+
+	t.Run("Changed", func(t *testing.T) {
+		target := source.DeepCopy()
+		target.Deployment.Name = "other"
+		target.PolicyName = nil
+		target.Upload.RepositoryURL = "s3://other"
+
+		require.Equal(t, []string{"deployment.name", "policyName", "upload.repositoryURL"}, source.ResetImmutableFields(target))
+		require.Equal(t, source, *target)
+	})
+
+	t.Run("Upload removed and defined again", func(t *testing.T) {
+		target := source.DeepCopy()
+		target.Upload = nil
+		require.Empty(t, source.ResetImmutableFields(target))
+
+		noUpload := source.DeepCopy()
+		noUpload.Upload = nil
+		require.Empty(t, noUpload.ResetImmutableFields(source.DeepCopy()))
+	})
+}
@@ -0,0 +1,139 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"time"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	shared "github.com/arangodb/kube-arangodb/pkg/apis/shared"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+const (
+	ArangoBackupSpecVerificationDefaultTimeout  = time.Hour
+	ArangoBackupSpecVerificationDefaultDatabase = "_system"
+)
+
+// ArangoBackupSpecVerification defines the restore verification of the backup.
+// Backup is downloaded from the remote repository and restored into the temporary ArangoDeployment, which is removed after verification.
+type ArangoBackupSpecVerification struct {
+	// Enabled enables the backup verification
+	// +doc/default: false
+	Enabled *bool `json:"enabled,omitempty"`
+	// Timeout of the whole verification process. Format: "1.5h" or "2h45m".
+	// +doc/default: 1h
+	Timeout *meta.Duration `json:"timeout,omitempty"`
+	// Queries defines the AQL sanity queries executed on the restored data. If empty, only the restore is verified.
+	Queries []ArangoBackupSpecVerificationQuery `json:"queries,omitempty"`
+}
+
+// IsEnabled returns true if the verification is enabled
+func (a *ArangoBackupSpecVerification) IsEnabled() bool {
+	if a == nil {
+		return false
+	}
+
+	return util.TypeOrDefault(a.Enabled, false)
+}
+
+// GetTimeout returns the verification timeout
+func (a *ArangoBackupSpecVerification) GetTimeout() time.Duration {
+	if a == nil || a.Timeout == nil {
+		return ArangoBackupSpecVerificationDefaultTimeout
+	}
+
+	return a.Timeout.Duration
+}
+
+func (a *ArangoBackupSpecVerification) Validate() error {
+	if a == nil {
+		return nil
+	}
+
+	var errs []error
+
+	if a.Timeout != nil && a.Timeout.Duration <= 0 {
+		errs = append(errs, shared.PrefixResourceErrors("timeout", errors.Errorf("Timeout needs to be greater than 0")))
+	}
+
+	errs = append(errs, shared.PrefixResourceErrors("queries", shared.ValidateList(a.Queries, func(q ArangoBackupSpecVerificationQuery) error {
+		return q.Validate()
+	}, func(in []ArangoBackupSpecVerificationQuery) error {
+		names := map[string]bool{}
+
+		for _, q := range in {
+			if names[q.Name] {
+				return errors.Errorf("Query name %s is not unique", q.Name)
+			}
+
+			names[q.Name] = true
+		}
+
+		return nil
+	})))
+
+	return shared.WithErrors(errs...)
+}
+
+// ArangoBackupSpecVerificationQuery defines the AQL query executed on the restored data
+type ArangoBackupSpecVerificationQuery struct {
+	// Name of the query
+	Name string `json:"name"`
+	// Database in which query is executed
+	// +doc/default: _system
+	Database *string `json:"database,omitempty"`
+	// Query AQL query to be executed
+	Query string `json:"query"`
+	// MinResults defines the minimal number of results returned by the query
+	// +doc/default: 0
+	MinResults *int `json:"minResults,omitempty"`
+}
+
+// GetDatabase returns the database name
+func (a ArangoBackupSpecVerificationQuery) GetDatabase() string {
+	return util.TypeOrDefault(a.Database, ArangoBackupSpecVerificationDefaultDatabase)
+}
+
+// GetMinResults returns the minimal number of results
+func (a ArangoBackupSpecVerificationQuery) GetMinResults() int {
+	return util.TypeOrDefault(a.MinResults, 0)
+}
+
+func (a ArangoBackupSpecVerificationQuery) Validate() error {
+	var errs []error
+
+	if a.Name == "" {
+		errs = append(errs, shared.PrefixResourceErrors("name", errors.Errorf("Name can not be empty")))
+	}
+
+	if a.Query == "" {
+		errs = append(errs, shared.PrefixResourceErrors("query", errors.Errorf("Query can not be empty")))
+	}
+
+	if a.GetMinResults() < 0 {
+		errs = append(errs, shared.PrefixResourceErrors("minResults", errors.Errorf("MinResults needs to be greater or equal to 0")))
+	}
+
+	return shared.WithErrors(errs...)
+}
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2023 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -36,6 +36,10 @@ type ArangoBackupStatus struct {
 	Available bool `json:"available"`
 	// Backoff shows current backoff status
 	Backoff *ArangoBackupStatusBackOff `json:"backoff,omitempty"`
+	// Verification shows the state of the backup verification
+	Verification *ArangoBackupStatusVerification `json:"verification,omitempty"`
+	// Conditions specific to the ArangoBackup
+	Conditions sharedApi.ConditionList `json:"conditions,omitempty"`
 }
 
 func (a *ArangoBackupStatus) Equal(b *ArangoBackupStatus) bool {
@@ -49,7 +53,9 @@ func (a *ArangoBackupStatus) Equal(b *ArangoBackupStatus) bool {
 
 	return a.ArangoBackupState.Equal(&b.ArangoBackupState) &&
 		a.Backup.Equal(b.Backup) &&
-		a.Available == b.Available
+		a.Available == b.Available &&
+		a.Verification.Equal(b.Verification) &&
+		a.Conditions.Equal(b.Conditions)
 }
 
 type ArangoBackupDetails struct {
@@ -0,0 +1,128 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	sharedApi "github.com/arangodb/kube-arangodb/pkg/apis/shared/v1"
+)
+
+const (
+	// ConditionTypeVerified indicates if the backup has been restored and verified in the temporary deployment
+	ConditionTypeVerified sharedApi.ConditionType = "Verified"
+)
+
+type ArangoBackupVerificationPhase string
+
+const (
+	// ArangoBackupVerificationPhaseDeploying temporary deployment is created and not ready yet
+	ArangoBackupVerificationPhaseDeploying ArangoBackupVerificationPhase = "Deploying"
+	// ArangoBackupVerificationPhaseDownloading backup is downloaded into the temporary deployment
+	ArangoBackupVerificationPhaseDownloading ArangoBackupVerificationPhase = "Downloading"
+	// ArangoBackupVerificationPhaseRestoring backup is restored in the temporary deployment
+	ArangoBackupVerificationPhaseRestoring ArangoBackupVerificationPhase = "Restoring"
+	// ArangoBackupVerificationPhaseQuerying sanity queries are executed on the restored data
+	ArangoBackupVerificationPhaseQuerying ArangoBackupVerificationPhase = "Querying"
+	// ArangoBackupVerificationPhaseSucceeded backup has been verified
+	ArangoBackupVerificationPhaseSucceeded ArangoBackupVerificationPhase = "Succeeded"
+	// ArangoBackupVerificationPhaseFailed backup verification failed
+	ArangoBackupVerificationPhaseFailed ArangoBackupVerificationPhase = "Failed"
+)
+
+// IsFinished returns true if the verification reached the final phase
+func (a ArangoBackupVerificationPhase) IsFinished() bool {
+	return a == ArangoBackupVerificationPhaseSucceeded || a == ArangoBackupVerificationPhaseFailed
+}
+
+// ArangoBackupStatusVerification keeps the state of the backup verification
+type ArangoBackupStatusVerification struct {
+	// Phase of the verification
+	// +doc/enum: Deploying|temporary deployment is created and not ready yet
+	// +doc/enum: Downloading|backup is downloaded into the temporary deployment
+	// +doc/enum: Restoring|backup is restored in the temporary deployment
+	// +doc/enum: Querying|sanity queries are executed on the restored data
+	// +doc/enum: Succeeded|backup has been verified
+	// +doc/enum: Failed|backup verification failed
+	Phase ArangoBackupVerificationPhase `json:"phase,omitempty"`
+	// Deployment name of the temporary ArangoDeployment
+	Deployment string `json:"deployment,omitempty"`
+	// Download name of the ArangoBackup downloaded into the temporary ArangoDeployment
+	Download string `json:"download,omitempty"`
+	// StartTime of the verification
+	// +doc/type: meta.Time
+	StartTime *meta.Time `json:"startTime,omitempty"`
+	// FinishTime of the verification
+	// +doc/type: meta.Time
+	FinishTime *meta.Time `json:"finishTime,omitempty"`
+	// Message keeps the reason of the verification failure
+	Message string `json:"message,omitempty"`
+	// Queries keeps the results of the sanity queries
+	Queries []ArangoBackupStatusVerificationQuery `json:"queries,omitempty"`
+}
+
+// IsFinished returns true if the verification reached the final phase
+func (a *ArangoBackupStatusVerification) IsFinished() bool {
+	if a == nil {
+		return false
+	}
+
+	return a.Phase.IsFinished()
+}
+
+func (a *ArangoBackupStatusVerification) Equal(b *ArangoBackupStatusVerification) bool {
+	if a == b {
+		return true
+	}
+
+	if a == nil || b == nil {
+		return false
+	}
+
+	if len(a.Queries) != len(b.Queries) {
+		return false
+	}
+
+	for id := range a.Queries {
+		if a.Queries[id] != b.Queries[id] {
+			return false
+		}
+	}
+
+	return a.Phase == b.Phase &&
+		a.Deployment == b.Deployment &&
+		a.Download == b.Download &&
+		a.StartTime.Equal(b.StartTime) &&
+		a.FinishTime.Equal(b.FinishTime) &&
+		a.Message == b.Message
+}
+
+// ArangoBackupStatusVerificationQuery keeps the result of the sanity query
+type ArangoBackupStatusVerificationQuery struct {
+	// Name of the query
+	Name string `json:"name"`
+	// Succeeded is true if the query returned enough results
+	Succeeded bool `json:"succeeded"`
+	// Results number of the results returned by the query
+	Results int `json:"results"`
+	// Message keeps the reason of the query failure
+	Message string `json:"message,omitempty"`
+}
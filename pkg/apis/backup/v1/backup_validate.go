@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2024 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -53,10 +53,32 @@ func (a *ArangoBackupSpec) Validate() error {
 		}
 	}
 
+	if a.Verification != nil {
+		if err := a.Verification.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid verification")
+		}
+	}
+
 	return nil
 }
 
 func (a *ArangoBackupSpecOperation) Validate() error {
+	if a.Storage != nil {
+		if a.RepositoryURL != "" || a.CredentialsSecretName != "" {
+			return errors.Errorf("Storage can not be used together with RepositoryURL or CredentialsSecretName")
+		}
+
+		if a.Storage.Namespace != nil {
+			return errors.Errorf("Storage from different namespace is not supported")
+		}
+
+		if err := a.Storage.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid storage")
+		}
+
+		return nil
+	}
+
 	if a.RepositoryURL == "" {
 		return errors.Errorf("RepositoryURL can not be empty")
 	}
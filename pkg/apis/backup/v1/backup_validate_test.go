@@ -0,0 +1,51 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sharedApi "github.com/arangodb/kube-arangodb/pkg/apis/shared/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func Test_ArangoBackupSpecOperation_Validate(t *testing.T) {
+	require.NoError(t, (&ArangoBackupSpecOperation{
+		Storage: &sharedApi.Object{Name: "storage"},
+	}).Validate())
+
+	require.NoError(t, (&ArangoBackupSpecOperation{
+		RepositoryURL: "s3://bucket",
+	}).Validate())
+
+	require.EqualError(t, (&ArangoBackupSpecOperation{}).Validate(), "RepositoryURL can not be empty")
+
+	require.EqualError(t, (&ArangoBackupSpecOperation{
+		Storage:       &sharedApi.Object{Name: "storage"},
+		RepositoryURL: "s3://bucket",
+	}).Validate(), "Storage can not be used together with RepositoryURL or CredentialsSecretName")
+
+	require.EqualError(t, (&ArangoBackupSpecOperation{
+		Storage: &sharedApi.Object{Name: "storage", Namespace: util.NewType("other")},
+	}).Validate(), "Storage from different namespace is not supported")
+}
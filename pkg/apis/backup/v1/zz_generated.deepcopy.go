@@ -195,6 +195,100 @@ func (in *ArangoBackupPolicyList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoBackupPolicyRetention) DeepCopyInto(out *ArangoBackupPolicyRetention) {
+	*out = *in
+	if in.Latest != nil {
+		in, out := &in.Latest, &out.Latest
+		*out = new(int)
+		**out = **in
+	}
+	if in.Hourly != nil {
+		in, out := &in.Hourly, &out.Hourly
+		*out = new(int)
+		**out = **in
+	}
+	if in.Daily != nil {
+		in, out := &in.Daily, &out.Daily
+		*out = new(int)
+		**out = **in
+	}
+	if in.Weekly != nil {
+		in, out := &in.Weekly, &out.Weekly
+		*out = new(int)
+		**out = **in
+	}
+	if in.Monthly != nil {
+		in, out := &in.Monthly, &out.Monthly
+		*out = new(int)
+		**out = **in
+	}
+	if in.Yearly != nil {
+		in, out := &in.Yearly, &out.Yearly
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoBackupPolicyRetention.
+func (in *ArangoBackupPolicyRetention) DeepCopy() *ArangoBackupPolicyRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoBackupPolicyRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoBackupPolicyRetentionBackup) DeepCopyInto(out *ArangoBackupPolicyRetentionBackup) {
+	*out = *in
+	if in.Tiers != nil {
+		in, out := &in.Tiers, &out.Tiers
+		*out = make([]ArangoBackupPolicyRetentionTier, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoBackupPolicyRetentionBackup.
+func (in *ArangoBackupPolicyRetentionBackup) DeepCopy() *ArangoBackupPolicyRetentionBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoBackupPolicyRetentionBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoBackupPolicyRetentionStatus) DeepCopyInto(out *ArangoBackupPolicyRetentionStatus) {
+	*out = *in
+	if in.NextPrune != nil {
+		in, out := &in.NextPrune, &out.NextPrune
+		*out = (*in).DeepCopy()
+	}
+	if in.Backups != nil {
+		in, out := &in.Backups, &out.Backups
+		*out = make([]ArangoBackupPolicyRetentionBackup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoBackupPolicyRetentionStatus.
+func (in *ArangoBackupPolicyRetentionStatus) DeepCopy() *ArangoBackupPolicyRetentionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoBackupPolicyRetentionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArangoBackupPolicySpec) DeepCopyInto(out *ArangoBackupPolicySpec) {
 	*out = *in
@@ -208,6 +302,11 @@ func (in *ArangoBackupPolicySpec) DeepCopyInto(out *ArangoBackupPolicySpec) {
 		*out = new(metav1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(ArangoBackupPolicyRetention)
+		(*in).DeepCopyInto(*out)
+	}
 	in.BackupTemplate.DeepCopyInto(&out.BackupTemplate)
 	return
 }
@@ -226,6 +325,11 @@ func (in *ArangoBackupPolicySpec) DeepCopy() *ArangoBackupPolicySpec {
 func (in *ArangoBackupPolicyStatus) DeepCopyInto(out *ArangoBackupPolicyStatus) {
 	*out = *in
 	in.Scheduled.DeepCopyInto(&out.Scheduled)
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(ArangoBackupPolicyRetentionStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -289,6 +393,11 @@ func (in *ArangoBackupSpec) DeepCopyInto(out *ArangoBackupSpec) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(ArangoBackupSpecVerification)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -378,6 +487,11 @@ func (in *ArangoBackupSpecDownload) DeepCopy() *ArangoBackupSpecDownload {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArangoBackupSpecOperation) DeepCopyInto(out *ArangoBackupSpecOperation) {
 	*out = *in
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(sharedv1.Object)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AutoDelete != nil {
 		in, out := &in.AutoDelete, &out.AutoDelete
 		*out = new(bool)
@@ -422,6 +536,65 @@ func (in *ArangoBackupSpecOptions) DeepCopy() *ArangoBackupSpecOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoBackupSpecVerification) DeepCopyInto(out *ArangoBackupSpecVerification) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Queries != nil {
+		in, out := &in.Queries, &out.Queries
+		*out = make([]ArangoBackupSpecVerificationQuery, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoBackupSpecVerification.
+func (in *ArangoBackupSpecVerification) DeepCopy() *ArangoBackupSpecVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoBackupSpecVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoBackupSpecVerificationQuery) DeepCopyInto(out *ArangoBackupSpecVerificationQuery) {
+	*out = *in
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(string)
+		**out = **in
+	}
+	if in.MinResults != nil {
+		in, out := &in.MinResults, &out.MinResults
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoBackupSpecVerificationQuery.
+func (in *ArangoBackupSpecVerificationQuery) DeepCopy() *ArangoBackupSpecVerificationQuery {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoBackupSpecVerificationQuery)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArangoBackupState) DeepCopyInto(out *ArangoBackupState) {
 	*out = *in
@@ -458,6 +631,18 @@ func (in *ArangoBackupStatus) DeepCopyInto(out *ArangoBackupStatus) {
 		*out = new(ArangoBackupStatusBackOff)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(ArangoBackupStatusVerification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(sharedv1.ConditionList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -488,6 +673,51 @@ func (in *ArangoBackupStatusBackOff) DeepCopy() *ArangoBackupStatusBackOff {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoBackupStatusVerification) DeepCopyInto(out *ArangoBackupStatusVerification) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.FinishTime != nil {
+		in, out := &in.FinishTime, &out.FinishTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Queries != nil {
+		in, out := &in.Queries, &out.Queries
+		*out = make([]ArangoBackupStatusVerificationQuery, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoBackupStatusVerification.
+func (in *ArangoBackupStatusVerification) DeepCopy() *ArangoBackupStatusVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoBackupStatusVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoBackupStatusVerificationQuery) DeepCopyInto(out *ArangoBackupStatusVerificationQuery) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoBackupStatusVerificationQuery.
+func (in *ArangoBackupStatusVerificationQuery) DeepCopy() *ArangoBackupStatusVerificationQuery {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoBackupStatusVerificationQuery)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArangoBackupTemplate) DeepCopyInto(out *ArangoBackupTemplate) {
 	*out = *in
@@ -511,6 +741,11 @@ func (in *ArangoBackupTemplate) DeepCopyInto(out *ArangoBackupTemplate) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(ArangoBackupSpecVerification)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2025 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -29,4 +29,8 @@ const (
 	ArangoDeploymentPodAllowUpgradeAnnotation = "upgrade." + ArangoDeploymentAnnotationPrefix + "/allow"
 	ArangoDeploymentPodDeleteNow              = ArangoDeploymentAnnotationPrefix + "/delete_now"
 	ArangoDeploymentPlanCleanAnnotation       = "plan." + ArangoDeploymentAnnotationPrefix + "/clean"
+	ArangoDeploymentPlanPauseAnnotation       = "plan." + ArangoDeploymentAnnotationPrefix + "/pause"
+	ArangoDeploymentPlanSkipAnnotation        = "plan." + ArangoDeploymentAnnotationPrefix + "/skip"
+	ArangoDeploymentPlanSkipForceAnnotation   = "plan." + ArangoDeploymentAnnotationPrefix + "/skip-force"
+	ArangoDeploymentPlanStepAnnotation        = "plan." + ArangoDeploymentAnnotationPrefix + "/step"
 )
@@ -0,0 +1,136 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"time"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	shared "github.com/arangodb/kube-arangodb/pkg/apis/shared"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+const (
+	// DefaultAuthenticationRotationOverlap is the default period in which the retired JWT keys are still accepted
+	DefaultAuthenticationRotationOverlap = 24 * time.Hour
+	// DefaultAuthenticationRotationMaxKeys is the default maximum number of JWT keys kept in the JWT folder
+	DefaultAuthenticationRotationMaxKeys = 3
+	// MinAuthenticationRotationMaxKeys is the minimum number of JWT keys required to rotate the key (active and the new one)
+	MinAuthenticationRotationMaxKeys = 2
+)
+
+// AuthenticationRotationSpec defines the automatic rotation policy of the JWT signing keys
+type AuthenticationRotationSpec struct {
+	// Interval defines how often the new JWT signing key is generated.
+	// The new key is added as passive, promoted to active once it is propagated to all members
+	// and stored in the `jwtSecretName` Secret.
+	// +doc/example: 2160h
+	Interval *meta.Duration `json:"interval,omitempty"`
+
+	// Overlap defines how long the retired JWT keys are still accepted after the new key is promoted
+	// +doc/default: 24h
+	Overlap *meta.Duration `json:"overlap,omitempty"`
+
+	// MaxKeys defines the maximum number of JWT keys kept in the JWT folder, including the active one.
+	// When the limit is reached, the oldest retired keys are removed before the overlap period ends.
+	// +doc/default: 3
+	MaxKeys *int `json:"maxKeys,omitempty"`
+}
+
+// IsEnabled returns true if the automatic rotation is enabled
+func (s *AuthenticationRotationSpec) IsEnabled() bool {
+	if s == nil || s.Interval == nil {
+		return false
+	}
+
+	return s.Interval.Duration > 0
+}
+
+// GetInterval returns the rotation interval
+func (s *AuthenticationRotationSpec) GetInterval() time.Duration {
+	if s == nil || s.Interval == nil {
+		return 0
+	}
+
+	return s.Interval.Duration
+}
+
+// GetOverlap returns the period in which the retired keys are still accepted
+func (s *AuthenticationRotationSpec) GetOverlap() time.Duration {
+	if s == nil || s.Overlap == nil {
+		return DefaultAuthenticationRotationOverlap
+	}
+
+	return s.Overlap.Duration
+}
+
+// GetMaxKeys returns the maximum number of keys kept in the JWT folder
+func (s *AuthenticationRotationSpec) GetMaxKeys() int {
+	if s == nil || s.MaxKeys == nil {
+		return DefaultAuthenticationRotationMaxKeys
+	}
+
+	return *s.MaxKeys
+}
+
+// Validate validates the AuthenticationRotationSpec
+func (s *AuthenticationRotationSpec) Validate() error {
+	if s == nil {
+		return nil
+	}
+
+	return shared.WithErrors(
+		shared.PrefixResourceErrorFunc("interval", func() error {
+			if s.Interval == nil {
+				return errors.Errorf("Interval is required")
+			}
+
+			if s.Interval.Duration <= 0 {
+				return errors.Errorf("Interval must be greater than zero")
+			}
+
+			return nil
+		}),
+		shared.PrefixResourceErrorFunc("overlap", func() error {
+			if s.Overlap == nil {
+				return nil
+			}
+
+			if s.Overlap.Duration < 0 {
+				return errors.Errorf("Overlap must not be negative")
+			}
+
+			if s.Interval != nil && s.Overlap.Duration >= s.Interval.Duration {
+				return errors.Errorf("Overlap must be less than interval")
+			}
+
+			return nil
+		}),
+		shared.PrefixResourceErrorFunc("maxKeys", func() error {
+			if s.MaxKeys != nil && *s.MaxKeys < MinAuthenticationRotationMaxKeys {
+				return errors.Errorf("MaxKeys must be at least %d", MinAuthenticationRotationMaxKeys)
+			}
+
+			return nil
+		}),
+	)
+}
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2023 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -22,8 +22,10 @@ package v1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/arangodb/kube-arangodb/pkg/util"
 )
@@ -102,3 +104,42 @@ func TestAuthenticationSpecResetImmutableFields(t *testing.T) {
 		assert.Equal(t, test.Expected, test.Target)
 	}
 }
+
+func TestAuthenticationSpecRotation(t *testing.T) {
+	duration := func(d time.Duration) *meta.Duration {
+		return &meta.Duration{Duration: d}
+	}
+
+	// Defaults
+	assert.False(t, AuthenticationSpec{}.IsRotationEnabled())
+	assert.False(t, AuthenticationSpec{Rotation: &AuthenticationRotationSpec{}}.IsRotationEnabled())
+	assert.False(t, AuthenticationSpec{JWTSecretName: util.NewType[string]("None"), Rotation: &AuthenticationRotationSpec{Interval: duration(time.Hour)}}.IsRotationEnabled())
+	assert.True(t, AuthenticationSpec{Rotation: &AuthenticationRotationSpec{Interval: duration(time.Hour)}}.IsRotationEnabled())
+
+	var rotation *AuthenticationRotationSpec
+	assert.Equal(t, DefaultAuthenticationRotationOverlap, rotation.GetOverlap())
+	assert.Equal(t, DefaultAuthenticationRotationMaxKeys, rotation.GetMaxKeys())
+
+	// Valid
+	assert.NoError(t, AuthenticationSpec{JWTSecretName: util.NewType[string]("foo")}.Validate(false))
+	assert.NoError(t, AuthenticationSpec{JWTSecretName: util.NewType[string]("foo"), Rotation: &AuthenticationRotationSpec{
+		Interval: duration(90 * 24 * time.Hour),
+	}}.Validate(false))
+	assert.NoError(t, AuthenticationSpec{JWTSecretName: util.NewType[string]("foo"), Rotation: &AuthenticationRotationSpec{
+		Interval: duration(90 * 24 * time.Hour),
+		Overlap:  duration(0),
+		MaxKeys:  util.NewType(2),
+	}}.Validate(false))
+
+	// Not valid
+	assert.EqualError(t, AuthenticationSpec{JWTSecretName: util.NewType[string]("foo"), Rotation: &AuthenticationRotationSpec{}}.Validate(false),
+		"rotation: Received 1 errors: interval: Interval is required")
+	assert.Error(t, AuthenticationSpec{JWTSecretName: util.NewType[string]("foo"), Rotation: &AuthenticationRotationSpec{
+		Interval: duration(time.Hour),
+		Overlap:  duration(time.Hour),
+	}}.Validate(false))
+	assert.Error(t, AuthenticationSpec{JWTSecretName: util.NewType[string]("foo"), Rotation: &AuthenticationRotationSpec{
+		Interval: duration(time.Hour),
+		MaxKeys:  util.NewType(1),
+	}}.Validate(false))
+}
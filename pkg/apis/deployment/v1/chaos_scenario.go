@@ -0,0 +1,84 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	shared "github.com/arangodb/kube-arangodb/pkg/apis/shared"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+type ChaosScenarioType string
+
+const (
+	// ChaosScenarioKillPod kills a random pod
+	ChaosScenarioKillPod ChaosScenarioType = "KillPod"
+	// ChaosScenarioKillAgencyLeader kills the pod of the current agency leader
+	ChaosScenarioKillAgencyLeader ChaosScenarioType = "KillAgencyLeader"
+	// ChaosScenarioKillShardLeader kills the pod of a DBServer which holds shard leaders
+	ChaosScenarioKillShardLeader ChaosScenarioType = "KillShardLeader"
+	// ChaosScenarioPauseReadiness marks a random member as not ready until the next chaos interval
+	ChaosScenarioPauseReadiness ChaosScenarioType = "PauseReadiness"
+	// ChaosScenarioResignLeadershipStorm resigns the leadership of all DBServers which hold shard leaders
+	ChaosScenarioResignLeadershipStorm ChaosScenarioType = "ResignLeadershipStorm"
+)
+
+func (c ChaosScenarioType) Validate() error {
+	switch c {
+	case ChaosScenarioKillPod, ChaosScenarioKillAgencyLeader, ChaosScenarioKillShardLeader, ChaosScenarioPauseReadiness, ChaosScenarioResignLeadershipStorm:
+		return nil
+	default:
+		return errors.Errorf("Unknown scenario %s", c)
+	}
+}
+
+// ChaosScenario defines the event injected by the chaos monkey
+type ChaosScenario struct {
+	// Type of the scenario
+	// +doc/enum: KillPod|Kills a random pod
+	// +doc/enum: KillAgencyLeader|Kills the pod of the current agency leader
+	// +doc/enum: KillShardLeader|Kills the pod of a DBServer which holds shard leaders
+	// +doc/enum: PauseReadiness|Marks a random member as not ready until the next chaos interval
+	// +doc/enum: ResignLeadershipStorm|Resigns the leadership of all DBServers which hold shard leaders
+	Type ChaosScenarioType `json:"type"`
+	// Probability is the chance of the scenario being executed during an event
+	// +doc/default: 50
+	Probability *Percent `json:"probability,omitempty"`
+}
+
+// GetProbability returns the probability of the scenario
+func (c ChaosScenario) GetProbability() Percent {
+	return PercentOrDefault(c.Probability, 50)
+}
+
+func (c ChaosScenario) Validate() error {
+	return shared.WithErrors(
+		shared.PrefixResourceError("type", c.Type.Validate()),
+		shared.PrefixResourceError("probability", c.GetProbability().Validate()),
+	)
+}
+
+type ChaosScenarios []ChaosScenario
+
+func (c ChaosScenarios) Validate() error {
+	return shared.ValidateList(c, func(s ChaosScenario) error {
+		return s.Validate()
+	})
+}
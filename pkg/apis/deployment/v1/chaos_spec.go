@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2023 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -35,6 +35,13 @@ type ChaosSpec struct {
 	Interval *time.Duration `json:"interval,omitempty"`
 	// KillPodProbability is the chance of a pod being killed during an event
 	KillPodProbability *Percent `json:"kill-pod-probability,omitempty"`
+	// Scenarios defines the events injected by the chaos monkey. If empty, a random pod is killed with KillPodProbability.
+	Scenarios ChaosScenarios `json:"scenarios,omitempty"`
+	// Groups restricts the chaos events to the members of the given groups (roles: single, agent, dbserver, coordinator, gateways). If empty, all groups are affected.
+	// +doc/example: dbserver
+	Groups []string `json:"groups,omitempty"`
+	// Windows restricts the chaos events to the given time windows. If empty, events are injected at any time.
+	Windows *DeploymentSpecMaintenanceWindows `json:"windows,omitempty"`
 }
 
 // IsEnabled returns the value of enabled.
@@ -52,6 +59,35 @@ func (s ChaosSpec) GetKillPodProbability() Percent {
 	return PercentOrDefault(s.KillPodProbability)
 }
 
+// GetScenarios returns the scenarios of the chaos monkey.
+func (s ChaosSpec) GetScenarios() ChaosScenarios {
+	if len(s.Scenarios) == 0 {
+		return ChaosScenarios{
+			{
+				Type:        ChaosScenarioKillPod,
+				Probability: NewPercent(s.GetKillPodProbability()),
+			},
+		}
+	}
+
+	return s.Scenarios
+}
+
+// IsGroupAllowed returns true if chaos events can affect members of the given group.
+func (s ChaosSpec) IsGroupAllowed(group ServerGroup) bool {
+	if len(s.Groups) == 0 {
+		return true
+	}
+
+	for _, g := range s.Groups {
+		if ServerGroupFromRole(g) == group {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Validate the given spec
 func (s ChaosSpec) Validate() error {
 	if s.IsEnabled() {
@@ -61,6 +97,17 @@ func (s ChaosSpec) Validate() error {
 		if err := s.GetKillPodProbability().Validate(); err != nil {
 			return errors.WithStack(err)
 		}
+		if err := s.Scenarios.Validate(); err != nil {
+			return errors.WithStack(errors.Wrapf(err, "Invalid scenarios"))
+		}
+		for _, g := range s.Groups {
+			if ServerGroupFromRole(g) == ServerGroupUnknown {
+				return errors.WithStack(errors.Wrapf(ValidationError, "Unknown group %s", g))
+			}
+		}
+		if err := s.Windows.Validate(); err != nil {
+			return errors.WithStack(errors.Wrapf(err, "Invalid windows"))
+		}
 	}
 	return nil
 }
@@ -86,4 +133,13 @@ func (s *ChaosSpec) SetDefaultsFrom(source ChaosSpec) {
 	if s.KillPodProbability == nil {
 		s.KillPodProbability = NewPercentOrNil(source.KillPodProbability)
 	}
+	if s.Scenarios == nil {
+		s.Scenarios = source.Scenarios
+	}
+	if s.Groups == nil {
+		s.Groups = source.Groups
+	}
+	if s.Windows == nil {
+		s.Windows = source.Windows
+	}
 }
@@ -0,0 +1,81 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func Test_ChaosSpec_Validate(t *testing.T) {
+	valid := ChaosSpec{
+		Enabled:  util.NewType(true),
+		Interval: util.NewType(time.Minute),
+		Scenarios: ChaosScenarios{
+			{Type: ChaosScenarioKillAgencyLeader},
+			{Type: ChaosScenarioPauseReadiness, Probability: NewPercent(10)},
+			{Type: ChaosScenarioResignLeadershipStorm},
+		},
+		Groups: []string{"dbserver", "agent"},
+		Windows: &DeploymentSpecMaintenanceWindows{
+			Timezone: util.NewType("Europe/Berlin"),
+			Windows:  []DeploymentSpecMaintenanceWindow{{Days: []string{"Monday"}, From: "09:00", To: "17:00"}},
+		},
+	}
+	require.NoError(t, valid.Validate())
+
+	t.Run("Unknown scenario", func(t *testing.T) {
+		s := valid.DeepCopy()
+		s.Scenarios = append(s.Scenarios, ChaosScenario{Type: "Unknown"})
+		require.Error(t, s.Validate())
+	})
+
+	t.Run("Unknown group", func(t *testing.T) {
+		s := valid.DeepCopy()
+		s.Groups = []string{"unknown"}
+		require.Error(t, s.Validate())
+	})
+
+	t.Run("Invalid window", func(t *testing.T) {
+		s := valid.DeepCopy()
+		s.Windows.Windows = []DeploymentSpecMaintenanceWindow{{From: "9am", To: "17:00"}}
+		require.Error(t, s.Validate())
+	})
+}
+
+func Test_ChaosSpec_Defaults(t *testing.T) {
+	s := ChaosSpec{KillPodProbability: NewPercent(30)}
+
+	scenarios := s.GetScenarios()
+	require.Len(t, scenarios, 1)
+	require.Equal(t, ChaosScenarioKillPod, scenarios[0].Type)
+	require.Equal(t, Percent(30), scenarios[0].GetProbability())
+
+	require.True(t, s.IsGroupAllowed(ServerGroupAgents))
+
+	s.Groups = []string{"dbserver"}
+	require.True(t, s.IsGroupAllowed(ServerGroupDBServers))
+	require.False(t, s.IsGroupAllowed(ServerGroupAgents))
+}
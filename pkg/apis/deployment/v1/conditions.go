@@ -75,6 +75,10 @@ const (
 	ConditionTypeSpecPropagated ConditionType = "SpecPropagated"
 	// ConditionTypeMemberVolumeUnschedulable indicates that the member cannot schedued due to volume issue.
 	ConditionTypeMemberVolumeUnschedulable ConditionType = "MemberVolumeUnschedulable"
+	// ConditionTypeMemberUnschedulable indicates that the member pod is not scheduled longer than the recovery timeout.
+	ConditionTypeMemberUnschedulable ConditionType = "MemberUnschedulable"
+	// ConditionTypeReadinessPaused indicates that the chaos monkey paused the readiness of the member.
+	ConditionTypeReadinessPaused ConditionType = "ReadinessPaused"
 	// ConditionTypeMarkedToRemove indicates that the member is marked to be removed.
 	ConditionTypeMarkedToRemove ConditionType = "MarkedToRemove"
 	// ConditionTypeUpgradeFailed indicates that upgrade failed
@@ -124,6 +128,10 @@ const (
 
 	// ConditionTypeMaintenance indicates that maintenance is enabled on cluster
 	ConditionTypeMaintenance ConditionType = "Maintenance"
+	// ConditionTypeMaintenanceWindowHold indicates that disruptive actions are held until the next maintenance window opens
+	ConditionTypeMaintenanceWindowHold ConditionType = "MaintenanceWindowHold"
+	// ConditionTypePlanPaused indicates that the plan execution is paused
+	ConditionTypePlanPaused ConditionType = "PlanPaused"
 
 	// ConditionTypeSyncEnabled Define if sync is enabled
 	ConditionTypeSyncEnabled ConditionType = "SyncEnabled"
@@ -132,6 +140,14 @@ const (
 	ConditionTypeDBServerWithData ConditionType = "DBServerWithData"
 	// ConditionTypeSyncEnabled Define if DBServer contains any active data leaders
 	ConditionTypeDBServerWithDataLeader ConditionType = "DBServerWithDataLeader"
+	// ConditionTypeDBServerDataAtRisk indicates that the failed DBServer keeps shards without replicas on the other DBServers
+	ConditionTypeDBServerDataAtRisk ConditionType = "DBServerDataAtRisk"
+
+	// ConditionTypeAgencyJobsStuck indicates that agency supervision jobs are not finished in time
+	ConditionTypeAgencyJobsStuck ConditionType = "AgencyJobsStuck"
+
+	// ConditionTypeShardReplicasSameZone indicates that replicas of the shards share the topology zone
+	ConditionTypeShardReplicasSameZone ConditionType = "ShardReplicasSameZone"
 
 	// ConditionTypeGatewayConfig contains current config checksum of the Gateway
 	ConditionTypeGatewayConfig ConditionType = "GatewayConfig"
@@ -0,0 +1,101 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func Test_DeploymentSpecMaintenanceWindows_Contains(t *testing.T) {
+	// 2026-01-05 is Monday
+	at := func(day, h, m int) time.Time {
+		return time.Date(2026, 1, day, h, m, 0, 0, time.UTC)
+	}
+
+	require.True(t, (*DeploymentSpecMaintenanceWindows)(nil).Contains(at(5, 12, 0)))
+	require.True(t, (&DeploymentSpecMaintenanceWindows{}).Contains(at(5, 12, 0)))
+
+	w := &DeploymentSpecMaintenanceWindows{
+		Windows: []DeploymentSpecMaintenanceWindow{
+			{Days: []string{"Saturday"}, From: "22:00", To: "04:00"},
+			{Days: []string{"Monday", "Wednesday"}, From: "01:00", To: "03:00"},
+		},
+	}
+
+	require.True(t, w.Contains(at(5, 1, 0)))
+	require.True(t, w.Contains(at(5, 2, 59)))
+	require.False(t, w.Contains(at(5, 3, 0)))
+	require.False(t, w.Contains(at(6, 1, 30)))
+	require.True(t, w.Contains(at(10, 23, 0)))
+	require.True(t, w.Contains(at(11, 3, 0)))
+	require.False(t, w.Contains(at(11, 23, 0)))
+
+	next, ok := w.Next(at(5, 12, 0))
+	require.True(t, ok)
+	require.True(t, at(7, 1, 0).Equal(next))
+
+	next, ok = w.Next(at(8, 12, 0))
+	require.True(t, ok)
+	require.True(t, at(10, 22, 0).Equal(next))
+}
+
+func Test_DeploymentSpecMaintenanceWindows_Timezone(t *testing.T) {
+	w := &DeploymentSpecMaintenanceWindows{
+		Timezone: util.NewType("Europe/Berlin"),
+		Windows: []DeploymentSpecMaintenanceWindow{
+			{From: "01:00", To: "02:00"},
+		},
+	}
+
+	require.NoError(t, w.Validate())
+
+	// 01:30 in Berlin is 00:30 UTC in winter
+	require.True(t, w.Contains(time.Date(2026, 1, 5, 0, 30, 0, 0, time.UTC)))
+	require.False(t, w.Contains(time.Date(2026, 1, 5, 1, 30, 0, 0, time.UTC)))
+
+	// 01:30 in Berlin is 23:30 UTC in summer
+	require.True(t, w.Contains(time.Date(2026, 7, 5, 23, 30, 0, 0, time.UTC)))
+}
+
+func Test_DeploymentSpecMaintenanceWindows_Validate(t *testing.T) {
+	require.NoError(t, (*DeploymentSpecMaintenanceWindows)(nil).Validate())
+
+	require.Error(t, (&DeploymentSpecMaintenanceWindows{
+		Timezone: util.NewType("Unknown/Zone"),
+	}).Validate())
+
+	require.Error(t, (&DeploymentSpecMaintenanceWindows{
+		Windows: []DeploymentSpecMaintenanceWindow{{Days: []string{"Someday"}, From: "01:00", To: "02:00"}},
+	}).Validate())
+
+	require.Error(t, (&DeploymentSpecMaintenanceWindows{
+		Windows: []DeploymentSpecMaintenanceWindow{{From: "1am", To: "02:00"}},
+	}).Validate())
+
+	require.Error(t, (&DeploymentSpecMaintenanceWindows{
+		Windows: []DeploymentSpecMaintenanceWindow{{From: "02:00", To: "02:00"}},
+	}).Validate())
+}
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2025 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -72,6 +72,9 @@ type DeploymentStatus struct {
 	// ResourcesPlan to update this deployment. Executed before plan, after highPlan
 	ResourcesPlan Plan `json:"resourcesPlan,omitempty"`
 
+	// PlanHistory keeps the bounded list of the recently executed plan actions
+	PlanHistory PlanHistory `json:"planHistory,omitempty"`
+
 	// AcceptedSpec contains the last specification that was accepted by the operator.
 	AcceptedSpec *DeploymentSpec `json:"accepted-spec,omitempty"`
 
@@ -125,6 +128,7 @@ func (ds *DeploymentStatus) Equal(other DeploymentStatus) bool {
 		ds.Plan.Equal(other.Plan) &&
 		ds.HighPriorityPlan.Equal(other.HighPriorityPlan) &&
 		ds.ResourcesPlan.Equal(other.ResourcesPlan) &&
+		ds.PlanHistory.Equal(other.PlanHistory) &&
 		strings.CompareStringPointers(ds.AcceptedSpecVersion, other.AcceptedSpecVersion) &&
 		ds.AcceptedSpec.Equal(other.AcceptedSpec) &&
 		ds.SecretHashes.Equal(other.SecretHashes) &&
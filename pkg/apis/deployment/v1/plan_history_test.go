@@ -0,0 +1,101 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+func Test_PlanHistory_Append(t *testing.T) {
+	var h PlanHistory
+
+	for i := 0; i < 5; i++ {
+		h = h.Append(3, PlanHistoryEntry{ID: string(rune('a' + i))})
+	}
+
+	require.Len(t, h, 3)
+	require.Equal(t, "c", h[0].ID)
+	require.Equal(t, "e", h[2].ID)
+
+	last := h.Last(2)
+	require.Len(t, last, 2)
+	require.Equal(t, "e", last[0].ID)
+	require.Equal(t, "d", last[1].ID)
+
+	require.Len(t, h.Last(0), 3)
+	require.Nil(t, PlanHistory(nil).Append(3))
+}
+
+func Test_PlanHistory_NewEntry(t *testing.T) {
+	start := meta.NewTime(time.Date(2026, time.January, 1, 10, 0, 0, 0, time.UTC))
+	end := meta.NewTime(start.Add(90 * time.Second))
+
+	a := Action{
+		ID:        "id",
+		Type:      ActionTypeRotateMember,
+		MemberID:  "PRMR-1",
+		Group:     ServerGroupDBServers,
+		Reason:    "Pod rotation",
+		StartTime: &start,
+	}
+
+	e := NewPlanHistoryEntry(a, "normal", PlanHistoryResultSuccess, nil, end)
+	require.Equal(t, "id", e.ID)
+	require.Equal(t, ActionTypeRotateMember, e.Type)
+	require.Equal(t, "normal", e.Priority)
+	require.Equal(t, "PRMR-1", e.MemberID)
+	require.Equal(t, ServerGroupDBServers, e.Group)
+	require.Equal(t, PlanHistoryResultSuccess, e.Result)
+	require.Empty(t, e.Error)
+	require.Equal(t, 90*time.Second, e.Duration().Duration)
+
+	a.StartTime = nil
+	e = NewPlanHistoryEntry(a, "high", PlanHistoryResultFailed, errors.Errorf("failure"), end)
+	require.Equal(t, "failure", e.Error)
+	require.NotNil(t, e.StartTime)
+	require.Equal(t, time.Duration(0), e.Duration().Duration)
+
+	e = NewPlanHistoryEntry(a, "high", PlanHistoryResultFailed, errors.Errorf("%s", strings.Repeat("x", 1024)), end)
+	require.Len(t, e.Error, PlanHistoryEntryMessageLimit)
+	require.True(t, strings.HasSuffix(e.Error, "..."))
+	require.True(t, e.Equal(e))
+
+	e = NewPlanHistoryEntry(a, "high", PlanHistoryResultFailed, errors.Errorf("%s", strings.Repeat("ä", 512)), end)
+	require.True(t, utf8.ValidString(e.Error))
+	require.LessOrEqual(t, len(e.Error), PlanHistoryEntryMessageLimit)
+	require.True(t, strings.HasSuffix(e.Error, "..."))
+}
+
+func Test_PlanHistory_Recorded(t *testing.T) {
+	require.True(t, ActionTypeRotateMember.IsPlanHistoryRecorded())
+	require.True(t, ActionTypeRemoveMember.IsPlanHistoryRecorded())
+	require.False(t, ActionTypeSetCondition.IsPlanHistoryRecorded())
+	require.False(t, ActionTypeSetConditionV2.IsPlanHistoryRecorded())
+	require.False(t, ActionTypeMemberPhaseUpdate.IsPlanHistoryRecorded())
+}
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2024 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -20,6 +20,13 @@
 
 package v1
 
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	shared "github.com/arangodb/kube-arangodb/pkg/apis/shared"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
 const ArangoDeploymentRebalancerDefaultParallelMoves = 32
 
 type ArangoDeploymentRebalancerSpec struct {
@@ -30,6 +37,19 @@ type ArangoDeploymentRebalancerSpec struct {
 	Readers *ArangoDeploymentRebalancerReadersSpec `json:"readers,omitempty"`
 
 	Optimizers *ArangoDeploymentRebalancerOptimizersSpec `json:"optimizers,omitempty"`
+
+	// Windows defines the time windows in which the rebalancer is allowed to move shards.
+	// If empty, shards are moved at any time.
+	Windows *DeploymentSpecMaintenanceWindows `json:"windows,omitempty"`
+
+	// Budget limits the amount of the data moved by the rebalancer
+	Budget *ArangoDeploymentRebalancerBudgetSpec `json:"budget,omitempty"`
+
+	// DryRun enables the dry-run mode. Computed move plan is saved in the `status.rebalancer.dryRun`
+	// together with the estimated size of the shards and the imbalance, moves are not executed.
+	// Saved plan is only a preview, once the dry-run mode is disabled the moves are computed again from the current state.
+	// +doc/default: false
+	DryRun *bool `json:"dryRun,omitempty"`
 }
 
 func (a *ArangoDeploymentRebalancerSpec) IsEnabled() bool {
@@ -56,6 +76,44 @@ func (a *ArangoDeploymentRebalancerSpec) GetParallelMoves() int {
 	return *a.ParallelMoves
 }
 
+// IsDryRun returns true if moves should be only computed and saved in the status
+func (a *ArangoDeploymentRebalancerSpec) IsDryRun() bool {
+	if a == nil || a.DryRun == nil {
+		return false
+	}
+
+	return *a.DryRun
+}
+
+// GetWindows returns the time windows in which the rebalancer is allowed to move shards
+func (a *ArangoDeploymentRebalancerSpec) GetWindows() *DeploymentSpecMaintenanceWindows {
+	if a == nil {
+		return nil
+	}
+
+	return a.Windows
+}
+
+// GetBudget returns the rebalancer budget
+func (a *ArangoDeploymentRebalancerSpec) GetBudget() *ArangoDeploymentRebalancerBudgetSpec {
+	if a == nil {
+		return nil
+	}
+
+	return a.Budget
+}
+
+func (a *ArangoDeploymentRebalancerSpec) Validate() error {
+	if a == nil {
+		return nil
+	}
+
+	return shared.WithErrors(
+		shared.PrefixResourceError("windows", a.Windows.Validate()),
+		shared.PrefixResourceError("budget", a.Budget.Validate()),
+	)
+}
+
 type ArangoDeploymentRebalancerReadersSpec struct {
 	// Count Enable Shard Count machanism
 	//
@@ -73,6 +131,11 @@ func (a *ArangoDeploymentRebalancerReadersSpec) IsCountEnabled() bool {
 
 type ArangoDeploymentRebalancerOptimizersSpec struct {
 	Leader *bool `json:"leader,omitempty"`
+
+	// Zones enables moves which spread replicas of the shards across the topology zones.
+	// Requires topology awareness to be enabled.
+	// +doc/default: false
+	Zones *bool `json:"zones,omitempty"`
 }
 
 func (a *ArangoDeploymentRebalancerOptimizersSpec) IsLeaderEnabled() bool {
@@ -82,3 +145,60 @@ func (a *ArangoDeploymentRebalancerOptimizersSpec) IsLeaderEnabled() bool {
 
 	return *a.Leader
 }
+
+func (a *ArangoDeploymentRebalancerOptimizersSpec) IsZonesEnabled() bool {
+	if a == nil || a.Zones == nil {
+		return false
+	}
+
+	return *a.Zones
+}
+
+type ArangoDeploymentRebalancerBudgetSpec struct {
+	// PerRun defines the maximum amount of the data moved in a single rebalancer run.
+	// Shard bigger than the budget is moved alone, if no data was moved within the last hour.
+	// +doc/type: resource.Quantity
+	// +doc/link: Documentation of resource.Quantity|https://kubernetes.io/docs/reference/generated/kubernetes-api/v1.29/#quantity-resource-core
+	PerRun *resource.Quantity `json:"perRun,omitempty"`
+
+	// PerHour defines the maximum amount of the data moved within the last hour.
+	// +doc/type: resource.Quantity
+	// +doc/link: Documentation of resource.Quantity|https://kubernetes.io/docs/reference/generated/kubernetes-api/v1.29/#quantity-resource-core
+	PerHour *resource.Quantity `json:"perHour,omitempty"`
+}
+
+// GetPerRun returns the maximum amount of the data in bytes moved in a single run
+func (a *ArangoDeploymentRebalancerBudgetSpec) GetPerRun() (int64, bool) {
+	if a == nil || a.PerRun == nil {
+		return 0, false
+	}
+
+	return a.PerRun.Value(), true
+}
+
+// GetPerHour returns the maximum amount of the data in bytes moved within the last hour
+func (a *ArangoDeploymentRebalancerBudgetSpec) GetPerHour() (int64, bool) {
+	if a == nil || a.PerHour == nil {
+		return 0, false
+	}
+
+	return a.PerHour.Value(), true
+}
+
+func (a *ArangoDeploymentRebalancerBudgetSpec) Validate() error {
+	if a == nil {
+		return nil
+	}
+
+	var errs []error
+
+	if a.PerRun != nil && a.PerRun.Sign() <= 0 {
+		errs = append(errs, shared.PrefixResourceError("perRun", errors.Errorf("Budget needs to be positive")))
+	}
+
+	if a.PerHour != nil && a.PerHour.Sign() <= 0 {
+		errs = append(errs, shared.PrefixResourceError("perHour", errors.Errorf("Budget needs to be positive")))
+	}
+
+	return shared.WithErrors(errs...)
+}
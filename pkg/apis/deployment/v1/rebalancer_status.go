@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -20,12 +20,22 @@
 
 package v1
 
-import meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+import (
+	"time"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
 type ArangoDeploymentRebalancerStatus struct {
 	LastCheckTime *meta.Time `json:"lastCheckTime,omitempty"`
 
 	MoveJobs []string `json:"moveJobs,omitempty"`
+
+	// MovedData keeps the amount of the data moved by the rebalancer runs within the last hour
+	MovedData []ArangoDeploymentRebalancerMovedData `json:"movedData,omitempty"`
+
+	// DryRun keeps the move plan computed in the dry-run mode
+	DryRun *ArangoDeploymentRebalancerDryRunStatus `json:"dryRun,omitempty"`
 }
 
 func (a *ArangoDeploymentRebalancerStatus) IsMoveInProgress() bool {
@@ -35,3 +45,84 @@ func (a *ArangoDeploymentRebalancerStatus) IsMoveInProgress() bool {
 
 	return len(a.MoveJobs) > 0
 }
+
+// GetMovedData returns the amount of the data in bytes moved since the given time
+func (a *ArangoDeploymentRebalancerStatus) GetMovedData(since time.Time) int64 {
+	if a == nil {
+		return 0
+	}
+
+	var r int64
+
+	for _, m := range a.MovedData {
+		if m.Time.Time.After(since) {
+			r += m.Bytes
+		}
+	}
+
+	return r
+}
+
+// GetMovedDataSince returns the moved data entries recorded after the given time
+func (a *ArangoDeploymentRebalancerStatus) GetMovedDataSince(since time.Time) []ArangoDeploymentRebalancerMovedData {
+	if a == nil {
+		return nil
+	}
+
+	var r []ArangoDeploymentRebalancerMovedData
+
+	for _, m := range a.MovedData {
+		if m.Time.Time.After(since) {
+			r = append(r, m)
+		}
+	}
+
+	return r
+}
+
+type ArangoDeploymentRebalancerMovedData struct {
+	// Time of the rebalancer run
+	Time meta.Time `json:"time"`
+
+	// Bytes defines the estimated amount of the data moved in the run
+	Bytes int64 `json:"bytes"`
+}
+
+type ArangoDeploymentRebalancerDryRunStatus struct {
+	// Time when the plan was computed
+	Time meta.Time `json:"time"`
+
+	// Moves keeps the list of the computed moves
+	Moves []ArangoDeploymentRebalancerMove `json:"moves,omitempty"`
+
+	// Bytes defines the estimated amount of the data moved by the plan
+	Bytes int64 `json:"bytes"`
+
+	// ImbalanceBefore defines the cluster imbalance before the moves
+	ImbalanceBefore *ArangoDeploymentRebalancerImbalance `json:"imbalanceBefore,omitempty"`
+
+	// ImbalanceAfter defines the expected cluster imbalance after the moves
+	ImbalanceAfter *ArangoDeploymentRebalancerImbalance `json:"imbalanceAfter,omitempty"`
+}
+
+type ArangoDeploymentRebalancerMove struct {
+	Database   string `json:"database"`
+	Collection string `json:"collection"`
+	Shard      string `json:"shard"`
+	From       string `json:"from"`
+	To         string `json:"to"`
+
+	// Leader is set if the leader of the shard is moved
+	Leader bool `json:"leader,omitempty"`
+
+	// Bytes defines the estimated size of the shard
+	Bytes int64 `json:"bytes"`
+}
+
+type ArangoDeploymentRebalancerImbalance struct {
+	// Leader defines the imbalance of the shard leaders
+	Leader float64 `json:"leader"`
+
+	// Shards defines the imbalance of the shards
+	Shards float64 `json:"shards"`
+}
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2023 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -35,6 +35,11 @@ type RocksDBEncryptionSpec struct {
 	// The encryption key cannot be changed after the cluster has been created.
 	// The secret specified by this setting, must have a data field named 'key' containing an encryption key that is exactly 32 bytes long.
 	KeySecretName *string `json:"keySecretName,omitempty"`
+
+	// KMS defines the envelope encryption of the encryption key with an external KMS.
+	// When set, the secret specified by `keySecretName` must have a data field named 'wrappedKey'
+	// containing the encryption key wrapped by the KMS instead of the plain 'key' field.
+	KMS *RocksDBEncryptionKMSSpec `json:"kms,omitempty"`
 }
 
 // GetKeySecretName returns the value of keySecretName.
@@ -48,6 +53,11 @@ func (s RocksDBEncryptionSpec) IsEncrypted() bool {
 	return s.GetKeySecretName() != ""
 }
 
+// IsKMS returns true when the encryption key is wrapped by the KMS
+func (s RocksDBEncryptionSpec) IsKMS() bool {
+	return s.IsEncrypted() && s.KMS != nil
+}
+
 // RocksDBSpec holds rocksdb specific configuration settings
 type RocksDBSpec struct {
 	Encryption RocksDBEncryptionSpec `json:"encryption"`
@@ -64,6 +74,9 @@ func (s RocksDBSpec) Validate() error {
 	if err := shared.ValidateOptionalResourceName(s.Encryption.GetKeySecretName()); err != nil {
 		return errors.WithStack(err)
 	}
+	if err := shared.PrefixResourceError("encryption.kms", s.Encryption.KMS.Validate()); err != nil {
+		return errors.WithStack(err)
+	}
 	return nil
 }
 
@@ -77,6 +90,9 @@ func (s *RocksDBSpec) SetDefaultsFrom(source RocksDBSpec) {
 	if s.Encryption.KeySecretName == nil {
 		s.Encryption.KeySecretName = util.NewTypeOrNil[string](source.Encryption.KeySecretName)
 	}
+	if s.Encryption.KMS == nil {
+		s.Encryption.KMS = source.Encryption.KMS.DeepCopy()
+	}
 }
 
 // ResetImmutableFields replaces all immutable fields in the given target with values from the source spec.
@@ -89,5 +105,10 @@ func (s RocksDBSpec) ResetImmutableFields(fieldPrefix string, target *RocksDBSpe
 		target.Encryption.KeySecretName = util.NewTypeOrNil[string](s.Encryption.KeySecretName)
 		resetFields = append(resetFields, fieldPrefix+".encryption.keySecretName")
 	}
+	if s.Encryption.IsKMS() != target.Encryption.IsKMS() {
+		// Note: The keyfolder content depends on the KMS mode, so it cannot be switched.
+		target.Encryption.KMS = s.Encryption.KMS.DeepCopy()
+		resetFields = append(resetFields, fieldPrefix+".encryption.kms")
+	}
 	return resetFields
 }
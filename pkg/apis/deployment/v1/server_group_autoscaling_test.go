@@ -0,0 +1,82 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func Test_ServerGroupAutoscalingSpec_Defaults(t *testing.T) {
+	var s *ServerGroupAutoscalingSpec
+
+	require.False(t, s.IsEnabled())
+	require.NoError(t, s.Validate(ServerGroupAgents))
+	require.EqualValues(t, 10, s.GetTolerance())
+	require.Equal(t, 1, s.GetMaxScaleUpStep())
+	require.Equal(t, 5*time.Minute, s.GetScaleUpCooldown())
+	require.Equal(t, 30*time.Minute, s.GetScaleDownCooldown())
+}
+
+func Test_ServerGroupAutoscalingSpec_Validate(t *testing.T) {
+	enabled := func(metrics ...ServerGroupAutoscalingMetric) *ServerGroupAutoscalingSpec {
+		return &ServerGroupAutoscalingSpec{
+			Enabled: util.NewType(true),
+			Metrics: metrics,
+		}
+	}
+
+	require.Error(t, enabled().Validate(ServerGroupCoordinators))
+	require.Error(t, enabled(ServerGroupAutoscalingMetric{Type: ServerGroupAutoscalingMetricTypeMemoryUsage, Target: 80}).Validate(ServerGroupAgents))
+	require.Error(t, enabled(ServerGroupAutoscalingMetric{Type: ServerGroupAutoscalingMetricTypeMemoryUsage}).Validate(ServerGroupDBServers))
+	require.Error(t, enabled(ServerGroupAutoscalingMetric{Type: "Unknown", Target: 1}).Validate(ServerGroupDBServers))
+	require.Error(t, enabled(ServerGroupAutoscalingMetric{Type: ServerGroupAutoscalingMetricTypeDiskUsage, Target: 80}).Validate(ServerGroupCoordinators))
+	require.Error(t, enabled(ServerGroupAutoscalingMetric{Type: ServerGroupAutoscalingMetricTypeRequestLatency, Target: 80}).Validate(ServerGroupDBServers))
+
+	require.NoError(t, enabled(ServerGroupAutoscalingMetric{Type: ServerGroupAutoscalingMetricTypeDiskUsage, Target: 80},
+		ServerGroupAutoscalingMetric{Type: ServerGroupAutoscalingMetricTypeMemoryUsage, Target: 70}).Validate(ServerGroupDBServers))
+	require.NoError(t, enabled(ServerGroupAutoscalingMetric{Type: ServerGroupAutoscalingMetricTypeRequestLatency, Target: 200},
+		ServerGroupAutoscalingMetric{Type: ServerGroupAutoscalingMetricTypeQueueLength, Target: 5}).Validate(ServerGroupCoordinators))
+
+	s := enabled(ServerGroupAutoscalingMetric{Type: ServerGroupAutoscalingMetricTypeQueueLength, Target: 5})
+	s.MaxScaleUpStep = util.NewType(0)
+	require.Error(t, s.Validate(ServerGroupCoordinators))
+}
+
+func Test_ServerGroupSpec_Autoscaling_RequiresMaxCount(t *testing.T) {
+	s := ServerGroupSpec{
+		Count: util.NewType(3),
+		Autoscaling: &ServerGroupAutoscalingSpec{
+			Enabled: util.NewType(true),
+			Metrics: []ServerGroupAutoscalingMetric{{Type: ServerGroupAutoscalingMetricTypeQueueLength, Target: 5}},
+		},
+	}
+	s = s.WithGroup(ServerGroupCoordinators)
+
+	require.Error(t, s.Validate(ServerGroupCoordinators, true, DeploymentModeCluster, EnvironmentDevelopment))
+
+	s.MaxCount = util.NewType(5)
+	require.NoError(t, s.Validate(ServerGroupCoordinators, true, DeploymentModeCluster, EnvironmentDevelopment))
+}
@@ -24,6 +24,9 @@ import "github.com/arangodb/kube-arangodb/pkg/util"
 
 type ServerGroupStatus struct {
 	Index *int `json:"index,omitempty"`
+
+	// Autoscaling keeps the status of the group autoscaler
+	Autoscaling *ServerGroupAutoscalingStatus `json:"autoscaling,omitempty"`
 }
 
 func (s *ServerGroupStatus) Equal(b *ServerGroupStatus) bool {
@@ -35,5 +38,6 @@ func (s *ServerGroupStatus) Equal(b *ServerGroupStatus) bool {
 		return false
 	}
 
-	return util.CompareIntp(s.Index, b.Index)
+	return util.CompareIntp(s.Index, b.Index) &&
+		s.Autoscaling.Equal(b.Autoscaling)
 }
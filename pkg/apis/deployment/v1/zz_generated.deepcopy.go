@@ -321,6 +321,113 @@ func (in *ArangoDeploymentList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoDeploymentRebalancerBudgetSpec) DeepCopyInto(out *ArangoDeploymentRebalancerBudgetSpec) {
+	*out = *in
+	if in.PerRun != nil {
+		in, out := &in.PerRun, &out.PerRun
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.PerHour != nil {
+		in, out := &in.PerHour, &out.PerHour
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoDeploymentRebalancerBudgetSpec.
+func (in *ArangoDeploymentRebalancerBudgetSpec) DeepCopy() *ArangoDeploymentRebalancerBudgetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoDeploymentRebalancerBudgetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoDeploymentRebalancerDryRunStatus) DeepCopyInto(out *ArangoDeploymentRebalancerDryRunStatus) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	if in.Moves != nil {
+		in, out := &in.Moves, &out.Moves
+		*out = make([]ArangoDeploymentRebalancerMove, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImbalanceBefore != nil {
+		in, out := &in.ImbalanceBefore, &out.ImbalanceBefore
+		*out = new(ArangoDeploymentRebalancerImbalance)
+		**out = **in
+	}
+	if in.ImbalanceAfter != nil {
+		in, out := &in.ImbalanceAfter, &out.ImbalanceAfter
+		*out = new(ArangoDeploymentRebalancerImbalance)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoDeploymentRebalancerDryRunStatus.
+func (in *ArangoDeploymentRebalancerDryRunStatus) DeepCopy() *ArangoDeploymentRebalancerDryRunStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoDeploymentRebalancerDryRunStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoDeploymentRebalancerImbalance) DeepCopyInto(out *ArangoDeploymentRebalancerImbalance) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoDeploymentRebalancerImbalance.
+func (in *ArangoDeploymentRebalancerImbalance) DeepCopy() *ArangoDeploymentRebalancerImbalance {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoDeploymentRebalancerImbalance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoDeploymentRebalancerMove) DeepCopyInto(out *ArangoDeploymentRebalancerMove) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoDeploymentRebalancerMove.
+func (in *ArangoDeploymentRebalancerMove) DeepCopy() *ArangoDeploymentRebalancerMove {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoDeploymentRebalancerMove)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoDeploymentRebalancerMovedData) DeepCopyInto(out *ArangoDeploymentRebalancerMovedData) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoDeploymentRebalancerMovedData.
+func (in *ArangoDeploymentRebalancerMovedData) DeepCopy() *ArangoDeploymentRebalancerMovedData {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoDeploymentRebalancerMovedData)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArangoDeploymentRebalancerOptimizersSpec) DeepCopyInto(out *ArangoDeploymentRebalancerOptimizersSpec) {
 	*out = *in
@@ -329,6 +436,11 @@ func (in *ArangoDeploymentRebalancerOptimizersSpec) DeepCopyInto(out *ArangoDepl
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -386,6 +498,21 @@ func (in *ArangoDeploymentRebalancerSpec) DeepCopyInto(out *ArangoDeploymentReba
 		*out = new(ArangoDeploymentRebalancerOptimizersSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = new(DeploymentSpecMaintenanceWindows)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Budget != nil {
+		in, out := &in.Budget, &out.Budget
+		*out = new(ArangoDeploymentRebalancerBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -411,6 +538,18 @@ func (in *ArangoDeploymentRebalancerStatus) DeepCopyInto(out *ArangoDeploymentRe
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.MovedData != nil {
+		in, out := &in.MovedData, &out.MovedData
+		*out = make([]ArangoDeploymentRebalancerMovedData, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(ArangoDeploymentRebalancerDryRunStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -424,6 +563,63 @@ func (in *ArangoDeploymentRebalancerStatus) DeepCopy() *ArangoDeploymentRebalanc
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoDeploymentRecoveryAgencyJobsSpec) DeepCopyInto(out *ArangoDeploymentRecoveryAgencyJobsSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Action != nil {
+		in, out := &in.Action, &out.Action
+		*out = new(ArangoDeploymentRecoveryAgencyJobsAction)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoDeploymentRecoveryAgencyJobsSpec.
+func (in *ArangoDeploymentRecoveryAgencyJobsSpec) DeepCopy() *ArangoDeploymentRecoveryAgencyJobsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoDeploymentRecoveryAgencyJobsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoDeploymentRecoveryDBServerFailureSpec) DeepCopyInto(out *ArangoDeploymentRecoveryDBServerFailureSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoDeploymentRecoveryDBServerFailureSpec.
+func (in *ArangoDeploymentRecoveryDBServerFailureSpec) DeepCopy() *ArangoDeploymentRecoveryDBServerFailureSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoDeploymentRecoveryDBServerFailureSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArangoDeploymentRecoverySpec) DeepCopyInto(out *ArangoDeploymentRecoverySpec) {
 	*out = *in
@@ -432,6 +628,21 @@ func (in *ArangoDeploymentRecoverySpec) DeepCopyInto(out *ArangoDeploymentRecove
 		*out = new(bool)
 		**out = **in
 	}
+	if in.DBServerFailure != nil {
+		in, out := &in.DBServerFailure, &out.DBServerFailure
+		*out = new(ArangoDeploymentRecoveryDBServerFailureSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UnschedulableMember != nil {
+		in, out := &in.UnschedulableMember, &out.UnschedulableMember
+		*out = new(ArangoDeploymentRecoveryUnschedulableMemberSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AgencyJobs != nil {
+		in, out := &in.AgencyJobs, &out.AgencyJobs
+		*out = new(ArangoDeploymentRecoveryAgencyJobsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -445,6 +656,37 @@ func (in *ArangoDeploymentRecoverySpec) DeepCopy() *ArangoDeploymentRecoverySpec
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoDeploymentRecoveryUnschedulableMemberSpec) DeepCopyInto(out *ArangoDeploymentRecoveryUnschedulableMemberSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ReleaseLocalVolume != nil {
+		in, out := &in.ReleaseLocalVolume, &out.ReleaseLocalVolume
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoDeploymentRecoveryUnschedulableMemberSpec.
+func (in *ArangoDeploymentRecoveryUnschedulableMemberSpec) DeepCopy() *ArangoDeploymentRecoveryUnschedulableMemberSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoDeploymentRecoveryUnschedulableMemberSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArangoMember) DeepCopyInto(out *ArangoMember) {
 	*out = *in
@@ -700,6 +942,38 @@ func (in *ArangoTaskList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoTaskMemberDetails) DeepCopyInto(out *ArangoTaskMemberDetails) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoTaskMemberDetails.
+func (in *ArangoTaskMemberDetails) DeepCopy() *ArangoTaskMemberDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoTaskMemberDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoTaskProgress) DeepCopyInto(out *ArangoTaskProgress) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoTaskProgress.
+func (in *ArangoTaskProgress) DeepCopy() *ArangoTaskProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoTaskProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArangoTaskSpec) DeepCopyInto(out *ArangoTaskSpec) {
 	*out = *in
@@ -734,6 +1008,19 @@ func (in *ArangoTaskStatus) DeepCopyInto(out *ArangoTaskStatus) {
 		*out = make(ArangoTaskDetails, len(*in))
 		copy(*out, *in)
 	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.FinishTime != nil {
+		in, out := &in.FinishTime, &out.FinishTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(ArangoTaskProgress)
+		**out = **in
+	}
 	return
 }
 
@@ -767,6 +1054,37 @@ func (in Arguments) DeepCopy() Arguments {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationRotationSpec) DeepCopyInto(out *AuthenticationRotationSpec) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Overlap != nil {
+		in, out := &in.Overlap, &out.Overlap
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxKeys != nil {
+		in, out := &in.MaxKeys, &out.MaxKeys
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationRotationSpec.
+func (in *AuthenticationRotationSpec) DeepCopy() *AuthenticationRotationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationRotationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthenticationSpec) DeepCopyInto(out *AuthenticationSpec) {
 	*out = *in
@@ -775,6 +1093,11 @@ func (in *AuthenticationSpec) DeepCopyInto(out *AuthenticationSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(AuthenticationRotationSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -833,6 +1156,49 @@ func (in *BootstrapSpec) DeepCopy() *BootstrapSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosScenario) DeepCopyInto(out *ChaosScenario) {
+	*out = *in
+	if in.Probability != nil {
+		in, out := &in.Probability, &out.Probability
+		*out = new(Percent)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosScenario.
+func (in *ChaosScenario) DeepCopy() *ChaosScenario {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosScenario)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in ChaosScenarios) DeepCopyInto(out *ChaosScenarios) {
+	{
+		in := &in
+		*out = make(ChaosScenarios, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosScenarios.
+func (in ChaosScenarios) DeepCopy() ChaosScenarios {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosScenarios)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChaosSpec) DeepCopyInto(out *ChaosSpec) {
 	*out = *in
@@ -851,6 +1217,23 @@ func (in *ChaosSpec) DeepCopyInto(out *ChaosSpec) {
 		*out = new(Percent)
 		**out = **in
 	}
+	if in.Scenarios != nil {
+		in, out := &in.Scenarios, &out.Scenarios
+		*out = make(ChaosScenarios, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = new(DeploymentSpecMaintenanceWindows)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1126,6 +1509,11 @@ func (in *DeploymentSpec) DeepCopyInto(out *DeploymentSpec) {
 		*out = new(ArangoDeploymentRebalancerSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = new(DeploymentSpecMaintenanceWindows)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Architecture != nil {
 		in, out := &in.Architecture, &out.Architecture
 		*out = make(ArangoDeploymentArchitecture, len(*in))
@@ -1232,28 +1620,77 @@ func (in *DeploymentSpecGatewayAuthentication) DeepCopy() *DeploymentSpecGateway
 	if in == nil {
 		return nil
 	}
-	out := new(DeploymentSpecGatewayAuthentication)
+	out := new(DeploymentSpecGatewayAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentSpecIntegration) DeepCopyInto(out *DeploymentSpecIntegration) {
+	*out = *in
+	if in.Sidecar != nil {
+		in, out := &in.Sidecar, &out.Sidecar
+		*out = new(integration.Sidecar)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentSpecIntegration.
+func (in *DeploymentSpecIntegration) DeepCopy() *DeploymentSpecIntegration {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentSpecIntegration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentSpecMaintenanceWindow) DeepCopyInto(out *DeploymentSpecMaintenanceWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentSpecMaintenanceWindow.
+func (in *DeploymentSpecMaintenanceWindow) DeepCopy() *DeploymentSpecMaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentSpecMaintenanceWindow)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeploymentSpecIntegration) DeepCopyInto(out *DeploymentSpecIntegration) {
+func (in *DeploymentSpecMaintenanceWindows) DeepCopyInto(out *DeploymentSpecMaintenanceWindows) {
 	*out = *in
-	if in.Sidecar != nil {
-		in, out := &in.Sidecar, &out.Sidecar
-		*out = new(integration.Sidecar)
-		(*in).DeepCopyInto(*out)
+	if in.Timezone != nil {
+		in, out := &in.Timezone, &out.Timezone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]DeploymentSpecMaintenanceWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentSpecIntegration.
-func (in *DeploymentSpecIntegration) DeepCopy() *DeploymentSpecIntegration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentSpecMaintenanceWindows.
+func (in *DeploymentSpecMaintenanceWindows) DeepCopy() *DeploymentSpecMaintenanceWindows {
 	if in == nil {
 		return nil
 	}
-	out := new(DeploymentSpecIntegration)
+	out := new(DeploymentSpecMaintenanceWindows)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1310,6 +1747,13 @@ func (in *DeploymentStatus) DeepCopyInto(out *DeploymentStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PlanHistory != nil {
+		in, out := &in.PlanHistory, &out.PlanHistory
+		*out = make(PlanHistory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.AcceptedSpec != nil {
 		in, out := &in.AcceptedSpec, &out.AcceptedSpec
 		*out = new(DeploymentSpec)
@@ -1505,6 +1949,13 @@ func (in *DeploymentStatusHashesJWT) DeepCopyInto(out *DeploymentStatusHashesJWT
 		*out = make(sharedv1.HashList, len(*in))
 		copy(*out, *in)
 	}
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make(DeploymentStatusHashesJWTKeys, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	return
 }
 
@@ -1518,6 +1969,53 @@ func (in *DeploymentStatusHashesJWT) DeepCopy() *DeploymentStatusHashesJWT {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentStatusHashesJWTKey) DeepCopyInto(out *DeploymentStatusHashesJWTKey) {
+	*out = *in
+	in.Created.DeepCopyInto(&out.Created)
+	if in.Activated != nil {
+		in, out := &in.Activated, &out.Activated
+		*out = (*in).DeepCopy()
+	}
+	if in.Retired != nil {
+		in, out := &in.Retired, &out.Retired
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentStatusHashesJWTKey.
+func (in *DeploymentStatusHashesJWTKey) DeepCopy() *DeploymentStatusHashesJWTKey {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentStatusHashesJWTKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in DeploymentStatusHashesJWTKeys) DeepCopyInto(out *DeploymentStatusHashesJWTKeys) {
+	{
+		in := &in
+		*out = make(DeploymentStatusHashesJWTKeys, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentStatusHashesJWTKeys.
+func (in DeploymentStatusHashesJWTKeys) DeepCopy() DeploymentStatusHashesJWTKeys {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentStatusHashesJWTKeys)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeploymentStatusHashesTLS) DeepCopyInto(out *DeploymentStatusHashesTLS) {
 	*out = *in
@@ -2224,6 +2722,52 @@ func (in Plan) DeepCopy() Plan {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in PlanHistory) DeepCopyInto(out *PlanHistory) {
+	{
+		in := &in
+		*out = make(PlanHistory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlanHistory.
+func (in PlanHistory) DeepCopy() PlanHistory {
+	if in == nil {
+		return nil
+	}
+	out := new(PlanHistory)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlanHistoryEntry) DeepCopyInto(out *PlanHistoryEntry) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlanHistoryEntry.
+func (in *PlanHistoryEntry) DeepCopy() *PlanHistoryEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(PlanHistoryEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in PlanLocals) DeepCopyInto(out *PlanLocals) {
 	{
@@ -2246,6 +2790,79 @@ func (in PlanLocals) DeepCopy() PlanLocals {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RocksDBEncryptionKMSFileSpec) DeepCopyInto(out *RocksDBEncryptionKMSFileSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RocksDBEncryptionKMSFileSpec.
+func (in *RocksDBEncryptionKMSFileSpec) DeepCopy() *RocksDBEncryptionKMSFileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RocksDBEncryptionKMSFileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RocksDBEncryptionKMSSpec) DeepCopyInto(out *RocksDBEncryptionKMSSpec) {
+	*out = *in
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(RocksDBEncryptionKMSVaultSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(RocksDBEncryptionKMSFileSpec)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RocksDBEncryptionKMSSpec.
+func (in *RocksDBEncryptionKMSSpec) DeepCopy() *RocksDBEncryptionKMSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RocksDBEncryptionKMSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RocksDBEncryptionKMSVaultSpec) DeepCopyInto(out *RocksDBEncryptionKMSVaultSpec) {
+	*out = *in
+	if in.MountPath != nil {
+		in, out := &in.MountPath, &out.MountPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.CASecretName != nil {
+		in, out := &in.CASecretName, &out.CASecretName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RocksDBEncryptionKMSVaultSpec.
+func (in *RocksDBEncryptionKMSVaultSpec) DeepCopy() *RocksDBEncryptionKMSVaultSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RocksDBEncryptionKMSVaultSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RocksDBEncryptionSpec) DeepCopyInto(out *RocksDBEncryptionSpec) {
 	*out = *in
@@ -2254,6 +2871,11 @@ func (in *RocksDBEncryptionSpec) DeepCopyInto(out *RocksDBEncryptionSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.KMS != nil {
+		in, out := &in.KMS, &out.KMS
+		*out = new(RocksDBEncryptionKMSSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2307,6 +2929,106 @@ func (in *SecretHashes) DeepCopy() *SecretHashes {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerGroupAutoscalingDecision) DeepCopyInto(out *ServerGroupAutoscalingDecision) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerGroupAutoscalingDecision.
+func (in *ServerGroupAutoscalingDecision) DeepCopy() *ServerGroupAutoscalingDecision {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerGroupAutoscalingDecision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerGroupAutoscalingMetric) DeepCopyInto(out *ServerGroupAutoscalingMetric) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerGroupAutoscalingMetric.
+func (in *ServerGroupAutoscalingMetric) DeepCopy() *ServerGroupAutoscalingMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerGroupAutoscalingMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerGroupAutoscalingSpec) DeepCopyInto(out *ServerGroupAutoscalingSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]ServerGroupAutoscalingMetric, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tolerance != nil {
+		in, out := &in.Tolerance, &out.Tolerance
+		*out = new(Percent)
+		**out = **in
+	}
+	if in.MaxScaleUpStep != nil {
+		in, out := &in.MaxScaleUpStep, &out.MaxScaleUpStep
+		*out = new(int)
+		**out = **in
+	}
+	if in.ScaleUpCooldown != nil {
+		in, out := &in.ScaleUpCooldown, &out.ScaleUpCooldown
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ScaleDownCooldown != nil {
+		in, out := &in.ScaleDownCooldown, &out.ScaleDownCooldown
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerGroupAutoscalingSpec.
+func (in *ServerGroupAutoscalingSpec) DeepCopy() *ServerGroupAutoscalingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerGroupAutoscalingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerGroupAutoscalingStatus) DeepCopyInto(out *ServerGroupAutoscalingStatus) {
+	*out = *in
+	if in.LastDecision != nil {
+		in, out := &in.LastDecision, &out.LastDecision
+		*out = new(ServerGroupAutoscalingDecision)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerGroupAutoscalingStatus.
+func (in *ServerGroupAutoscalingStatus) DeepCopy() *ServerGroupAutoscalingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerGroupAutoscalingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServerGroupEnvVar) DeepCopyInto(out *ServerGroupEnvVar) {
 	*out = *in
@@ -2731,6 +3453,11 @@ func (in *ServerGroupSpec) DeepCopyInto(out *ServerGroupSpec) {
 		*out = new(corev1.RestartPolicy)
 		**out = **in
 	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(ServerGroupAutoscalingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3128,6 +3855,11 @@ func (in *ServerGroupStatus) DeepCopyInto(out *ServerGroupStatus) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(ServerGroupAutoscalingStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3336,6 +4068,54 @@ func (in *SyncSpec) DeepCopy() *SyncSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSCertManagerIssuerRef) DeepCopyInto(out *TLSCertManagerIssuerRef) {
+	*out = *in
+	if in.Kind != nil {
+		in, out := &in.Kind, &out.Kind
+		*out = new(TLSCertManagerIssuerKind)
+		**out = **in
+	}
+	if in.Group != nil {
+		in, out := &in.Group, &out.Group
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSCertManagerIssuerRef.
+func (in *TLSCertManagerIssuerRef) DeepCopy() *TLSCertManagerIssuerRef {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSCertManagerIssuerRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSCertManagerSpec) DeepCopyInto(out *TLSCertManagerSpec) {
+	*out = *in
+	in.IssuerRef.DeepCopyInto(&out.IssuerRef)
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSCertManagerSpec.
+func (in *TLSCertManagerSpec) DeepCopy() *TLSCertManagerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSCertManagerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLSSNISpec) DeepCopyInto(out *TLSSNISpec) {
 	*out = *in
@@ -3395,6 +4175,11 @@ func (in *TLSSpec) DeepCopyInto(out *TLSSpec) {
 		*out = new(TLSRotateMode)
 		**out = **in
 	}
+	if in.CertManager != nil {
+		in, out := &in.CertManager, &out.CertManager
+		*out = new(TLSCertManagerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -26,6 +26,9 @@ const (
 	// ActionsDefaultTimeout define default timeout
 	ActionsDefaultTimeout time.Duration = 600 * time.Second // 10m0s
 
+	// ActionAbortAgencyJobDefaultTimeout define default timeout for action ActionAbortAgencyJob
+	ActionAbortAgencyJobDefaultTimeout time.Duration = ActionsDefaultTimeout
+
 	// ActionAddMemberDefaultTimeout define default timeout for action ActionAddMember
 	ActionAddMemberDefaultTimeout time.Duration = 600 * time.Second // 10m0s
 
@@ -68,6 +71,9 @@ const (
 	// ActionCompactMemberDefaultTimeout define default timeout for action ActionCompactMember
 	ActionCompactMemberDefaultTimeout time.Duration = 28800 * time.Second // 8h0m0s
 
+	// ActionDebugInfoCollectDefaultTimeout define default timeout for action ActionDebugInfoCollect
+	ActionDebugInfoCollectDefaultTimeout time.Duration = ActionsDefaultTimeout
+
 	// ActionDelayDefaultTimeout define default timeout for action ActionDelay
 	ActionDelayDefaultTimeout time.Duration = ActionsDefaultTimeout
 
@@ -101,6 +107,9 @@ const (
 	// ActionEncryptionKeyRemoveDefaultTimeout define default timeout for action ActionEncryptionKeyRemove
 	ActionEncryptionKeyRemoveDefaultTimeout time.Duration = ActionsDefaultTimeout
 
+	// ActionEncryptionKeyRewrapDefaultTimeout define default timeout for action ActionEncryptionKeyRewrap
+	ActionEncryptionKeyRewrapDefaultTimeout time.Duration = ActionsDefaultTimeout
+
 	// ActionEncryptionKeyStatusUpdateDefaultTimeout define default timeout for action ActionEncryptionKeyStatusUpdate
 	ActionEncryptionKeyStatusUpdateDefaultTimeout time.Duration = ActionsDefaultTimeout
 
@@ -116,6 +125,12 @@ const (
 	// ActionJWTCleanDefaultTimeout define default timeout for action ActionJWTClean
 	ActionJWTCleanDefaultTimeout time.Duration = ActionsDefaultTimeout
 
+	// ActionJWTGenerateDefaultTimeout define default timeout for action ActionJWTGenerate
+	ActionJWTGenerateDefaultTimeout time.Duration = ActionsDefaultTimeout
+
+	// ActionJWTPromoteDefaultTimeout define default timeout for action ActionJWTPromote
+	ActionJWTPromoteDefaultTimeout time.Duration = ActionsDefaultTimeout
+
 	// ActionJWTPropagatedDefaultTimeout define default timeout for action ActionJWTPropagated
 	ActionJWTPropagatedDefaultTimeout time.Duration = ActionsDefaultTimeout
 
@@ -173,6 +188,9 @@ const (
 	// ActionRebalancerGenerateV2DefaultTimeout define default timeout for action ActionRebalancerGenerateV2
 	ActionRebalancerGenerateV2DefaultTimeout time.Duration = ActionsDefaultTimeout
 
+	// ActionRebalancerWaitV2DefaultTimeout define default timeout for action ActionRebalancerWaitV2
+	ActionRebalancerWaitV2DefaultTimeout time.Duration = 172800 * time.Second // 48h0m0s
+
 	// ActionRebuildOutSyncedShardsDefaultTimeout define default timeout for action ActionRebuildOutSyncedShards
 	ActionRebuildOutSyncedShardsDefaultTimeout time.Duration = 86400 * time.Second // 24h0m0s
 
@@ -203,6 +221,9 @@ const (
 	// ActionResourceSyncDefaultTimeout define default timeout for action ActionResourceSync
 	ActionResourceSyncDefaultTimeout time.Duration = ActionsDefaultTimeout
 
+	// ActionRetryAgencyJobDefaultTimeout define default timeout for action ActionRetryAgencyJob
+	ActionRetryAgencyJobDefaultTimeout time.Duration = ActionsDefaultTimeout
+
 	// ActionRotateMemberDefaultTimeout define default timeout for action ActionRotateMember
 	ActionRotateMemberDefaultTimeout time.Duration = 900 * time.Second // 15m0s
 
@@ -260,6 +281,12 @@ const (
 	// ActionTLSPropagatedDefaultTimeout define default timeout for action ActionTLSPropagated
 	ActionTLSPropagatedDefaultTimeout time.Duration = ActionsDefaultTimeout
 
+	// ActionTaskFinishDefaultTimeout define default timeout for action ActionTaskFinish
+	ActionTaskFinishDefaultTimeout time.Duration = ActionsDefaultTimeout
+
+	// ActionTaskStartDefaultTimeout define default timeout for action ActionTaskStart
+	ActionTaskStartDefaultTimeout time.Duration = ActionsDefaultTimeout
+
 	// ActionTimezoneSecretSetDefaultTimeout define default timeout for action ActionTimezoneSecretSet
 	ActionTimezoneSecretSetDefaultTimeout time.Duration = 1800 * time.Second // 30m0s
 
@@ -295,6 +322,9 @@ const (
 
 	// Actions
 
+	// ActionTypeAbortAgencyJob in scopes Normal. Aborts the stuck agency supervision job
+	ActionTypeAbortAgencyJob ActionType = "AbortAgencyJob"
+
 	// ActionTypeAddMember in scopes Normal. Adds new member to the Member list
 	ActionTypeAddMember ActionType = "AddMember"
 
@@ -337,6 +367,9 @@ const (
 	// ActionTypeCompactMember in scopes Normal. Runs the Compact API on the Member
 	ActionTypeCompactMember ActionType = "CompactMember"
 
+	// ActionTypeDebugInfoCollect in scopes Normal. Collects the deployment debug information into the ArangoTask status
+	ActionTypeDebugInfoCollect ActionType = "DebugInfoCollect"
+
 	// ActionTypeDelay in scopes High and Normal. Define delay operation
 	ActionTypeDelay ActionType = "Delay"
 
@@ -374,6 +407,9 @@ const (
 	// ActionTypeEncryptionKeyRemove in scopes Normal. Remove the encryption key to the pool
 	ActionTypeEncryptionKeyRemove ActionType = "EncryptionKeyRemove"
 
+	// ActionTypeEncryptionKeyRewrap in scopes Normal. Rewrap the encryption keys with the current version of the KMS key-encryption key
+	ActionTypeEncryptionKeyRewrap ActionType = "EncryptionKeyRewrap"
+
 	// ActionTypeEncryptionKeyStatusUpdate in scopes Normal. Update status of encryption propagation
 	ActionTypeEncryptionKeyStatusUpdate ActionType = "EncryptionKeyStatusUpdate"
 
@@ -389,6 +425,12 @@ const (
 	// ActionTypeJWTClean in scopes Normal. Remove JWT key from the pool
 	ActionTypeJWTClean ActionType = "JWTClean"
 
+	// ActionTypeJWTGenerate in scopes Normal. Generates new JWT key and adds it to the pool as passive
+	ActionTypeJWTGenerate ActionType = "JWTGenerate"
+
+	// ActionTypeJWTPromote in scopes Normal. Promotes propagated JWT key to the JWT secret
+	ActionTypeJWTPromote ActionType = "JWTPromote"
+
 	// ActionTypeJWTPropagated in scopes Normal. Update condition of JWT propagation
 	ActionTypeJWTPropagated ActionType = "JWTPropagated"
 
@@ -448,6 +490,9 @@ const (
 	// ActionTypeRebalancerGenerateV2 in scopes Normal. Generates the Rebalancer plan
 	ActionTypeRebalancerGenerateV2 ActionType = "RebalancerGenerateV2"
 
+	// ActionTypeRebalancerWaitV2 in scopes Normal. Waits for the Rebalancer move jobs to finish
+	ActionTypeRebalancerWaitV2 ActionType = "RebalancerWaitV2"
+
 	// ActionTypeRebuildOutSyncedShards in scopes High. Run Rebuild Out Synced Shards procedure for DBServers
 	ActionTypeRebuildOutSyncedShards ActionType = "RebuildOutSyncedShards"
 
@@ -478,6 +523,9 @@ const (
 	// ActionTypeResourceSync in scopes Normal. Runs the Resource sync
 	ActionTypeResourceSync ActionType = "ResourceSync"
 
+	// ActionTypeRetryAgencyJob in scopes Normal. Aborts the stuck agency supervision job and schedules it again with the same parameters
+	ActionTypeRetryAgencyJob ActionType = "RetryAgencyJob"
+
 	// ActionTypeRotateMember in scopes Normal. Waits for Pod restart and recreation
 	ActionTypeRotateMember ActionType = "RotateMember"
 
@@ -539,6 +587,12 @@ const (
 	// ActionTypeTLSPropagated in scopes Normal. Update TLS propagation condition
 	ActionTypeTLSPropagated ActionType = "TLSPropagated"
 
+	// ActionTypeTaskFinish in scopes Normal. Marks the ArangoTask as finished (Success or Failed)
+	ActionTypeTaskFinish ActionType = "TaskFinish"
+
+	// ActionTypeTaskStart in scopes Normal. Marks the ArangoTask as Running and saves the number of the injected actions
+	ActionTypeTaskStart ActionType = "TaskStart"
+
 	// ActionTypeTimezoneSecretSet in scopes Normal. Set timezone details in cluster
 	ActionTypeTimezoneSecretSet ActionType = "TimezoneSecretSet"
 
@@ -575,6 +629,8 @@ const (
 
 func (a ActionType) DefaultTimeout() time.Duration {
 	switch a {
+	case ActionTypeAbortAgencyJob:
+		return ActionAbortAgencyJobDefaultTimeout
 	case ActionTypeAddMember:
 		return ActionAddMemberDefaultTimeout
 	case ActionTypeAppendTLSCACertificate:
@@ -603,6 +659,8 @@ func (a ActionType) DefaultTimeout() time.Duration {
 		return ActionClusterMemberCleanupDefaultTimeout
 	case ActionTypeCompactMember:
 		return ActionCompactMemberDefaultTimeout
+	case ActionTypeDebugInfoCollect:
+		return ActionDebugInfoCollectDefaultTimeout
 	case ActionTypeDelay:
 		return ActionDelayDefaultTimeout
 	case ActionTypeDisableClusterScaling:
@@ -625,6 +683,8 @@ func (a ActionType) DefaultTimeout() time.Duration {
 		return ActionEncryptionKeyRefreshDefaultTimeout
 	case ActionTypeEncryptionKeyRemove:
 		return ActionEncryptionKeyRemoveDefaultTimeout
+	case ActionTypeEncryptionKeyRewrap:
+		return ActionEncryptionKeyRewrapDefaultTimeout
 	case ActionTypeEncryptionKeyStatusUpdate:
 		return ActionEncryptionKeyStatusUpdateDefaultTimeout
 	case ActionTypeEnforceResignLeadership:
@@ -635,6 +695,10 @@ func (a ActionType) DefaultTimeout() time.Duration {
 		return ActionJWTAddDefaultTimeout
 	case ActionTypeJWTClean:
 		return ActionJWTCleanDefaultTimeout
+	case ActionTypeJWTGenerate:
+		return ActionJWTGenerateDefaultTimeout
+	case ActionTypeJWTPromote:
+		return ActionJWTPromoteDefaultTimeout
 	case ActionTypeJWTPropagated:
 		return ActionJWTPropagatedDefaultTimeout
 	case ActionTypeJWTRefresh:
@@ -673,6 +737,8 @@ func (a ActionType) DefaultTimeout() time.Duration {
 		return ActionRebalancerCleanV2DefaultTimeout
 	case ActionTypeRebalancerGenerateV2:
 		return ActionRebalancerGenerateV2DefaultTimeout
+	case ActionTypeRebalancerWaitV2:
+		return ActionRebalancerWaitV2DefaultTimeout
 	case ActionTypeRebuildOutSyncedShards:
 		return ActionRebuildOutSyncedShardsDefaultTimeout
 	case ActionTypeRecreateMember:
@@ -693,6 +759,8 @@ func (a ActionType) DefaultTimeout() time.Duration {
 		return ActionResignLeadershipDefaultTimeout
 	case ActionTypeResourceSync:
 		return ActionResourceSyncDefaultTimeout
+	case ActionTypeRetryAgencyJob:
+		return ActionRetryAgencyJobDefaultTimeout
 	case ActionTypeRotateMember:
 		return ActionRotateMemberDefaultTimeout
 	case ActionTypeRotateStartMember:
@@ -731,6 +799,10 @@ func (a ActionType) DefaultTimeout() time.Duration {
 		return ActionTLSKeyStatusUpdateDefaultTimeout
 	case ActionTypeTLSPropagated:
 		return ActionTLSPropagatedDefaultTimeout
+	case ActionTypeTaskFinish:
+		return ActionTaskFinishDefaultTimeout
+	case ActionTypeTaskStart:
+		return ActionTaskStartDefaultTimeout
 	case ActionTypeTimezoneSecretSet:
 		return ActionTimezoneSecretSetDefaultTimeout
 	case ActionTypeTopologyDisable:
@@ -761,6 +833,8 @@ func (a ActionType) DefaultTimeout() time.Duration {
 // Priority returns action priority
 func (a ActionType) Priority() ActionPriority {
 	switch a {
+	case ActionTypeAbortAgencyJob:
+		return ActionPriorityNormal
 	case ActionTypeAddMember:
 		return ActionPriorityNormal
 	case ActionTypeAppendTLSCACertificate:
@@ -789,6 +863,8 @@ func (a ActionType) Priority() ActionPriority {
 		return ActionPriorityNormal
 	case ActionTypeCompactMember:
 		return ActionPriorityNormal
+	case ActionTypeDebugInfoCollect:
+		return ActionPriorityNormal
 	case ActionTypeDelay:
 		return ActionPriorityHigh
 	case ActionTypeDisableClusterScaling:
@@ -811,6 +887,8 @@ func (a ActionType) Priority() ActionPriority {
 		return ActionPriorityNormal
 	case ActionTypeEncryptionKeyRemove:
 		return ActionPriorityNormal
+	case ActionTypeEncryptionKeyRewrap:
+		return ActionPriorityNormal
 	case ActionTypeEncryptionKeyStatusUpdate:
 		return ActionPriorityNormal
 	case ActionTypeEnforceResignLeadership:
@@ -821,6 +899,10 @@ func (a ActionType) Priority() ActionPriority {
 		return ActionPriorityNormal
 	case ActionTypeJWTClean:
 		return ActionPriorityNormal
+	case ActionTypeJWTGenerate:
+		return ActionPriorityNormal
+	case ActionTypeJWTPromote:
+		return ActionPriorityNormal
 	case ActionTypeJWTPropagated:
 		return ActionPriorityNormal
 	case ActionTypeJWTRefresh:
@@ -859,6 +941,8 @@ func (a ActionType) Priority() ActionPriority {
 		return ActionPriorityNormal
 	case ActionTypeRebalancerGenerateV2:
 		return ActionPriorityNormal
+	case ActionTypeRebalancerWaitV2:
+		return ActionPriorityNormal
 	case ActionTypeRebuildOutSyncedShards:
 		return ActionPriorityHigh
 	case ActionTypeRecreateMember:
@@ -879,6 +963,8 @@ func (a ActionType) Priority() ActionPriority {
 		return ActionPriorityNormal
 	case ActionTypeResourceSync:
 		return ActionPriorityNormal
+	case ActionTypeRetryAgencyJob:
+		return ActionPriorityNormal
 	case ActionTypeRotateMember:
 		return ActionPriorityNormal
 	case ActionTypeRotateStartMember:
@@ -917,6 +1003,10 @@ func (a ActionType) Priority() ActionPriority {
 		return ActionPriorityNormal
 	case ActionTypeTLSPropagated:
 		return ActionPriorityNormal
+	case ActionTypeTaskFinish:
+		return ActionPriorityNormal
+	case ActionTypeTaskStart:
+		return ActionPriorityNormal
 	case ActionTypeTimezoneSecretSet:
 		return ActionPriorityNormal
 	case ActionTypeTopologyDisable:
@@ -959,6 +1049,8 @@ func (a ActionType) Internal() bool {
 		return true
 	case ActionTypeRebalancerGenerateV2:
 		return true
+	case ActionTypeRebalancerWaitV2:
+		return true
 	case ActionTypeSetAnnotation:
 		return true
 	case ActionTypeSetConditionV2:
@@ -971,6 +1063,10 @@ func (a ActionType) Internal() bool {
 		return true
 	case ActionTypeTLSPropagated:
 		return true
+	case ActionTypeTaskFinish:
+		return true
+	case ActionTypeTaskStart:
+		return true
 	case ActionTypeUpToDateUpdate:
 		return true
 	default:
@@ -978,9 +1074,41 @@ func (a ActionType) Internal() bool {
 	}
 }
 
+// Disruptive returns true if action disrupts the running members and is held outside of the maintenance windows
+func (a ActionType) Disruptive() bool {
+	switch a {
+	case ActionTypeJWTSetActive:
+		return true
+	case ActionTypeKillMemberPod:
+		return true
+	case ActionTypeRecreateMember:
+		return true
+	case ActionTypeRemoveMemberPVC:
+		return true
+	case ActionTypeRenewTLSCACertificate:
+		return true
+	case ActionTypeRenewTLSCertificate:
+		return true
+	case ActionTypeRotateMember:
+		return true
+	case ActionTypeRotateStartMember:
+		return true
+	case ActionTypeRuntimeContainerImageUpdate:
+		return true
+	case ActionTypeShutdownMember:
+		return true
+	case ActionTypeUpgradeMember:
+		return true
+	default:
+		return false
+	}
+}
+
 // Optional returns true if action execution wont abort Plan
 func (a ActionType) Optional() bool {
 	switch a {
+	case ActionTypeAbortAgencyJob:
+		return false
 	case ActionTypeAddMember:
 		return false
 	case ActionTypeAppendTLSCACertificate:
@@ -1009,6 +1137,8 @@ func (a ActionType) Optional() bool {
 		return false
 	case ActionTypeCompactMember:
 		return false
+	case ActionTypeDebugInfoCollect:
+		return false
 	case ActionTypeDelay:
 		return true
 	case ActionTypeDisableClusterScaling:
@@ -1031,6 +1161,8 @@ func (a ActionType) Optional() bool {
 		return false
 	case ActionTypeEncryptionKeyRemove:
 		return false
+	case ActionTypeEncryptionKeyRewrap:
+		return false
 	case ActionTypeEncryptionKeyStatusUpdate:
 		return false
 	case ActionTypeEnforceResignLeadership:
@@ -1041,6 +1173,10 @@ func (a ActionType) Optional() bool {
 		return false
 	case ActionTypeJWTClean:
 		return false
+	case ActionTypeJWTGenerate:
+		return false
+	case ActionTypeJWTPromote:
+		return false
 	case ActionTypeJWTPropagated:
 		return false
 	case ActionTypeJWTRefresh:
@@ -1079,6 +1215,8 @@ func (a ActionType) Optional() bool {
 		return false
 	case ActionTypeRebalancerGenerateV2:
 		return false
+	case ActionTypeRebalancerWaitV2:
+		return false
 	case ActionTypeRebuildOutSyncedShards:
 		return false
 	case ActionTypeRecreateMember:
@@ -1099,6 +1237,8 @@ func (a ActionType) Optional() bool {
 		return true
 	case ActionTypeResourceSync:
 		return false
+	case ActionTypeRetryAgencyJob:
+		return false
 	case ActionTypeRotateMember:
 		return false
 	case ActionTypeRotateStartMember:
@@ -1137,6 +1277,10 @@ func (a ActionType) Optional() bool {
 		return false
 	case ActionTypeTLSPropagated:
 		return false
+	case ActionTypeTaskFinish:
+		return false
+	case ActionTypeTaskStart:
+		return false
 	case ActionTypeTimezoneSecretSet:
 		return false
 	case ActionTypeTopologyDisable:
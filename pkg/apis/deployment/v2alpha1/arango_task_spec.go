@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -20,10 +20,60 @@
 
 package v2alpha1
 
-import "encoding/json"
+import (
+	"encoding/json"
+
+	shared "github.com/arangodb/kube-arangodb/pkg/apis/shared"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
 
 type ArangoTaskType string
 
+const (
+	// ArangoTaskCompactType runs the Compact API on the DBServers (or on the selected member)
+	ArangoTaskCompactType ArangoTaskType = "compact"
+	// ArangoTaskRebalanceType generates and executes the Rebalancer plan
+	ArangoTaskRebalanceType ArangoTaskType = "rebalance"
+	// ArangoTaskResignLeadershipType moves the shard leadership out of the selected DBServer
+	ArangoTaskResignLeadershipType ArangoTaskType = "resign-leadership"
+	// ArangoTaskCleanOutMemberType cleans out the selected DBServer and replaces it with a new member
+	ArangoTaskCleanOutMemberType ArangoTaskType = "cleanout-member"
+	// ArangoTaskCollectDebugInfoType collects the deployment debug information into the task status
+	ArangoTaskCollectDebugInfoType ArangoTaskType = "collect-debug-info"
+)
+
+// Validate validates if the task type is supported
+func (a ArangoTaskType) Validate() error {
+	switch a {
+	case ArangoTaskCompactType, ArangoTaskRebalanceType, ArangoTaskResignLeadershipType, ArangoTaskCleanOutMemberType, ArangoTaskCollectDebugInfoType:
+		return nil
+	case "":
+		return errors.Errorf("Task type is required")
+	default:
+		return errors.Errorf("Task type %s is not supported", a)
+	}
+}
+
+// RequiresMember returns true if the task requires member to be selected in the details
+func (a ArangoTaskType) RequiresMember() bool {
+	switch a {
+	case ArangoTaskResignLeadershipType, ArangoTaskCleanOutMemberType:
+		return true
+	default:
+		return false
+	}
+}
+
+// AcceptsMember returns true if the member can be selected in the details
+func (a ArangoTaskType) AcceptsMember() bool {
+	switch a {
+	case ArangoTaskCompactType, ArangoTaskResignLeadershipType, ArangoTaskCleanOutMemberType:
+		return true
+	default:
+		return false
+	}
+}
+
 type ArangoTaskDetails []byte
 
 func (a ArangoTaskDetails) MarshalJSON() ([]byte, error) {
@@ -67,8 +117,83 @@ func (a *ArangoTaskDetails) Set(i interface{}) error {
 var _ json.Unmarshaler = &ArangoTaskDetails{}
 var _ json.Marshaler = ArangoTaskDetails{}
 
+// ArangoTaskMemberDetails defines the details of the tasks which are executed on the single member
+type ArangoTaskMemberDetails struct {
+	// ID of the member on which task is executed
+	ID string `json:"id,omitempty"`
+}
+
+// Validate validates the member details
+func (a ArangoTaskMemberDetails) Validate() error {
+	if a.ID == "" {
+		return shared.PrefixResourceErrors("id", errors.Errorf("Member ID is required"))
+	}
+
+	return nil
+}
+
 type ArangoTaskSpec struct {
 	Type ArangoTaskType `json:"type,omitempty"`
 
+	// Deployment keeps the name of the ArangoDeployment (in the same namespace) on which task is executed
+	Deployment string `json:"deployment,omitempty"`
+
 	Details ArangoTaskDetails `json:"details,omitempty"`
 }
+
+// GetMemberDetails parses the details of the tasks which are executed on the single member
+func (a ArangoTaskSpec) GetMemberDetails() (ArangoTaskMemberDetails, error) {
+	var d ArangoTaskMemberDetails
+
+	if len(a.Details) == 0 {
+		return d, nil
+	}
+
+	if err := json.Unmarshal(a.Details, &d); err != nil {
+		return d, err
+	}
+
+	return d, nil
+}
+
+// Validate validates the task spec with the details defined for the task type
+func (a ArangoTaskSpec) Validate() error {
+	if err := shared.WithErrors(
+		shared.PrefixResourceErrors("type", a.Type.Validate()),
+		shared.PrefixResourceErrors("deployment", shared.ValidateResourceName(a.Deployment)),
+	); err != nil {
+		return err
+	}
+
+	return shared.PrefixResourceErrors("details", a.validateDetails())
+}
+
+func (a ArangoTaskSpec) validateDetails() error {
+	if !a.Type.AcceptsMember() {
+		if len(a.Details) == 0 {
+			return nil
+		}
+
+		var d map[string]interface{}
+		if err := json.Unmarshal(a.Details, &d); err != nil {
+			return err
+		}
+
+		if len(d) != 0 {
+			return errors.Errorf("Task type %s does not accept details", a.Type)
+		}
+
+		return nil
+	}
+
+	d, err := a.GetMemberDetails()
+	if err != nil {
+		return err
+	}
+
+	if !a.Type.RequiresMember() {
+		return nil
+	}
+
+	return d.Validate()
+}
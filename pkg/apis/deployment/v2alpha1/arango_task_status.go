@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -20,6 +20,8 @@
 
 package v2alpha1
 
+import meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 type ArangoTaskState string
 
 const (
@@ -30,9 +32,31 @@ const (
 	ArangoTaskFailedState  ArangoTaskState = "Failed"
 )
 
+// IsFinished returns true if the task reached the final state
+func (a ArangoTaskState) IsFinished() bool {
+	return a == ArangoTaskSuccessState || a == ArangoTaskFailedState
+}
+
 type ArangoTaskStatus struct {
 	AcceptedSpec *ArangoTaskSpec `json:"acceptedSpec,omitempty"`
 
 	State   ArangoTaskState   `json:"state,omitempty"`
 	Details ArangoTaskDetails `json:"details,omitempty"`
+
+	// StartTime keeps the time when the task actions were injected into the deployment plan
+	StartTime *meta.Time `json:"startTime,omitempty"`
+	// FinishTime keeps the time when the task reached the final state
+	FinishTime *meta.Time `json:"finishTime,omitempty"`
+	// Progress keeps the progress of the task actions
+	Progress *ArangoTaskProgress `json:"progress,omitempty"`
+	// Message keeps the reason of the task failure
+	Message string `json:"message,omitempty"`
+}
+
+// ArangoTaskProgress keeps the progress of the task actions
+type ArangoTaskProgress struct {
+	// Total keeps the number of the actions injected into the plan
+	Total int `json:"total"`
+	// Completed keeps the number of the completed actions
+	Completed int `json:"completed"`
 }
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -58,3 +58,47 @@ func arangoTaskDetailsExp(t *testing.T, obj, exp interface{}) {
 		require.EqualValues(t, obj, exp)
 	})
 }
+
+func Test_ArangoTask_Validate(t *testing.T) {
+	withDetails := func(spec ArangoTaskSpec, obj interface{}) ArangoTaskSpec {
+		require.NoError(t, spec.Details.Set(obj))
+		return spec
+	}
+
+	t.Run("Missing type", func(t *testing.T) {
+		require.EqualError(t, ArangoTaskSpec{Deployment: "deployment"}.Validate(), "Received 1 errors: type: Task type is required")
+	})
+
+	t.Run("Unknown type", func(t *testing.T) {
+		require.EqualError(t, ArangoTaskSpec{Type: "unknown", Deployment: "deployment"}.Validate(), "Received 1 errors: type: Task type unknown is not supported")
+	})
+
+	t.Run("Missing deployment", func(t *testing.T) {
+		require.Error(t, ArangoTaskSpec{Type: ArangoTaskCompactType}.Validate())
+	})
+
+	t.Run("Compact without member", func(t *testing.T) {
+		require.NoError(t, ArangoTaskSpec{Type: ArangoTaskCompactType, Deployment: "deployment"}.Validate())
+	})
+
+	t.Run("Compact with member", func(t *testing.T) {
+		require.NoError(t, withDetails(ArangoTaskSpec{Type: ArangoTaskCompactType, Deployment: "deployment"}, ArangoTaskMemberDetails{ID: "PRMR-1"}).Validate())
+	})
+
+	t.Run("Rebalance with member", func(t *testing.T) {
+		require.EqualError(t, withDetails(ArangoTaskSpec{Type: ArangoTaskRebalanceType, Deployment: "deployment"}, ArangoTaskMemberDetails{ID: "PRMR-1"}).Validate(), "Received 1 errors: details: Task type rebalance does not accept details")
+	})
+
+	t.Run("Resign leadership without member", func(t *testing.T) {
+		require.EqualError(t, ArangoTaskSpec{Type: ArangoTaskResignLeadershipType, Deployment: "deployment"}.Validate(), "Received 1 errors: details.id: Member ID is required")
+	})
+
+	t.Run("CleanOut with member", func(t *testing.T) {
+		spec := withDetails(ArangoTaskSpec{Type: ArangoTaskCleanOutMemberType, Deployment: "deployment"}, ArangoTaskMemberDetails{ID: "PRMR-1"})
+		require.NoError(t, spec.Validate())
+
+		d, err := spec.GetMemberDetails()
+		require.NoError(t, err)
+		require.Equal(t, "PRMR-1", d.ID)
+	})
+}
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2023 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -36,6 +36,10 @@ type AuthenticationSpec struct {
 	// If you specify a name of a `Secret` that does not exist, a random key is created and stored in a `Secret` with given name.
 	// Changing secret key results in restarting of a whole cluster.
 	JWTSecretName *string `json:"jwtSecretName,omitempty"`
+
+	// Rotation defines the automatic rotation policy of the JWT signing keys.
+	// Rotation requires ArangoDB with the JWT rotation support.
+	Rotation *AuthenticationRotationSpec `json:"rotation,omitempty"`
 }
 
 const (
@@ -54,6 +58,16 @@ func (s AuthenticationSpec) IsAuthenticated() bool {
 	return s.GetJWTSecretName() != JWTSecretNameDisabled
 }
 
+// GetRotation returns the JWT rotation policy
+func (s AuthenticationSpec) GetRotation() *AuthenticationRotationSpec {
+	return s.Rotation
+}
+
+// IsRotationEnabled returns true if authentication is enabled with the automatic JWT rotation policy
+func (s AuthenticationSpec) IsRotationEnabled() bool {
+	return s.IsAuthenticated() && s.Rotation.IsEnabled()
+}
+
 // Validate the given spec
 func (s AuthenticationSpec) Validate(required bool) error {
 	if required && !s.IsAuthenticated() {
@@ -63,6 +77,9 @@ func (s AuthenticationSpec) Validate(required bool) error {
 		if err := shared.ValidateResourceName(s.GetJWTSecretName()); err != nil {
 			return errors.WithStack(err)
 		}
+		if err := shared.PrefixResourceError("rotation", s.Rotation.Validate()); err != nil {
+			return errors.WithStack(err)
+		}
 	}
 	return nil
 }
@@ -81,6 +98,9 @@ func (s *AuthenticationSpec) SetDefaultsFrom(source AuthenticationSpec) {
 	if s.JWTSecretName == nil {
 		s.JWTSecretName = util.NewTypeOrNil[string](source.JWTSecretName)
 	}
+	if s.Rotation == nil {
+		s.Rotation = source.Rotation.DeepCopy()
+	}
 }
 
 // ResetImmutableFields replaces all immutable fields in the given target with values from the source spec.
@@ -244,6 +244,9 @@ type DeploymentSpec struct {
 	// Rebalancer defines the rebalancer specification
 	Rebalancer *ArangoDeploymentRebalancerSpec `json:"rebalancer,omitempty"`
 
+	// MaintenanceWindows defines the time windows in which disruptive plan actions are allowed
+	MaintenanceWindows *DeploymentSpecMaintenanceWindows `json:"maintenanceWindows,omitempty"`
+
 	// Architecture defines the list of supported architectures.
 	// First element on the list is marked as default architecture.
 	// Possible values are:
@@ -578,6 +581,15 @@ func (s *DeploymentSpec) Validate() error {
 	if err := s.Chaos.Validate(); err != nil {
 		return errors.WithStack(errors.Wrap(err, "spec.chaos"))
 	}
+	if err := s.MaintenanceWindows.Validate(); err != nil {
+		return errors.WithStack(errors.Wrap(err, "spec.maintenanceWindows"))
+	}
+	if err := s.Rebalancer.Validate(); err != nil {
+		return errors.WithStack(errors.Wrap(err, "spec.rebalancer"))
+	}
+	if err := s.Recovery.Validate(); err != nil {
+		return errors.WithStack(errors.Wrap(err, "spec.recovery"))
+	}
 	if err := s.License.Validate(); err != nil {
 		return errors.WithStack(errors.Wrap(err, "spec.licenseKey"))
 	}
@@ -0,0 +1,280 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"time"
+
+	shared "github.com/arangodb/kube-arangodb/pkg/apis/shared"
+	"github.com/arangodb/kube-arangodb/pkg/generated/timezones"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+const (
+	DeploymentSpecMaintenanceWindowsDefaultTimezone = "UTC"
+
+	maintenanceWindowTimeFormat = "15:04"
+)
+
+// DeploymentSpecMaintenanceWindows defines the time windows in which disruptive plan actions
+// (rotations, upgrades, member replacements, TLS and JWT key rotations) are allowed to be executed.
+// Emergency actions, like recovery of failed members, are executed immediately.
+type DeploymentSpecMaintenanceWindows struct {
+	// Timezone of the windows. Must be in format accepted by "tzdata", e.g. `America/New_York` or `Europe/London`
+	// +doc/default: UTC
+	Timezone *string `json:"timezone,omitempty"`
+	// Windows defines the list of the maintenance windows. If empty, disruptive actions are executed immediately.
+	Windows []DeploymentSpecMaintenanceWindow `json:"windows,omitempty"`
+}
+
+// IsEnabled returns true if at least one window is defined
+func (d *DeploymentSpecMaintenanceWindows) IsEnabled() bool {
+	if d == nil {
+		return false
+	}
+
+	return len(d.Windows) > 0
+}
+
+// GetTimezone returns the timezone name of the windows
+func (d *DeploymentSpecMaintenanceWindows) GetTimezone() string {
+	if d == nil {
+		return DeploymentSpecMaintenanceWindowsDefaultTimezone
+	}
+
+	return util.TypeOrDefault(d.Timezone, DeploymentSpecMaintenanceWindowsDefaultTimezone)
+}
+
+// GetLocation returns the location of the windows, loaded from the embedded timezone database
+func (d *DeploymentSpecMaintenanceWindows) GetLocation() (*time.Location, error) {
+	name := d.GetTimezone()
+
+	tz, ok := timezones.GetTimezone(name)
+	if !ok {
+		return nil, errors.Errorf("Unknown timezone %s", name)
+	}
+
+	data, ok := tz.GetData()
+	if !ok {
+		return time.FixedZone(tz.Name, int(tz.Offset/time.Second)), nil
+	}
+
+	return time.LoadLocationFromTZData(tz.Name, data)
+}
+
+// Contains returns true if windows are not enabled or the given time is within one of the windows
+func (d *DeploymentSpecMaintenanceWindows) Contains(t time.Time) bool {
+	if !d.IsEnabled() {
+		return true
+	}
+
+	loc, err := d.GetLocation()
+	if err != nil {
+		return false
+	}
+
+	t = t.In(loc)
+
+	for _, w := range d.Windows {
+		if w.contains(t) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Next returns the time when the next window opens after the given time
+func (d *DeploymentSpecMaintenanceWindows) Next(t time.Time) (time.Time, bool) {
+	if !d.IsEnabled() {
+		return time.Time{}, false
+	}
+
+	loc, err := d.GetLocation()
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	t = t.In(loc)
+
+	var next time.Time
+
+	for _, w := range d.Windows {
+		if n, ok := w.next(t); ok && (next.IsZero() || n.Before(next)) {
+			next = n
+		}
+	}
+
+	return next, !next.IsZero()
+}
+
+func (d *DeploymentSpecMaintenanceWindows) Validate() error {
+	if d == nil {
+		return nil
+	}
+
+	var errs []error
+
+	if _, err := d.GetLocation(); err != nil {
+		errs = append(errs, shared.PrefixResourceError("timezone", err))
+	}
+
+	errs = append(errs, shared.PrefixResourceErrors("windows", shared.ValidateList(d.Windows, func(w DeploymentSpecMaintenanceWindow) error {
+		return w.Validate()
+	})))
+
+	return shared.WithErrors(errs...)
+}
+
+// DeploymentSpecMaintenanceWindow defines the weekly time window
+type DeploymentSpecMaintenanceWindow struct {
+	// Days defines the weekdays in which window opens, e.g. `Monday` or `Sunday`. If empty, window opens every day.
+	Days []string `json:"days,omitempty"`
+	// From defines the start of the window. Format: "HH:MM"
+	// +doc/example: 01:00
+	From string `json:"from"`
+	// To defines the end of the window. Format: "HH:MM". Window can cross midnight, then it ends on the next day.
+	// +doc/example: 05:00
+	To string `json:"to"`
+}
+
+func parseMaintenanceWindowTime(in string) (time.Duration, error) {
+	t, err := time.Parse(maintenanceWindowTimeFormat, in)
+	if err != nil {
+		return 0, errors.Errorf("Invalid time %s, expected format HH:MM", in)
+	}
+
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func parseMaintenanceWindowDay(in string) (time.Weekday, error) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if d.String() == in {
+			return d, nil
+		}
+	}
+
+	return 0, errors.Errorf("Invalid day %s", in)
+}
+
+func (d DeploymentSpecMaintenanceWindow) opensOn(day time.Weekday) bool {
+	if len(d.Days) == 0 {
+		return true
+	}
+
+	for _, v := range d.Days {
+		if w, err := parseMaintenanceWindowDay(v); err == nil && w == day {
+			return true
+		}
+	}
+
+	return false
+}
+
+// bounds returns the start and end of the window opened on the day of the given time
+func (d DeploymentSpecMaintenanceWindow) bounds(t time.Time) (time.Time, time.Time, bool) {
+	from, err := parseMaintenanceWindowTime(d.From)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+
+	to, err := parseMaintenanceWindowTime(d.To)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+
+	start := day.Add(from)
+	end := day.Add(to)
+	if to <= from {
+		end = day.AddDate(0, 0, 1).Add(to)
+	}
+
+	return start, end, true
+}
+
+func (d DeploymentSpecMaintenanceWindow) contains(t time.Time) bool {
+	// Window opened on the previous day can still be open
+	for _, day := range []time.Time{t.AddDate(0, 0, -1), t} {
+		if !d.opensOn(day.Weekday()) {
+			continue
+		}
+
+		start, end, ok := d.bounds(day)
+		if !ok {
+			return false
+		}
+
+		if !t.Before(start) && t.Before(end) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (d DeploymentSpecMaintenanceWindow) next(t time.Time) (time.Time, bool) {
+	for i := 0; i <= 7; i++ {
+		day := t.AddDate(0, 0, i)
+
+		if !d.opensOn(day.Weekday()) {
+			continue
+		}
+
+		start, _, ok := d.bounds(day)
+		if !ok {
+			return time.Time{}, false
+		}
+
+		if start.After(t) {
+			return start, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func (d DeploymentSpecMaintenanceWindow) Validate() error {
+	var errs []error
+
+	errs = append(errs, shared.PrefixResourceErrors("days", shared.ValidateList(d.Days, func(day string) error {
+		_, err := parseMaintenanceWindowDay(day)
+		return err
+	})))
+
+	from, fromErr := parseMaintenanceWindowTime(d.From)
+	if fromErr != nil {
+		errs = append(errs, shared.PrefixResourceError("from", fromErr))
+	}
+
+	to, toErr := parseMaintenanceWindowTime(d.To)
+	if toErr != nil {
+		errs = append(errs, shared.PrefixResourceError("to", toErr))
+	}
+
+	if fromErr == nil && toErr == nil && from == to {
+		errs = append(errs, errors.Errorf("Window needs to be non-empty"))
+	}
+
+	return shared.WithErrors(errs...)
+}
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2023 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -20,7 +20,13 @@
 
 package v2alpha1
 
-import sharedApi "github.com/arangodb/kube-arangodb/pkg/apis/shared/v1"
+import (
+	"time"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	sharedApi "github.com/arangodb/kube-arangodb/pkg/apis/shared/v1"
+)
 
 type DeploymentStatusHashes struct {
 	Encryption DeploymentStatusHashesEncryption `json:"rocksDBEncryption,omitempty"`
@@ -45,5 +51,46 @@ type DeploymentStatusHashesJWT struct {
 	Active  string             `json:"active,omitempty"`
 	Passive sharedApi.HashList `json:"passive,omitempty"`
 
+	// Keys keeps the lifecycle of the JWT keys from the JWT folder, by the key hash
+	Keys DeploymentStatusHashesJWTKeys `json:"keys,omitempty"`
+
 	Propagated bool `json:"propagated,omitempty"`
 }
+
+// DeploymentStatusHashesJWTKeys keeps the lifecycle of the JWT keys, by the key hash
+type DeploymentStatusHashesJWTKeys map[string]DeploymentStatusHashesJWTKey
+
+// Get returns the lifecycle of the key with given hash
+func (d DeploymentStatusHashesJWTKeys) Get(hash string) (DeploymentStatusHashesJWTKey, bool) {
+	if d == nil {
+		return DeploymentStatusHashesJWTKey{}, false
+	}
+
+	k, ok := d[hash]
+	return k, ok
+}
+
+// DeploymentStatusHashesJWTKey keeps the lifecycle of the JWT key
+type DeploymentStatusHashesJWTKey struct {
+	// Created keeps the time when key was added to the JWT folder
+	Created meta.Time `json:"created"`
+	// Activated keeps the time when key was promoted to the active one
+	Activated *meta.Time `json:"activated,omitempty"`
+	// Retired keeps the time when key was replaced by the new active key
+	Retired *meta.Time `json:"retired,omitempty"`
+}
+
+// Age returns the age of the key
+func (d DeploymentStatusHashesJWTKey) Age(now time.Time) time.Duration {
+	return now.Sub(d.Created.Time)
+}
+
+// IsActivated returns true if key was promoted to the active one
+func (d DeploymentStatusHashesJWTKey) IsActivated() bool {
+	return d.Activated != nil
+}
+
+// IsRetired returns true if key was replaced by the new active key
+func (d DeploymentStatusHashesJWTKey) IsRetired() bool {
+	return d.Retired != nil
+}
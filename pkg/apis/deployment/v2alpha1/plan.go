@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2025 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -216,6 +216,12 @@ func (a Action) SetArch(arch ArangoDeploymentArchitectureType) Action {
 	return a
 }
 
+// SetTaskID sets the TaskID field to the given value and returns the modified action.
+func (a Action) SetTaskID(taskID types.UID) Action {
+	a.TaskID = taskID
+	return a
+}
+
 // IsStarted returns true if the action has been started already.
 func (a Action) IsStarted() bool {
 	return !a.StartTime.IsZero()
@@ -264,6 +270,17 @@ func (p Plan) NonInternalActions() int {
 	return z
 }
 
+// Disruptive returns the first disruptive action of the plan
+func (p Plan) Disruptive() (Action, bool) {
+	for id := range p {
+		if p[id].Type.Disruptive() {
+			return p[id], true
+		}
+	}
+
+	return Action{}, false
+}
+
 // After add action at the end of plan
 func (p Plan) After(action ...Action) Plan {
 	n := Plan{}
@@ -312,6 +329,17 @@ func (p Plan) Wrap(before, after Action) Plan {
 	return n
 }
 
+// SetTaskID sets the TaskID on all actions of the plan
+func (p Plan) SetTaskID(taskID types.UID) Plan {
+	n := make(Plan, len(p))
+
+	for id := range p {
+		n[id] = p[id].SetTaskID(taskID)
+	}
+
+	return n
+}
+
 // AfterFirst adds actions when condition will return false
 func (p Plan) AfterFirst(condition func(a Action) bool, actions ...Action) Plan {
 	return util.AppendAfter(p, condition, actions...)
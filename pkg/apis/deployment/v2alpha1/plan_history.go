@@ -0,0 +1,201 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"unicode/utf8"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+const (
+	// DefaultPlanHistoryLimit defines the maximum number of entries kept in the plan history.
+	// History is kept in the ArangoDeployment status, so it needs to stay small. Bookkeeping actions are not recorded
+	DefaultPlanHistoryLimit = 16
+
+	// PlanHistoryEntryMessageLimit defines the maximum length of the reason and error kept in the plan history entry
+	PlanHistoryEntryMessageLimit = 256
+)
+
+// PlanHistoryResult defines the outcome of the executed action
+type PlanHistoryResult string
+
+const (
+	// PlanHistoryResultSuccess action finished successfully
+	PlanHistoryResultSuccess PlanHistoryResult = "Success"
+	// PlanHistoryResultFailed action returned an error and plan has been removed
+	PlanHistoryResultFailed PlanHistoryResult = "Failed"
+	// PlanHistoryResultAborted action has been aborted (timeout or abort request) and plan has been removed
+	PlanHistoryResultAborted PlanHistoryResult = "Aborted"
+	// PlanHistoryResultSkipped action has been skipped on user request
+	PlanHistoryResultSkipped PlanHistoryResult = "Skipped"
+)
+
+// PlanHistoryEntry keeps the information about the single executed action
+type PlanHistoryEntry struct {
+	// ID of the executed action
+	ID string `json:"id"`
+	// Type of the executed action
+	Type ActionType `json:"type"`
+	// Priority of the plan in which action was executed (high, resources, normal)
+	Priority string `json:"priority,omitempty"`
+	// MemberID of the member involved in this action (if any)
+	MemberID string `json:"memberID,omitempty"`
+	// Group involved in this action
+	Group ServerGroup `json:"group,omitempty"`
+	// Reason for this action
+	Reason string `json:"reason,omitempty"`
+	// StartTime is set when the action has been started
+	StartTime *meta.Time `json:"startTime,omitempty"`
+	// EndTime is set when the action has been finished
+	EndTime *meta.Time `json:"endTime,omitempty"`
+	// Result of the action
+	Result PlanHistoryResult `json:"result"`
+	// Error message returned by the action (if any)
+	Error string `json:"error,omitempty"`
+}
+
+// NewPlanHistoryEntry creates history entry for the finished action
+func NewPlanHistoryEntry(action Action, priority string, result PlanHistoryResult, err error, now meta.Time) PlanHistoryEntry {
+	e := PlanHistoryEntry{
+		ID:        action.ID,
+		Type:      action.Type,
+		Priority:  priority,
+		MemberID:  action.MemberID,
+		Group:     action.Group,
+		Reason:    truncatePlanHistoryMessage(action.Reason),
+		StartTime: action.StartTime.DeepCopy(),
+		EndTime:   now.DeepCopy(),
+		Result:    result,
+	}
+
+	if e.StartTime == nil {
+		// Action started and finished in the same iteration
+		e.StartTime = now.DeepCopy()
+	}
+
+	if err != nil {
+		e.Error = truncatePlanHistoryMessage(err.Error())
+	}
+
+	return e
+}
+
+func truncatePlanHistoryMessage(msg string) string {
+	if len(msg) <= PlanHistoryEntryMessageLimit {
+		return msg
+	}
+
+	// Cut on the rune boundary to keep the message valid UTF-8
+	cut := PlanHistoryEntryMessageLimit - 3
+	for cut > 0 && !utf8.RuneStart(msg[cut]) {
+		cut--
+	}
+
+	return msg[:cut] + "..."
+}
+
+// IsPlanHistoryRecorded returns true if the successful execution of the action is kept in the plan history.
+// Condition and phase bookkeeping actions are skipped, as they would evict the meaningful entries.
+func (a ActionType) IsPlanHistoryRecorded() bool {
+	switch a {
+	case ActionTypeSetCondition, ActionTypeSetConditionV2, ActionTypeSetMemberCondition, ActionTypeSetMemberConditionV2,
+		ActionTypeMemberPhaseUpdate, ActionTypeMemberStatusSync, ActionTypeArangoMemberUpdatePodSpec, ActionTypeArangoMemberUpdatePodStatus,
+		ActionTypeSetCurrentImage, ActionTypeIdle:
+		return false
+	default:
+		return true
+	}
+}
+
+// Duration returns time spent on the action execution
+func (p PlanHistoryEntry) Duration() meta.Duration {
+	if p.StartTime == nil || p.EndTime == nil {
+		return meta.Duration{}
+	}
+
+	return meta.Duration{Duration: p.EndTime.Sub(p.StartTime.Time)}
+}
+
+// Equal compares two PlanHistoryEntries
+func (p PlanHistoryEntry) Equal(other PlanHistoryEntry) bool {
+	return p.ID == other.ID &&
+		p.Type == other.Type &&
+		p.Priority == other.Priority &&
+		p.MemberID == other.MemberID &&
+		p.Group == other.Group &&
+		p.Reason == other.Reason &&
+		util.TimeCompareEqualPointer(p.StartTime, other.StartTime) &&
+		util.TimeCompareEqualPointer(p.EndTime, other.EndTime) &&
+		p.Result == other.Result &&
+		p.Error == other.Error
+}
+
+// PlanHistory keeps the list of executed actions, ordered from the oldest one
+type PlanHistory []PlanHistoryEntry
+
+// Equal compares two PlanHistories
+func (p PlanHistory) Equal(other PlanHistory) bool {
+	if len(p) != len(other) {
+		return false
+	}
+
+	for i := range p {
+		if !p[i].Equal(other[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Append returns copy of the history with entries added at the end, keeping at most limit of the newest entries
+func (p PlanHistory) Append(limit int, entries ...PlanHistoryEntry) PlanHistory {
+	r := make(PlanHistory, 0, len(p)+len(entries))
+	r = append(r, p...)
+	r = append(r, entries...)
+
+	if limit > 0 && len(r) > limit {
+		r = r[len(r)-limit:]
+	}
+
+	if len(r) == 0 {
+		return nil
+	}
+
+	return r
+}
+
+// Last returns up to the n newest entries, newest first. Returns all entries if n is not positive
+func (p PlanHistory) Last(n int) PlanHistory {
+	if n <= 0 || n > len(p) {
+		n = len(p)
+	}
+
+	r := make(PlanHistory, 0, n)
+	for i := len(p) - 1; i >= 0 && len(r) < n; i-- {
+		r = append(r, p[i])
+	}
+
+	return r
+}
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2023 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -20,10 +20,36 @@
 
 package v2alpha1
 
-import "github.com/arangodb/kube-arangodb/pkg/util"
+import (
+	"time"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	shared "github.com/arangodb/kube-arangodb/pkg/apis/shared"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// ArangoDeploymentRecoveryDBServerFailureDefaultTimeout defines the default time after which not ready DBServer is considered as failed
+const ArangoDeploymentRecoveryDBServerFailureDefaultTimeout = 15 * time.Minute
+
+// ArangoDeploymentRecoveryUnschedulableMemberDefaultTimeout defines the default time after which not scheduled member is considered as unschedulable
+const ArangoDeploymentRecoveryUnschedulableMemberDefaultTimeout = 10 * time.Minute
+
+// ArangoDeploymentRecoveryAgencyJobsDefaultTimeout defines the default time after which not finished agency job is considered as stuck
+const ArangoDeploymentRecoveryAgencyJobsDefaultTimeout = 30 * time.Minute
 
 type ArangoDeploymentRecoverySpec struct {
 	AutoRecover *bool `json:"autoRecover"`
+
+	// DBServerFailure defines the shard-aware recovery policy of the failed DBServers
+	DBServerFailure *ArangoDeploymentRecoveryDBServerFailureSpec `json:"dbServerFailure,omitempty"`
+
+	// UnschedulableMember defines the recovery policy of the members which pods cannot be scheduled
+	UnschedulableMember *ArangoDeploymentRecoveryUnschedulableMemberSpec `json:"unschedulableMember,omitempty"`
+
+	// AgencyJobs defines the watcher of the agency supervision jobs
+	AgencyJobs *ArangoDeploymentRecoveryAgencyJobsSpec `json:"agencyJobs,omitempty"`
 }
 
 func (a *ArangoDeploymentRecoverySpec) Get() ArangoDeploymentRecoverySpec {
@@ -37,3 +63,212 @@ func (a *ArangoDeploymentRecoverySpec) Get() ArangoDeploymentRecoverySpec {
 func (a ArangoDeploymentRecoverySpec) GetAutoRecover() bool {
 	return util.TypeOrDefault[bool](a.AutoRecover, false)
 }
+
+func (a *ArangoDeploymentRecoverySpec) Validate() error {
+	if a == nil {
+		return nil
+	}
+
+	return shared.WithErrors(
+		shared.PrefixResourceError("dbServerFailure", a.DBServerFailure.Validate()),
+		shared.PrefixResourceError("unschedulableMember", a.UnschedulableMember.Validate()),
+		shared.PrefixResourceError("agencyJobs", a.AgencyJobs.Validate()),
+	)
+}
+
+// ArangoDeploymentRecoveryDBServerFailureSpec defines the shard-aware recovery policy of the failed DBServers.
+// The failed DBServer is replaced only if all shards it keeps have an in-sync replica on the other DBServers.
+type ArangoDeploymentRecoveryDBServerFailureSpec struct {
+	// Enabled enables the shard-aware replacement of the failed DBServers.
+	// DBServer is considered as failed when it is not ready (or cannot be scheduled) longer than `timeout`,
+	// or it has been restarted too often.
+	// +doc/default: false
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Timeout defines how long the DBServer can stay not ready before it is considered as failed
+	// +doc/type: string
+	// +doc/default: 15m
+	Timeout *meta.Duration `json:"timeout,omitempty"`
+}
+
+// IsEnabled returns true if the shard-aware replacement of the failed DBServers is enabled
+func (a *ArangoDeploymentRecoveryDBServerFailureSpec) IsEnabled() bool {
+	if a == nil {
+		return false
+	}
+
+	return util.TypeOrDefault[bool](a.Enabled, false)
+}
+
+// GetTimeout returns the time after which not ready DBServer is considered as failed
+func (a *ArangoDeploymentRecoveryDBServerFailureSpec) GetTimeout() time.Duration {
+	if a == nil || a.Timeout == nil {
+		return ArangoDeploymentRecoveryDBServerFailureDefaultTimeout
+	}
+
+	return a.Timeout.Duration
+}
+
+func (a *ArangoDeploymentRecoveryDBServerFailureSpec) Validate() error {
+	if a == nil {
+		return nil
+	}
+
+	return shared.WithErrors(
+		shared.PrefixResourceError("timeout", shared.ValidateOptional(a.Timeout, func(v meta.Duration) error {
+			if v.Duration <= 0 {
+				return errors.Errorf("Timeout needs to be greater than 0")
+			}
+			return nil
+		})),
+	)
+}
+
+// ArangoDeploymentRecoveryUnschedulableMemberSpec defines the recovery policy of the members which pods cannot be scheduled,
+// for example because the node with their local PersistentVolume is gone.
+type ArangoDeploymentRecoveryUnschedulableMemberSpec struct {
+	// Enabled enables the detection of the members which pods stay Pending/Unschedulable longer than `timeout`.
+	// Detected members are reported with the `MemberUnschedulable` member condition and events.
+	// +doc/default: false
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Timeout defines how long the member pod can stay not scheduled before the member is considered as unschedulable
+	// +doc/type: string
+	// +doc/default: 10m
+	Timeout *meta.Duration `json:"timeout,omitempty"`
+
+	// ReleaseLocalVolume enables the release of the PVC bound to the local PersistentVolume on the missing node.
+	// Replacement PVC is created, pod is scheduled on another node and member data is resynchronized from the replicas.
+	// DBServers are released only if they are not leading any shard.
+	// +doc/default: false
+	ReleaseLocalVolume *bool `json:"releaseLocalVolume,omitempty"`
+}
+
+// IsEnabled returns true if the detection of the unschedulable members is enabled
+func (a *ArangoDeploymentRecoveryUnschedulableMemberSpec) IsEnabled() bool {
+	if a == nil {
+		return false
+	}
+
+	return util.TypeOrDefault[bool](a.Enabled, false)
+}
+
+// GetTimeout returns the time after which not scheduled member is considered as unschedulable
+func (a *ArangoDeploymentRecoveryUnschedulableMemberSpec) GetTimeout() time.Duration {
+	if a == nil || a.Timeout == nil {
+		return ArangoDeploymentRecoveryUnschedulableMemberDefaultTimeout
+	}
+
+	return a.Timeout.Duration
+}
+
+// IsReleaseLocalVolumeEnabled returns true if the local volumes of the unschedulable members can be released
+func (a *ArangoDeploymentRecoveryUnschedulableMemberSpec) IsReleaseLocalVolumeEnabled() bool {
+	if !a.IsEnabled() {
+		return false
+	}
+
+	return util.TypeOrDefault[bool](a.ReleaseLocalVolume, false)
+}
+
+func (a *ArangoDeploymentRecoveryUnschedulableMemberSpec) Validate() error {
+	if a == nil {
+		return nil
+	}
+
+	return shared.WithErrors(
+		shared.PrefixResourceError("timeout", shared.ValidateOptional(a.Timeout, func(v meta.Duration) error {
+			if v.Duration <= 0 {
+				return errors.Errorf("Timeout needs to be greater than 0")
+			}
+			return nil
+		})),
+	)
+}
+
+// ArangoDeploymentRecoveryAgencyJobsSpec defines the watcher of the agency supervision jobs (MoveShard, CleanOutServer, FailedServer...).
+// Jobs which stay in ToDo or Pending phase longer than `timeout` are reported with the `AgencyJobsStuck` condition.
+type ArangoDeploymentRecoveryAgencyJobsSpec struct {
+	// Enabled enables the detection of the stuck agency jobs
+	// +doc/default: false
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Timeout defines how long the agency job can stay in ToDo or Pending phase before it is considered as stuck
+	// +doc/type: string
+	// +doc/default: 30m
+	Timeout *meta.Duration `json:"timeout,omitempty"`
+
+	// Action defines the action taken on the stuck MoveShard and CleanOutServer jobs
+	// +doc/enum: None|Stuck jobs are only reported
+	// +doc/enum: Abort|Stuck jobs are aborted
+	// +doc/enum: Retry|Stuck jobs are aborted and scheduled again with the same parameters
+	Action *ArangoDeploymentRecoveryAgencyJobsAction `json:"action,omitempty"`
+}
+
+// IsEnabled returns true if the detection of the stuck agency jobs is enabled
+func (a *ArangoDeploymentRecoveryAgencyJobsSpec) IsEnabled() bool {
+	if a == nil {
+		return false
+	}
+
+	return util.TypeOrDefault[bool](a.Enabled, false)
+}
+
+// GetTimeout returns the time after which not finished agency job is considered as stuck
+func (a *ArangoDeploymentRecoveryAgencyJobsSpec) GetTimeout() time.Duration {
+	if a == nil || a.Timeout == nil {
+		return ArangoDeploymentRecoveryAgencyJobsDefaultTimeout
+	}
+
+	return a.Timeout.Duration
+}
+
+// GetAction returns the action taken on the stuck agency jobs
+func (a *ArangoDeploymentRecoveryAgencyJobsSpec) GetAction() ArangoDeploymentRecoveryAgencyJobsAction {
+	if !a.IsEnabled() || a.Action == nil {
+		return ArangoDeploymentRecoveryAgencyJobsActionNone
+	}
+
+	return *a.Action
+}
+
+func (a *ArangoDeploymentRecoveryAgencyJobsSpec) Validate() error {
+	if a == nil {
+		return nil
+	}
+
+	return shared.WithErrors(
+		shared.PrefixResourceError("timeout", shared.ValidateOptional(a.Timeout, func(v meta.Duration) error {
+			if v.Duration <= 0 {
+				return errors.Errorf("Timeout needs to be greater than 0")
+			}
+			return nil
+		})),
+		shared.PrefixResourceError("action", shared.ValidateOptionalInterface(a.Action)),
+	)
+}
+
+// ArangoDeploymentRecoveryAgencyJobsAction defines the action taken on the stuck agency jobs
+type ArangoDeploymentRecoveryAgencyJobsAction string
+
+const (
+	// ArangoDeploymentRecoveryAgencyJobsActionNone reports stuck jobs only
+	ArangoDeploymentRecoveryAgencyJobsActionNone ArangoDeploymentRecoveryAgencyJobsAction = "None"
+	// ArangoDeploymentRecoveryAgencyJobsActionAbort aborts stuck jobs
+	ArangoDeploymentRecoveryAgencyJobsActionAbort ArangoDeploymentRecoveryAgencyJobsAction = "Abort"
+	// ArangoDeploymentRecoveryAgencyJobsActionRetry aborts stuck jobs and schedules them again
+	ArangoDeploymentRecoveryAgencyJobsActionRetry ArangoDeploymentRecoveryAgencyJobsAction = "Retry"
+)
+
+func (a *ArangoDeploymentRecoveryAgencyJobsAction) Validate() error {
+	if a == nil {
+		return nil
+	}
+
+	switch v := *a; v {
+	case ArangoDeploymentRecoveryAgencyJobsActionNone, ArangoDeploymentRecoveryAgencyJobsActionAbort, ArangoDeploymentRecoveryAgencyJobsActionRetry:
+		return nil
+	default:
+		return errors.Errorf("Invalid Action `%s`", v)
+	}
+}
@@ -0,0 +1,205 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	shared "github.com/arangodb/kube-arangodb/pkg/apis/shared"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+type RocksDBEncryptionKMSProvider string
+
+const (
+	// RocksDBEncryptionKMSProviderVault wraps the encryption key with the HashiCorp Vault Transit secrets engine
+	RocksDBEncryptionKMSProviderVault RocksDBEncryptionKMSProvider = "Vault"
+	// RocksDBEncryptionKMSProviderFile wraps the encryption key with the key-encryption keys stored in the Kubernetes Secret
+	RocksDBEncryptionKMSProviderFile RocksDBEncryptionKMSProvider = "File"
+)
+
+const (
+	// DefaultRocksDBEncryptionKMSVaultMountPath is the default mount path of the Vault Transit secrets engine
+	DefaultRocksDBEncryptionKMSVaultMountPath = "transit"
+)
+
+// RocksDBEncryptionKMSSpec defines the envelope encryption of the RocksDB encryption key.
+// When set, the `Secret` from `keySecretName` holds the encryption key wrapped by the KMS in the `wrappedKey` field
+// instead of the plain key. The key is unwrapped in the init container of the server Pods and stored only in memory.
+// This requires the Enterprise Edition with encryption key rotation support.
+type RocksDBEncryptionKMSSpec struct {
+	// Provider defines the KMS used to wrap the encryption key
+	// +doc/enum: Vault|HashiCorp Vault Transit secrets engine
+	// +doc/enum: File|Key-encryption keys stored in the Kubernetes Secret, intended for testing
+	Provider RocksDBEncryptionKMSProvider `json:"provider"`
+
+	// Vault defines the HashiCorp Vault Transit settings, required for the `Vault` provider
+	Vault *RocksDBEncryptionKMSVaultSpec `json:"vault,omitempty"`
+
+	// File defines the key-encryption keys, required for the `File` provider
+	File *RocksDBEncryptionKMSFileSpec `json:"file,omitempty"`
+}
+
+// GetProvider returns the KMS provider
+func (s *RocksDBEncryptionKMSSpec) GetProvider() RocksDBEncryptionKMSProvider {
+	if s == nil {
+		return ""
+	}
+
+	return s.Provider
+}
+
+// GetVault returns the Vault settings
+func (s *RocksDBEncryptionKMSSpec) GetVault() *RocksDBEncryptionKMSVaultSpec {
+	if s == nil {
+		return nil
+	}
+
+	return s.Vault
+}
+
+// GetFile returns the File settings
+func (s *RocksDBEncryptionKMSSpec) GetFile() *RocksDBEncryptionKMSFileSpec {
+	if s == nil {
+		return nil
+	}
+
+	return s.File
+}
+
+// Validate the given spec
+func (s *RocksDBEncryptionKMSSpec) Validate() error {
+	if s == nil {
+		return nil
+	}
+
+	switch s.Provider {
+	case RocksDBEncryptionKMSProviderVault:
+		if s.Vault == nil {
+			return shared.PrefixResourceError("vault", errors.Errorf("Vault settings are required for the %s provider", s.Provider))
+		}
+		return shared.PrefixResourceError("vault", s.Vault.Validate())
+	case RocksDBEncryptionKMSProviderFile:
+		if s.File == nil {
+			return shared.PrefixResourceError("file", errors.Errorf("File settings are required for the %s provider", s.Provider))
+		}
+		return shared.PrefixResourceError("file", s.File.Validate())
+	default:
+		return shared.PrefixResourceError("provider", errors.Errorf("Unsupported KMS provider: %s", s.Provider))
+	}
+}
+
+// RocksDBEncryptionKMSVaultSpec defines the HashiCorp Vault Transit secrets engine settings
+type RocksDBEncryptionKMSVaultSpec struct {
+	// Address of the Vault server, e.g. `https://vault.vault.svc:8200`
+	// +doc/required
+	Address string `json:"address"`
+
+	// KeyName is the name of the Transit key used to wrap the encryption key
+	// +doc/required
+	KeyName string `json:"keyName"`
+
+	// MountPath is the mount path of the Transit secrets engine
+	// +doc/default: transit
+	MountPath *string `json:"mountPath,omitempty"`
+
+	// Namespace is the Vault Enterprise namespace
+	Namespace *string `json:"namespace,omitempty"`
+
+	// TokenSecretName is the name of the Kubernetes `Secret` with the Vault token in the `token` field.
+	// The token needs the `encrypt`, `decrypt` and `rewrap` permissions on the Transit key and the `read` permission on the key itself.
+	// +doc/required
+	TokenSecretName string `json:"tokenSecretName"`
+
+	// CASecretName is the name of the Kubernetes `Secret` with the CA certificate in the `ca.crt` field, used to verify the Vault server.
+	// When not set, system CA is used.
+	CASecretName *string `json:"caSecretName,omitempty"`
+}
+
+// GetMountPath returns the mount path of the Transit secrets engine
+func (s *RocksDBEncryptionKMSVaultSpec) GetMountPath() string {
+	if s == nil || s.MountPath == nil || *s.MountPath == "" {
+		return DefaultRocksDBEncryptionKMSVaultMountPath
+	}
+
+	return *s.MountPath
+}
+
+// GetNamespace returns the Vault namespace, empty when not set
+func (s *RocksDBEncryptionKMSVaultSpec) GetNamespace() string {
+	if s == nil || s.Namespace == nil {
+		return ""
+	}
+
+	return *s.Namespace
+}
+
+// GetCASecretName returns the name of the CA secret, empty when not set
+func (s *RocksDBEncryptionKMSVaultSpec) GetCASecretName() string {
+	if s == nil || s.CASecretName == nil {
+		return ""
+	}
+
+	return *s.CASecretName
+}
+
+// Validate the given spec
+func (s *RocksDBEncryptionKMSVaultSpec) Validate() error {
+	if s == nil {
+		return nil
+	}
+
+	var errs []error
+
+	if s.Address == "" {
+		errs = append(errs, shared.PrefixResourceError("address", errors.Errorf("Address cannot be empty")))
+	}
+
+	if s.KeyName == "" {
+		errs = append(errs, shared.PrefixResourceError("keyName", errors.Errorf("KeyName cannot be empty")))
+	}
+
+	if err := shared.ValidateResourceName(s.TokenSecretName); err != nil {
+		errs = append(errs, shared.PrefixResourceError("tokenSecretName", err))
+	}
+
+	if err := shared.ValidateOptionalResourceName(s.GetCASecretName()); err != nil {
+		errs = append(errs, shared.PrefixResourceError("caSecretName", err))
+	}
+
+	return shared.WithErrors(errs...)
+}
+
+// RocksDBEncryptionKMSFileSpec defines the key-encryption keys stored in the Kubernetes Secret
+type RocksDBEncryptionKMSFileSpec struct {
+	// SecretName is the name of the Kubernetes `Secret` with the key-encryption keys.
+	// Each field name is the version of the key (`1`, `2`, ...) and each value is a 32 bytes long key.
+	// The key with the highest version is used to wrap the encryption key.
+	// +doc/required
+	SecretName string `json:"secretName"`
+}
+
+// Validate the given spec
+func (s *RocksDBEncryptionKMSFileSpec) Validate() error {
+	if s == nil {
+		return nil
+	}
+
+	return shared.PrefixResourceError("secretName", shared.ValidateResourceName(s.SecretName))
+}
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2023 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -37,6 +37,60 @@ func TestRocksDBSpecValidate(t *testing.T) {
 	assert.Error(t, RocksDBSpec{Encryption: RocksDBEncryptionSpec{KeySecretName: util.NewType[string]("Foo")}}.Validate())
 }
 
+func TestRocksDBSpecKMS(t *testing.T) {
+	vault := func(mod func(v *RocksDBEncryptionKMSVaultSpec)) RocksDBSpec {
+		v := RocksDBEncryptionKMSVaultSpec{
+			Address:         "https://vault:8200",
+			KeyName:         "arangodb",
+			TokenSecretName: "vault-token",
+		}
+		if mod != nil {
+			mod(&v)
+		}
+		return RocksDBSpec{Encryption: RocksDBEncryptionSpec{
+			KeySecretName: util.NewType[string]("foo"),
+			KMS:           &RocksDBEncryptionKMSSpec{Provider: RocksDBEncryptionKMSProviderVault, Vault: &v},
+		}}
+	}
+
+	assert.False(t, RocksDBEncryptionSpec{KeySecretName: util.NewType[string]("foo")}.IsKMS())
+	assert.False(t, RocksDBEncryptionSpec{KMS: &RocksDBEncryptionKMSSpec{}}.IsKMS())
+	assert.True(t, vault(nil).Encryption.IsKMS())
+
+	// Valid
+	assert.Nil(t, vault(nil).Validate())
+	assert.Nil(t, vault(func(v *RocksDBEncryptionKMSVaultSpec) {
+		v.CASecretName = util.NewType[string]("vault-ca")
+		v.Namespace = util.NewType[string]("admin")
+	}).Validate())
+	assert.Nil(t, RocksDBSpec{Encryption: RocksDBEncryptionSpec{
+		KeySecretName: util.NewType[string]("foo"),
+		KMS:           &RocksDBEncryptionKMSSpec{Provider: RocksDBEncryptionKMSProviderFile, File: &RocksDBEncryptionKMSFileSpec{SecretName: "kek"}},
+	}}.Validate())
+
+	// Not valid
+	assert.Error(t, vault(func(v *RocksDBEncryptionKMSVaultSpec) {
+		v.Address = ""
+	}).Validate())
+	assert.Error(t, vault(func(v *RocksDBEncryptionKMSVaultSpec) {
+		v.TokenSecretName = ""
+	}).Validate())
+	assert.Error(t, vault(func(v *RocksDBEncryptionKMSVaultSpec) {
+		v.CASecretName = util.NewType[string]("Foo")
+	}).Validate())
+	assert.Error(t, RocksDBSpec{Encryption: RocksDBEncryptionSpec{
+		KeySecretName: util.NewType[string]("foo"),
+		KMS:           &RocksDBEncryptionKMSSpec{Provider: RocksDBEncryptionKMSProviderFile},
+	}}.Validate())
+	assert.Error(t, RocksDBSpec{Encryption: RocksDBEncryptionSpec{
+		KeySecretName: util.NewType[string]("foo"),
+		KMS:           &RocksDBEncryptionKMSSpec{Provider: "Unknown"},
+	}}.Validate())
+
+	// Defaults
+	assert.Equal(t, DefaultRocksDBEncryptionKMSVaultMountPath, vault(nil).Encryption.KMS.GetVault().GetMountPath())
+}
+
 func TestRocksDBSpecIsEncrypted(t *testing.T) {
 	assert.False(t, RocksDBSpec{}.IsEncrypted())
 	assert.False(t, RocksDBSpec{Encryption: RocksDBEncryptionSpec{KeySecretName: util.NewType[string]("")}}.IsEncrypted())
@@ -86,6 +140,12 @@ func TestRocksDBSpecResetImmutableFields(t *testing.T) {
 			RocksDBSpec{Encryption: RocksDBEncryptionSpec{KeySecretName: util.NewType[string]("foo")}},
 			[]string{"test.encryption.keySecretName"},
 		},
+		{
+			RocksDBSpec{Encryption: RocksDBEncryptionSpec{KeySecretName: util.NewType[string]("foo")}},
+			RocksDBSpec{Encryption: RocksDBEncryptionSpec{KeySecretName: util.NewType[string]("foo"), KMS: &RocksDBEncryptionKMSSpec{Provider: RocksDBEncryptionKMSProviderFile}}},
+			RocksDBSpec{Encryption: RocksDBEncryptionSpec{KeySecretName: util.NewType[string]("foo")}},
+			[]string{"test.encryption.kms"},
+		},
 	}
 
 	for _, test := range tests {
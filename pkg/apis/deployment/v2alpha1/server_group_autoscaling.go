@@ -0,0 +1,263 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"time"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	shared "github.com/arangodb/kube-arangodb/pkg/apis/shared"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+const (
+	// ServerGroupAutoscalingDefaultTolerance defines the default tolerance (in percent) of the metric around the target
+	ServerGroupAutoscalingDefaultTolerance = 10
+	// ServerGroupAutoscalingDefaultScaleUpCooldown defines the default time between scale operations before scale up
+	ServerGroupAutoscalingDefaultScaleUpCooldown = 5 * time.Minute
+	// ServerGroupAutoscalingDefaultScaleDownCooldown defines the default time between scale operations before scale down
+	ServerGroupAutoscalingDefaultScaleDownCooldown = 30 * time.Minute
+	// ServerGroupAutoscalingDefaultMaxScaleUpStep defines the default number of members added in a single scale up
+	ServerGroupAutoscalingDefaultMaxScaleUpStep = 1
+)
+
+type ServerGroupAutoscalingMetricType string
+
+const (
+	// ServerGroupAutoscalingMetricTypeRequestLatency average request time of the Coordinators in milliseconds
+	ServerGroupAutoscalingMetricTypeRequestLatency ServerGroupAutoscalingMetricType = "RequestLatency"
+	// ServerGroupAutoscalingMetricTypeQueueLength average scheduler queue length of the Coordinators
+	ServerGroupAutoscalingMetricTypeQueueLength ServerGroupAutoscalingMetricType = "QueueLength"
+	// ServerGroupAutoscalingMetricTypeDiskUsage average disk usage of the DBServers in percent
+	ServerGroupAutoscalingMetricTypeDiskUsage ServerGroupAutoscalingMetricType = "DiskUsage"
+	// ServerGroupAutoscalingMetricTypeMemoryUsage average resident memory usage of the members in percent of the available memory
+	ServerGroupAutoscalingMetricTypeMemoryUsage ServerGroupAutoscalingMetricType = "MemoryUsage"
+)
+
+// Validate checks if the metric type is known and supported by the group
+func (m ServerGroupAutoscalingMetricType) Validate(group ServerGroup) error {
+	switch m {
+	case ServerGroupAutoscalingMetricTypeRequestLatency, ServerGroupAutoscalingMetricTypeQueueLength:
+		if group != ServerGroupCoordinators {
+			return errors.Errorf("Metric %s is supported only by %s", m, ServerGroupCoordinatorsString)
+		}
+		return nil
+	case ServerGroupAutoscalingMetricTypeDiskUsage:
+		if group != ServerGroupDBServers {
+			return errors.Errorf("Metric %s is supported only by %s", m, ServerGroupDBServersString)
+		}
+		return nil
+	case ServerGroupAutoscalingMetricTypeMemoryUsage:
+		return nil
+	default:
+		return errors.Errorf("Unknown metric %s", m)
+	}
+}
+
+// ServerGroupAutoscalingMetric defines the metric which drives the autoscaling
+type ServerGroupAutoscalingMetric struct {
+	// Type of the metric
+	// +doc/enum: RequestLatency|Average request time of the Coordinators in milliseconds
+	// +doc/enum: QueueLength|Average scheduler queue length of the Coordinators
+	// +doc/enum: DiskUsage|Average disk usage of the DBServers in percent
+	// +doc/enum: MemoryUsage|Average resident memory usage of the members in percent of the available memory
+	Type ServerGroupAutoscalingMetricType `json:"type"`
+	// Target value of the metric, averaged over all members of the group
+	Target int `json:"target"`
+}
+
+// Validate validates the metric
+func (m ServerGroupAutoscalingMetric) Validate(group ServerGroup) error {
+	if m.Target <= 0 {
+		return shared.PrefixResourceError("target", errors.Errorf("Target needs to be greater than 0"))
+	}
+
+	return shared.PrefixResourceError("type", m.Type.Validate(group))
+}
+
+// ServerGroupAutoscalingSpec defines the autoscaling policy of the group.
+// The count of the group is kept between the `minCount` and `maxCount` of the group.
+type ServerGroupAutoscalingSpec struct {
+	// Enabled defines if the count of the group is managed by the operator based on the metrics
+	// +doc/default: false
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Metrics defines the target metrics. The desired count is the highest count required by any of the metrics
+	Metrics []ServerGroupAutoscalingMetric `json:"metrics,omitempty"`
+
+	// Tolerance defines the percent of deviation from the target which does not trigger scaling
+	// +doc/default: 10
+	Tolerance *Percent `json:"tolerance,omitempty"`
+
+	// MaxScaleUpStep defines the maximum number of members added in a single scale up. Scale down always removes a single member
+	// +doc/default: 1
+	MaxScaleUpStep *int `json:"maxScaleUpStep,omitempty"`
+
+	// ScaleUpCooldown defines the minimum time since the last scale operation before the group is scaled up
+	// +doc/default: 5m
+	ScaleUpCooldown *meta.Duration `json:"scaleUpCooldown,omitempty"`
+
+	// ScaleDownCooldown defines the minimum time since the last scale operation before the group is scaled down
+	// +doc/default: 30m
+	ScaleDownCooldown *meta.Duration `json:"scaleDownCooldown,omitempty"`
+}
+
+// IsEnabled returns true if autoscaling is enabled
+func (s *ServerGroupAutoscalingSpec) IsEnabled() bool {
+	if s == nil {
+		return false
+	}
+
+	return util.TypeOrDefault(s.Enabled, false)
+}
+
+// GetMetrics returns the target metrics
+func (s *ServerGroupAutoscalingSpec) GetMetrics() []ServerGroupAutoscalingMetric {
+	if s == nil {
+		return nil
+	}
+
+	return s.Metrics
+}
+
+// GetTolerance returns the tolerance in percent
+func (s *ServerGroupAutoscalingSpec) GetTolerance() Percent {
+	if s == nil {
+		return ServerGroupAutoscalingDefaultTolerance
+	}
+
+	return PercentOrDefault(s.Tolerance, ServerGroupAutoscalingDefaultTolerance)
+}
+
+// GetMaxScaleUpStep returns the maximum number of members added in a single scale up
+func (s *ServerGroupAutoscalingSpec) GetMaxScaleUpStep() int {
+	if s == nil {
+		return ServerGroupAutoscalingDefaultMaxScaleUpStep
+	}
+
+	return util.TypeOrDefault(s.MaxScaleUpStep, ServerGroupAutoscalingDefaultMaxScaleUpStep)
+}
+
+// GetScaleUpCooldown returns the scale up cooldown
+func (s *ServerGroupAutoscalingSpec) GetScaleUpCooldown() time.Duration {
+	if s == nil || s.ScaleUpCooldown == nil {
+		return ServerGroupAutoscalingDefaultScaleUpCooldown
+	}
+
+	return s.ScaleUpCooldown.Duration
+}
+
+// GetScaleDownCooldown returns the scale down cooldown
+func (s *ServerGroupAutoscalingSpec) GetScaleDownCooldown() time.Duration {
+	if s == nil || s.ScaleDownCooldown == nil {
+		return ServerGroupAutoscalingDefaultScaleDownCooldown
+	}
+
+	return s.ScaleDownCooldown.Duration
+}
+
+// Validate validates the autoscaling policy of the group
+func (s *ServerGroupAutoscalingSpec) Validate(group ServerGroup) error {
+	if !s.IsEnabled() {
+		return nil
+	}
+
+	switch group {
+	case ServerGroupCoordinators, ServerGroupDBServers:
+	default:
+		return errors.Errorf("Autoscaling is supported only by %s and %s", ServerGroupCoordinatorsString, ServerGroupDBServersString)
+	}
+
+	if len(s.Metrics) == 0 {
+		return shared.PrefixResourceError("metrics", errors.Errorf("At least one metric is required"))
+	}
+
+	return shared.WithErrors(
+		shared.PrefixResourceError("metrics", shared.ValidateList(s.Metrics, func(m ServerGroupAutoscalingMetric) error {
+			return m.Validate(group)
+		})),
+		shared.PrefixResourceError("tolerance", s.GetTolerance().Validate()),
+		shared.PrefixResourceError("maxScaleUpStep", shared.ValidateOptional(s.MaxScaleUpStep, func(v int) error {
+			if v <= 0 {
+				return errors.Errorf("MaxScaleUpStep needs to be greater than 0")
+			}
+			return nil
+		})),
+	)
+}
+
+// ServerGroupAutoscalingDecision describes the scale operation done by the autoscaler
+type ServerGroupAutoscalingDecision struct {
+	// Time of the decision
+	Time meta.Time `json:"time"`
+	// From defines the count of the group before the decision
+	From int `json:"from"`
+	// To defines the count of the group after the decision
+	To int `json:"to"`
+	// Reason of the decision
+	Reason string `json:"reason,omitempty"`
+}
+
+// Equal compares two decisions
+func (s *ServerGroupAutoscalingDecision) Equal(b *ServerGroupAutoscalingDecision) bool {
+	if s == nil && b == nil {
+		return true
+	}
+
+	if s == nil || b == nil {
+		return false
+	}
+
+	return util.TimeCompareEqual(s.Time, b.Time) &&
+		s.From == b.From &&
+		s.To == b.To &&
+		s.Reason == b.Reason
+}
+
+// ServerGroupAutoscalingStatus keeps the status of the group autoscaler
+type ServerGroupAutoscalingStatus struct {
+	// LastDecision keeps the last scale operation done by the autoscaler
+	LastDecision *ServerGroupAutoscalingDecision `json:"lastDecision,omitempty"`
+}
+
+// GetLastScaleTime returns the time of the last scale operation
+func (s *ServerGroupAutoscalingStatus) GetLastScaleTime() (time.Time, bool) {
+	if s == nil || s.LastDecision == nil {
+		return time.Time{}, false
+	}
+
+	return s.LastDecision.Time.Time, true
+}
+
+// Equal compares two statuses
+func (s *ServerGroupAutoscalingStatus) Equal(b *ServerGroupAutoscalingStatus) bool {
+	if s == nil && b == nil {
+		return true
+	}
+
+	if s == nil || b == nil {
+		return false
+	}
+
+	return s.LastDecision.Equal(b.LastDecision)
+}
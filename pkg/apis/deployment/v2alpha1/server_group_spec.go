@@ -231,6 +231,10 @@ type ServerGroupSpec struct {
 	// +doc/enum: Never|Means that containers within the pod are never restarted.
 	// +doc/link: Documentation of core.RestartPolicy|https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle/#restart-po
 	RestartPolicy *core.RestartPolicy `json:"restartPolicy,omitempty"`
+
+	// Autoscaling defines the autoscaling policy of the group. Supported only by Coordinators and DBServers.
+	// When enabled, `count` is managed by the operator within `minCount` and `maxCount`
+	Autoscaling *ServerGroupAutoscalingSpec `json:"autoscaling,omitempty"`
 }
 
 func (s *ServerGroupSpec) Get() ServerGroupSpec {
@@ -460,6 +464,12 @@ func (s *ServerGroupSpec) Validate(group ServerGroup, used bool, mode Deployment
 		if err := Arguments(s.Args).Validate(group); err != nil {
 			return err
 		}
+		if s.Autoscaling.IsEnabled() && s.MaxCount == nil {
+			return errors.WithStack(errors.Wrapf(ValidationError, "Invalid maxCount. Required when autoscaling is enabled"))
+		}
+		if err := shared.PrefixResourceError("autoscaling", s.Autoscaling.Validate(group)); err != nil {
+			return errors.WithStack(err)
+		}
 
 		if err := s.validate(); err != nil {
 			return errors.WithStack(err)
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2023 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -70,6 +70,9 @@ func (s SyncSpec) Validate(mode DeploymentMode) error {
 		if err := s.TLS.Validate(); err != nil {
 			return errors.WithStack(err)
 		}
+		if s.TLS.CertManager != nil {
+			return errors.WithStack(errors.Wrapf(ValidationError, "cert-manager is not supported for the sync TLS"))
+		}
 	}
 	if err := s.Monitoring.Validate(); err != nil {
 		return errors.WithStack(err)
@@ -0,0 +1,135 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	shared "github.com/arangodb/kube-arangodb/pkg/apis/shared"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+type TLSCertManagerIssuerKind string
+
+const (
+	// TLSCertManagerIssuerKindIssuer references a namespaced cert-manager Issuer
+	TLSCertManagerIssuerKindIssuer TLSCertManagerIssuerKind = "Issuer"
+	// TLSCertManagerIssuerKindClusterIssuer references a cluster-wide cert-manager ClusterIssuer
+	TLSCertManagerIssuerKindClusterIssuer TLSCertManagerIssuerKind = "ClusterIssuer"
+)
+
+const (
+	// DefaultTLSCertManagerIssuerGroup is the API group of the cert-manager issuers
+	DefaultTLSCertManagerIssuerGroup = "cert-manager.io"
+)
+
+// TLSCertManagerSpec defines the cert-manager integration.
+// When set, server certificates are requested from the referenced issuer using cert-manager `Certificate` objects
+// instead of being signed by the Operator with the CA from `caSecretName`.
+type TLSCertManagerSpec struct {
+	// IssuerRef references the cert-manager `Issuer` or `ClusterIssuer` which issues the server certificates
+	IssuerRef TLSCertManagerIssuerRef `json:"issuerRef"`
+
+	// RenewBefore defines how long before the expiration cert-manager renews the certificates.
+	// When not set, cert-manager default is used.
+	RenewBefore *Duration `json:"renewBefore,omitempty"`
+}
+
+// GetIssuerRef returns the issuer reference
+func (s *TLSCertManagerSpec) GetIssuerRef() TLSCertManagerIssuerRef {
+	if s == nil {
+		return TLSCertManagerIssuerRef{}
+	}
+
+	return s.IssuerRef
+}
+
+// GetRenewBefore returns the renewBefore duration, empty when not set
+func (s *TLSCertManagerSpec) GetRenewBefore() Duration {
+	if s == nil {
+		return ""
+	}
+
+	return DurationOrDefault(s.RenewBefore)
+}
+
+// Validate the given spec
+func (s *TLSCertManagerSpec) Validate() error {
+	if s == nil {
+		return nil
+	}
+
+	return shared.WithErrors(
+		shared.PrefixResourceError("issuerRef", s.IssuerRef.Validate()),
+		shared.PrefixResourceError("renewBefore", shared.ValidateOptional(s.RenewBefore, func(d Duration) error {
+			return d.Validate()
+		})),
+	)
+}
+
+// TLSCertManagerIssuerRef references the cert-manager issuer
+type TLSCertManagerIssuerRef struct {
+	// Name of the issuer
+	// +doc/required
+	Name string `json:"name"`
+
+	// Kind of the issuer
+	// +doc/enum: Issuer|Namespaced cert-manager Issuer in the namespace of the deployment
+	// +doc/enum: ClusterIssuer|Cluster-wide cert-manager ClusterIssuer
+	Kind *TLSCertManagerIssuerKind `json:"kind,omitempty"`
+
+	// Group of the issuer
+	// +doc/default: cert-manager.io
+	Group *string `json:"group,omitempty"`
+}
+
+// GetKind returns the kind of the issuer
+func (r TLSCertManagerIssuerRef) GetKind() TLSCertManagerIssuerKind {
+	if r.Kind == nil {
+		return TLSCertManagerIssuerKindIssuer
+	}
+
+	return *r.Kind
+}
+
+// GetGroup returns the API group of the issuer
+func (r TLSCertManagerIssuerRef) GetGroup() string {
+	if r.Group == nil || *r.Group == "" {
+		return DefaultTLSCertManagerIssuerGroup
+	}
+
+	return *r.Group
+}
+
+// Validate the given reference
+func (r TLSCertManagerIssuerRef) Validate() error {
+	var errs []error
+
+	if err := shared.ValidateResourceName(r.Name); err != nil {
+		errs = append(errs, shared.PrefixResourceError("name", err))
+	}
+
+	switch k := r.GetKind(); k {
+	case TLSCertManagerIssuerKindIssuer, TLSCertManagerIssuerKindClusterIssuer:
+	default:
+		errs = append(errs, shared.PrefixResourceError("kind", errors.Errorf("Unsupported issuer kind: %s", k)))
+	}
+
+	return shared.WithErrors(errs...)
+}
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2024 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -79,6 +79,12 @@ type TLSSpec struct {
 	TTL  *Duration      `json:"ttl,omitempty"`
 	SNI  *TLSSNISpec    `json:"sni,omitempty"`
 	Mode *TLSRotateMode `json:"mode,omitempty"`
+	// CertManager enables issuing of the server certificates by cert-manager.
+	// When set, the Operator does not generate a CA. The `Secret` specified by `caSecretName`
+	// holds only the CA bundle (`ca.crt`) trusted by the deployment. When it does not exist, it is created
+	// from the `ca.crt` field of the issued certificates.
+	// Supported only for the deployment TLS, not for the sync TLS.
+	CertManager *TLSCertManagerSpec `json:"certManager,omitempty"`
 }
 
 const (
@@ -114,6 +120,11 @@ func (s TLSSpec) IsSecure() bool {
 	return s.GetCASecretName() != CASecretNameDisabled
 }
 
+// IsCertManager returns true when the server certificates are issued by cert-manager.
+func (s TLSSpec) IsCertManager() bool {
+	return s.IsSecure() && s.CertManager != nil
+}
+
 // GetParsedAltNames splits the list of AltNames into DNS names, IP addresses & email addresses.
 // When an entry is not valid for any of those categories, an error is returned.
 func (s TLSSpec) GetParsedAltNames() (dnsNames, ipAddresses, emailAddresses []string, err error) {
@@ -143,6 +154,9 @@ func (s TLSSpec) Validate() error {
 		if err := s.GetTTL().Validate(); err != nil {
 			return errors.WithStack(err)
 		}
+		if err := shared.PrefixResourceError("certManager", s.CertManager.Validate()); err != nil {
+			return errors.WithStack(err)
+		}
 	}
 	return nil
 }
@@ -175,4 +189,7 @@ func (s *TLSSpec) SetDefaultsFrom(source TLSSpec) {
 	if s.SNI == nil {
 		s.SNI = source.SNI.DeepCopy()
 	}
+	if s.CertManager == nil {
+		s.CertManager = source.CertManager.DeepCopy()
+	}
 }
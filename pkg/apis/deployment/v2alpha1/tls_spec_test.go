@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2024 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -44,6 +44,36 @@ func TestTLSSpecValidate(t *testing.T) {
 	assert.Error(t, TLSSpec{CASecretName: util.NewType[string]("foo"), AltNames: []string{"@@"}}.Validate())
 }
 
+func TestTLSSpecCertManager(t *testing.T) {
+	assert.False(t, TLSSpec{CASecretName: util.NewType[string]("foo")}.IsCertManager())
+	assert.False(t, TLSSpec{CASecretName: util.NewType[string]("None"), CertManager: &TLSCertManagerSpec{}}.IsCertManager())
+	assert.True(t, TLSSpec{CASecretName: util.NewType[string]("foo"), CertManager: &TLSCertManagerSpec{}}.IsCertManager())
+
+	// Valid
+	assert.Nil(t, TLSSpec{CASecretName: util.NewType[string]("foo"), CertManager: &TLSCertManagerSpec{
+		IssuerRef: TLSCertManagerIssuerRef{Name: "issuer"},
+	}}.Validate())
+	assert.Nil(t, TLSSpec{CASecretName: util.NewType[string]("foo"), CertManager: &TLSCertManagerSpec{
+		IssuerRef:   TLSCertManagerIssuerRef{Name: "issuer", Kind: util.NewType(TLSCertManagerIssuerKindClusterIssuer)},
+		RenewBefore: NewDuration("240h"),
+	}}.Validate())
+
+	// Not valid
+	assert.Error(t, TLSSpec{CASecretName: util.NewType[string]("foo"), CertManager: &TLSCertManagerSpec{}}.Validate())
+	assert.Error(t, TLSSpec{CASecretName: util.NewType[string]("foo"), CertManager: &TLSCertManagerSpec{
+		IssuerRef: TLSCertManagerIssuerRef{Name: "issuer", Kind: util.NewType[TLSCertManagerIssuerKind]("Unknown")},
+	}}.Validate())
+	assert.Error(t, TLSSpec{CASecretName: util.NewType[string]("foo"), CertManager: &TLSCertManagerSpec{
+		IssuerRef:   TLSCertManagerIssuerRef{Name: "issuer"},
+		RenewBefore: NewDuration("invalid"),
+	}}.Validate())
+
+	// Defaults
+	ref := TLSCertManagerIssuerRef{Name: "issuer"}
+	assert.Equal(t, TLSCertManagerIssuerKindIssuer, ref.GetKind())
+	assert.Equal(t, DefaultTLSCertManagerIssuerGroup, ref.GetGroup())
+}
+
 func TestTLSSpecIsSecure(t *testing.T) {
 	assert.True(t, TLSSpec{CASecretName: util.NewType[string]("")}.IsSecure())
 	assert.True(t, TLSSpec{CASecretName: util.NewType[string]("foo")}.IsSecure())
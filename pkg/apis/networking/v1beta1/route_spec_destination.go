@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2024-2025 ArangoDB GmbH, Cologne, Germany
+// Copyright 2024-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -63,6 +63,17 @@ type ArangoRouteSpecDestination struct {
 	// +doc/type: string
 	// +doc/default: 1m0s
 	Timeout *meta.Duration `json:"timeout,omitempty"`
+
+	// Limits defines the request rate limits and upstream connection caps
+	Limits *ArangoRouteSpecDestinationLimits `json:"limits,omitempty"`
+
+	// Weight defines the share of the requests sent to the destination, relative to the weights of the Backends
+	// +doc/default: 100
+	Weight *uint32 `json:"weight,omitempty"`
+
+	// Backends defines additional destinations of the route, selected by the weight or by the request matching rules.
+	// Backends share the connection settings (schema, protocol, TLS, path, authentication, timeout and limits) with the destination
+	Backends ArangoRouteSpecDestinationBackends `json:"backends,omitempty"`
 }
 
 func (a *ArangoRouteSpecDestination) GetService() *ArangoRouteSpecDestinationService {
@@ -139,6 +150,30 @@ func (a *ArangoRouteSpecDestination) GetAuthentication() *ArangoRouteSpecDestina
 	return a.Authentication
 }
 
+func (a *ArangoRouteSpecDestination) GetLimits() *ArangoRouteSpecDestinationLimits {
+	if a == nil || a.Limits == nil {
+		return nil
+	}
+
+	return a.Limits
+}
+
+func (a *ArangoRouteSpecDestination) GetWeight() uint32 {
+	if a == nil || a.Weight == nil {
+		return ArangoRouteSpecDestinationWeightDefault
+	}
+
+	return *a.Weight
+}
+
+func (a *ArangoRouteSpecDestination) GetBackends() ArangoRouteSpecDestinationBackends {
+	if a == nil {
+		return nil
+	}
+
+	return a.Backends
+}
+
 func (a *ArangoRouteSpecDestination) Validate() error {
 	if a == nil {
 		a = &ArangoRouteSpecDestination{}
@@ -153,6 +188,22 @@ func (a *ArangoRouteSpecDestination) Validate() error {
 		shared.ValidateOptionalInterfacePath("protocol", a.Protocol),
 		shared.ValidateOptionalInterfacePath("tls", a.TLS),
 		shared.ValidateOptionalInterfacePath("authentication", a.Authentication),
+		shared.ValidateOptionalInterfacePath("limits", a.Limits),
+		shared.PrefixResourceError("backends", a.Backends.Validate()),
+		shared.PrefixResourceErrorFunc("backends", func() error {
+			if len(a.Backends) > 0 && a.Redirect != nil {
+				return errors.Errorf("Backends are not supported with Redirect")
+			}
+			return nil
+		}),
+		shared.PrefixResourceErrorFunc("weight", func() error {
+			if w := a.GetWeight(); w > ArangoRouteSpecDestinationWeightMax {
+				return errors.Errorf("Weight greater than %d not allowed", ArangoRouteSpecDestinationWeightMax)
+			} else if w == 0 && a.Backends.GetWeights() == 0 {
+				return errors.Errorf("Weight of the destination or at least one backend needs to be greater than 0")
+			}
+			return nil
+		}),
 		shared.PrefixResourceError("path", shared.ValidateAPIPath(a.GetPath())),
 		shared.PrefixResourceErrorFunc("timeout", func() error {
 			if t := a.GetTimeout(); t.Duration < utilConstants.MinEnvoyUpstreamTimeout {
@@ -0,0 +1,134 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1beta1
+
+import (
+	shared "github.com/arangodb/kube-arangodb/pkg/apis/shared"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+const (
+	// ArangoRouteSpecDestinationWeightDefault defines the default weight of the main destination
+	ArangoRouteSpecDestinationWeightDefault uint32 = 100
+
+	// ArangoRouteSpecDestinationWeightMax defines the maximum weight of the destination
+	ArangoRouteSpecDestinationWeightMax uint32 = 10000
+)
+
+type ArangoRouteSpecDestinationBackends []ArangoRouteSpecDestinationBackend
+
+func (a ArangoRouteSpecDestinationBackends) Validate() error {
+	return shared.ValidateList(a, func(backend ArangoRouteSpecDestinationBackend) error {
+		return backend.Validate()
+	}, func(in []ArangoRouteSpecDestinationBackend) error {
+		names := map[string]bool{}
+
+		for _, backend := range in {
+			if names[backend.Name] {
+				return errors.Errorf("Backend name `%s` is not unique", backend.Name)
+			}
+			names[backend.Name] = true
+		}
+
+		return nil
+	})
+}
+
+// GetWeights returns the sum of the backend weights
+func (a ArangoRouteSpecDestinationBackends) GetWeights() uint32 {
+	var r uint32
+
+	for _, backend := range a {
+		r += backend.GetWeight()
+	}
+
+	return r
+}
+
+type ArangoRouteSpecDestinationBackend struct {
+	// Name defines the backend name, unique within the route
+	// +doc/required
+	Name string `json:"name"`
+
+	// Service defines service upstream reference
+	Service *ArangoRouteSpecDestinationService `json:"service,omitempty"`
+
+	// Endpoints defines service upstream reference - which is used to find endpoints
+	Endpoints *ArangoRouteSpecDestinationEndpoints `json:"endpoints,omitempty"`
+
+	// Weight defines the share of the requests sent to the backend, relative to the weight of the main destination and other backends
+	// +doc/default: 0
+	Weight *uint32 `json:"weight,omitempty"`
+
+	// Match defines the request matching rules. Requests matching all rules are sent to the backend, regardless of the weights
+	Match *ArangoRouteSpecDestinationBackendMatch `json:"match,omitempty"`
+}
+
+func (a *ArangoRouteSpecDestinationBackend) GetService() *ArangoRouteSpecDestinationService {
+	if a == nil || a.Service == nil {
+		return nil
+	}
+
+	return a.Service
+}
+
+func (a *ArangoRouteSpecDestinationBackend) GetEndpoints() *ArangoRouteSpecDestinationEndpoints {
+	if a == nil || a.Endpoints == nil {
+		return nil
+	}
+
+	return a.Endpoints
+}
+
+func (a *ArangoRouteSpecDestinationBackend) GetWeight() uint32 {
+	if a == nil {
+		return 0
+	}
+
+	return util.OptionalType(a.Weight, 0)
+}
+
+func (a *ArangoRouteSpecDestinationBackend) Validate() error {
+	if a == nil {
+		a = &ArangoRouteSpecDestinationBackend{}
+	}
+
+	if err := shared.WithErrors(
+		shared.PrefixResourceError("name", shared.ValidateResourceName(a.Name)),
+		shared.ValidateExclusiveFields(a, 1, "Service", "Endpoints"),
+		shared.ValidateOptionalInterfacePath("service", a.Service),
+		shared.ValidateOptionalInterfacePath("endpoints", a.Endpoints),
+		shared.ValidateOptionalInterfacePath("match", a.Match),
+		shared.PrefixResourceErrorFunc("weight", func() error {
+			if w := a.GetWeight(); w > ArangoRouteSpecDestinationWeightMax {
+				return errors.Errorf("Weight greater than %d not allowed", ArangoRouteSpecDestinationWeightMax)
+			} else if w == 0 && a.Match == nil {
+				return errors.Errorf("Weight or Match is required")
+			}
+			return nil
+		}),
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
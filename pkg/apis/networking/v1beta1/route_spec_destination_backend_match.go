@@ -0,0 +1,117 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1beta1
+
+import (
+	"regexp"
+	"strings"
+
+	shared "github.com/arangodb/kube-arangodb/pkg/apis/shared"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+var arangoRouteSpecDestinationBackendMatchNameRE = regexp.MustCompile("^[!#$%&'*+\\-.^_`|~0-9A-Za-z]+$")
+
+type ArangoRouteSpecDestinationBackendMatch struct {
+	// Headers defines the request header rules
+	Headers ArangoRouteSpecDestinationBackendMatchRules `json:"headers,omitempty"`
+
+	// Cookies defines the request cookie rules
+	Cookies ArangoRouteSpecDestinationBackendMatchRules `json:"cookies,omitempty"`
+}
+
+func (a *ArangoRouteSpecDestinationBackendMatch) AsStatus() *ArangoRouteStatusTargetBackendMatch {
+	if a == nil {
+		return nil
+	}
+
+	return &ArangoRouteStatusTargetBackendMatch{
+		Headers: a.Headers.asStatus(),
+		Cookies: a.Cookies.asStatus(),
+	}
+}
+
+func (a *ArangoRouteSpecDestinationBackendMatch) Validate() error {
+	if a == nil {
+		a = &ArangoRouteSpecDestinationBackendMatch{}
+	}
+
+	if len(a.Headers) == 0 && len(a.Cookies) == 0 {
+		return errors.Errorf("At least one header or cookie rule is required")
+	}
+
+	if err := shared.WithErrors(
+		shared.PrefixResourceError("headers", a.Headers.Validate()),
+		shared.PrefixResourceError("cookies", shared.ValidateList(a.Cookies, func(rule ArangoRouteSpecDestinationBackendMatchRule) error {
+			return shared.WithErrors(
+				rule.Validate(),
+				shared.PrefixResourceErrorFunc("value", func() error {
+					if v := rule.Value; v != nil && strings.ContainsAny(*v, "; \t\r\n\"") {
+						return errors.Errorf("Cookie value contains invalid characters")
+					}
+					return nil
+				}),
+			)
+		})),
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type ArangoRouteSpecDestinationBackendMatchRules []ArangoRouteSpecDestinationBackendMatchRule
+
+func (a ArangoRouteSpecDestinationBackendMatchRules) Validate() error {
+	return shared.ValidateInterfaceList(a)
+}
+
+func (a ArangoRouteSpecDestinationBackendMatchRules) asStatus() ArangoRouteStatusTargetBackendMatchRules {
+	if len(a) == 0 {
+		return nil
+	}
+
+	return util.FormatList(a, func(a ArangoRouteSpecDestinationBackendMatchRule) ArangoRouteStatusTargetBackendMatchRule {
+		return ArangoRouteStatusTargetBackendMatchRule{
+			Name:  a.Name,
+			Value: util.NewTypeOrNil(a.Value),
+		}
+	})
+}
+
+type ArangoRouteSpecDestinationBackendMatchRule struct {
+	// Name defines the header or cookie name
+	// +doc/required
+	Name string `json:"name"`
+
+	// Value defines the expected value. If not set, only the presence is checked
+	Value *string `json:"value,omitempty"`
+}
+
+func (a ArangoRouteSpecDestinationBackendMatchRule) Validate() error {
+	return shared.PrefixResourceErrorFunc("name", func() error {
+		if !arangoRouteSpecDestinationBackendMatchNameRE.MatchString(a.Name) {
+			return errors.Errorf("Invalid name: `%s`", a.Name)
+		}
+		return nil
+	})
+}
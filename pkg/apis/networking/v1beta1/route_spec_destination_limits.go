@@ -0,0 +1,132 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1beta1
+
+import (
+	shared "github.com/arangodb/kube-arangodb/pkg/apis/shared"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+type ArangoRouteSpecDestinationLimits struct {
+	// RequestsPerSecond defines the number of requests per second allowed on the route.
+	// Requests above the limit are rejected with the 429 code.
+	RequestsPerSecond *uint32 `json:"requestsPerSecond,omitempty"`
+
+	// Burst defines the number of requests allowed at once, above the requests per second rate
+	// +doc/default: requestsPerSecond
+	Burst *uint32 `json:"burst,omitempty"`
+
+	// Key defines how requests are grouped for the rate limit
+	// +doc/default: route
+	// +doc/enum: route|Limit is shared by all requests on the route
+	// +doc/enum: user|Limit is applied per authenticated user, unauthenticated requests share the route limit
+	Key *ArangoRouteSpecDestinationLimitsKey `json:"key,omitempty"`
+
+	// MaxConnections defines the maximum number of concurrent connections to the upstream
+	MaxConnections *uint32 `json:"maxConnections,omitempty"`
+
+	// MaxPendingRequests defines the maximum number of requests waiting for the upstream connection
+	MaxPendingRequests *uint32 `json:"maxPendingRequests,omitempty"`
+
+	// MaxRequests defines the maximum number of concurrent requests to the upstream
+	MaxRequests *uint32 `json:"maxRequests,omitempty"`
+}
+
+func (a *ArangoRouteSpecDestinationLimits) AsStatus() *ArangoRouteStatusTargetLimits {
+	if a == nil {
+		return nil
+	}
+
+	var s ArangoRouteStatusTargetLimits
+
+	if a.RequestsPerSecond != nil {
+		s.RateLimit = &ArangoRouteStatusTargetLimitsRate{
+			RequestsPerSecond: *a.RequestsPerSecond,
+			Burst:             util.OptionalType(a.Burst, *a.RequestsPerSecond),
+			Key:               a.Key.Get(),
+		}
+	}
+
+	s.MaxConnections = util.NewTypeOrNil(a.MaxConnections)
+	s.MaxPendingRequests = util.NewTypeOrNil(a.MaxPendingRequests)
+	s.MaxRequests = util.NewTypeOrNil(a.MaxRequests)
+
+	return &s
+}
+
+func (a *ArangoRouteSpecDestinationLimits) Validate() error {
+	if a == nil {
+		return nil
+	}
+
+	if err := shared.WithErrors(
+		shared.PrefixResourceErrorFunc("requestsPerSecond", func() error {
+			if a.RequestsPerSecond != nil && *a.RequestsPerSecond == 0 {
+				return errors.Errorf("RequestsPerSecond must be greater than 0")
+			}
+			return nil
+		}),
+		shared.PrefixResourceErrorFunc("burst", func() error {
+			if a.Burst == nil {
+				return nil
+			}
+			if a.RequestsPerSecond == nil {
+				return errors.Errorf("Burst requires RequestsPerSecond to be defined")
+			}
+			if *a.Burst < *a.RequestsPerSecond {
+				return errors.Errorf("Burst must not be lower than RequestsPerSecond")
+			}
+			return nil
+		}),
+		shared.PrefixResourceErrorFunc("key", func() error {
+			if a.Key == nil {
+				return nil
+			}
+			if a.RequestsPerSecond == nil {
+				return errors.Errorf("Key requires RequestsPerSecond to be defined")
+			}
+			return a.Key.Validate()
+		}),
+		shared.PrefixResourceErrorFunc("maxConnections", func() error {
+			if a.MaxConnections != nil && *a.MaxConnections == 0 {
+				return errors.Errorf("MaxConnections must be greater than 0")
+			}
+			return nil
+		}),
+		shared.PrefixResourceErrorFunc("maxPendingRequests", func() error {
+			if a.MaxPendingRequests != nil && *a.MaxPendingRequests == 0 {
+				return errors.Errorf("MaxPendingRequests must be greater than 0")
+			}
+			return nil
+		}),
+		shared.PrefixResourceErrorFunc("maxRequests", func() error {
+			if a.MaxRequests != nil && *a.MaxRequests == 0 {
+				return errors.Errorf("MaxRequests must be greater than 0")
+			}
+			return nil
+		}),
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2024-2025 ArangoDB GmbH, Cologne, Germany
+// Copyright 2024-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -58,6 +58,15 @@ type ArangoRouteStatusTarget struct {
 
 	// Redirect defines the route status
 	Redirect ArangoRouteStatusTargetRedirect `json:"redirect,omitempty"`
+
+	// Limits defines the request rate limits and upstream connection caps
+	Limits *ArangoRouteStatusTargetLimits `json:"limits,omitempty"`
+
+	// Weight defines the share of the requests sent to the destinations, set only if Backends are defined
+	Weight uint32 `json:"weight,omitempty"`
+
+	// Backends keeps the additional destinations of the route
+	Backends ArangoRouteStatusTargetBackends `json:"backends,omitempty"`
 }
 
 func (a *ArangoRouteStatusTarget) RenderURLs() []string {
@@ -84,5 +93,5 @@ func (a *ArangoRouteStatusTarget) Hash() string {
 	if a == nil {
 		return ""
 	}
-	return util.SHA256FromNonEmptyStringArray(a.Destinations.Hash(), a.Type.Hash(), a.TLS.Hash(), a.Protocol.String(), a.Path, a.Authentication.Hash(), a.Options.Hash(), a.Timeout.String(), a.Route.Hash(), a.Redirect.Hash())
+	return util.SHA256FromNonEmptyStringArray(a.Destinations.Hash(), a.Type.Hash(), a.TLS.Hash(), a.Protocol.String(), a.Path, a.Authentication.Hash(), a.Options.Hash(), a.Timeout.String(), a.Route.Hash(), a.Redirect.Hash(), a.Limits.Hash(), util.BoolSwitch(a.Weight == 0, "", fmt.Sprintf("%d", a.Weight)), a.Backends.Hash())
 }
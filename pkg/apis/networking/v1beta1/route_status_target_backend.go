@@ -0,0 +1,67 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1beta1
+
+import (
+	"fmt"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+type ArangoRouteStatusTargetBackends []ArangoRouteStatusTargetBackend
+
+func (a ArangoRouteStatusTargetBackends) Hash() string {
+	if len(a) == 0 {
+		return ""
+	}
+
+	return util.SHA256FromStringArray(util.FormatList(a, func(a ArangoRouteStatusTargetBackend) string {
+		return a.Hash()
+	})...)
+}
+
+type ArangoRouteStatusTargetBackend struct {
+	// Name defines the backend name
+	Name string `json:"name"`
+
+	// Weight defines the share of the requests sent to the backend
+	Weight uint32 `json:"weight,omitempty"`
+
+	// Match keeps the request matching rules
+	Match *ArangoRouteStatusTargetBackendMatch `json:"match,omitempty"`
+
+	// Destinations keeps backend destinations
+	Destinations ArangoRouteStatusTargetDestinations `json:"destinations,omitempty"`
+
+	// Ready defines if the backend destinations are discovered
+	Ready bool `json:"ready"`
+
+	// Message keeps the reason of the backend not being ready
+	Message string `json:"message,omitempty"`
+}
+
+func (a *ArangoRouteStatusTargetBackend) Hash() string {
+	if a == nil {
+		return ""
+	}
+
+	return util.SHA256FromStringArray(a.Name, fmt.Sprintf("%d", a.Weight), a.Match.Hash(), a.Destinations.Hash(), util.BoolSwitch(a.Ready, "true", "false"), a.Message)
+}
@@ -0,0 +1,71 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1beta1
+
+import "github.com/arangodb/kube-arangodb/pkg/util"
+
+type ArangoRouteStatusTargetBackendMatch struct {
+	// Headers keeps the request header rules
+	Headers ArangoRouteStatusTargetBackendMatchRules `json:"headers,omitempty"`
+
+	// Cookies keeps the request cookie rules
+	Cookies ArangoRouteStatusTargetBackendMatchRules `json:"cookies,omitempty"`
+}
+
+func (a *ArangoRouteStatusTargetBackendMatch) Hash() string {
+	if a == nil {
+		return ""
+	}
+
+	return util.SHA256FromStringArray(a.Headers.Hash(), a.Cookies.Hash())
+}
+
+type ArangoRouteStatusTargetBackendMatchRules []ArangoRouteStatusTargetBackendMatchRule
+
+func (a ArangoRouteStatusTargetBackendMatchRules) Hash() string {
+	if len(a) == 0 {
+		return ""
+	}
+
+	return util.SHA256FromStringArray(util.FormatList(a, func(a ArangoRouteStatusTargetBackendMatchRule) string {
+		return a.Hash()
+	})...)
+}
+
+type ArangoRouteStatusTargetBackendMatchRule struct {
+	// Name defines the header or cookie name
+	Name string `json:"name"`
+
+	// Value defines the expected value. If not set, only the presence is checked
+	Value *string `json:"value,omitempty"`
+}
+
+func (a *ArangoRouteStatusTargetBackendMatchRule) Hash() string {
+	if a == nil {
+		return ""
+	}
+
+	if a.Value == nil {
+		return util.SHA256FromStringArray(a.Name)
+	}
+
+	return util.SHA256FromStringArray(a.Name, "=", *a.Value)
+}
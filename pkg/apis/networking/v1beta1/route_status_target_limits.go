@@ -0,0 +1,76 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1beta1
+
+import (
+	"fmt"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+type ArangoRouteStatusTargetLimits struct {
+	// RateLimit keeps the request rate limit
+	RateLimit *ArangoRouteStatusTargetLimitsRate `json:"rateLimit,omitempty"`
+
+	// MaxConnections defines the maximum number of concurrent connections to the upstream
+	MaxConnections *uint32 `json:"maxConnections,omitempty"`
+
+	// MaxPendingRequests defines the maximum number of requests waiting for the upstream connection
+	MaxPendingRequests *uint32 `json:"maxPendingRequests,omitempty"`
+
+	// MaxRequests defines the maximum number of concurrent requests to the upstream
+	MaxRequests *uint32 `json:"maxRequests,omitempty"`
+}
+
+func (a *ArangoRouteStatusTargetLimits) Hash() string {
+	if a == nil {
+		return ""
+	}
+
+	return util.SHA256FromStringArray(a.RateLimit.Hash(), optionalUint32Hash(a.MaxConnections), optionalUint32Hash(a.MaxPendingRequests), optionalUint32Hash(a.MaxRequests))
+}
+
+type ArangoRouteStatusTargetLimitsRate struct {
+	// RequestsPerSecond defines the number of requests per second allowed on the route
+	RequestsPerSecond uint32 `json:"requestsPerSecond"`
+
+	// Burst defines the number of requests allowed at once
+	Burst uint32 `json:"burst"`
+
+	// Key defines how requests are grouped for the rate limit
+	Key ArangoRouteSpecDestinationLimitsKey `json:"key,omitempty"`
+}
+
+func (a *ArangoRouteStatusTargetLimitsRate) Hash() string {
+	if a == nil {
+		return ""
+	}
+
+	return util.SHA256FromStringArray(fmt.Sprintf("%d", a.RequestsPerSecond), fmt.Sprintf("%d", a.Burst), string(a.Key))
+}
+
+func optionalUint32Hash(v *uint32) string {
+	if v == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%d", *v)
+}
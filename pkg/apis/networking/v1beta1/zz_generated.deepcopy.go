@@ -177,6 +177,23 @@ func (in *ArangoRouteSpecDestination) DeepCopyInto(out *ArangoRouteSpecDestinati
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = new(ArangoRouteSpecDestinationLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Backends != nil {
+		in, out := &in.Backends, &out.Backends
+		*out = make(ArangoRouteSpecDestinationBackends, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -216,6 +233,137 @@ func (in *ArangoRouteSpecDestinationAuthentication) DeepCopy() *ArangoRouteSpecD
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoRouteSpecDestinationBackend) DeepCopyInto(out *ArangoRouteSpecDestinationBackend) {
+	*out = *in
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(ArangoRouteSpecDestinationService)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = new(ArangoRouteSpecDestinationEndpoints)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = new(ArangoRouteSpecDestinationBackendMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoRouteSpecDestinationBackend.
+func (in *ArangoRouteSpecDestinationBackend) DeepCopy() *ArangoRouteSpecDestinationBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoRouteSpecDestinationBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoRouteSpecDestinationBackendMatch) DeepCopyInto(out *ArangoRouteSpecDestinationBackendMatch) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(ArangoRouteSpecDestinationBackendMatchRules, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Cookies != nil {
+		in, out := &in.Cookies, &out.Cookies
+		*out = make(ArangoRouteSpecDestinationBackendMatchRules, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoRouteSpecDestinationBackendMatch.
+func (in *ArangoRouteSpecDestinationBackendMatch) DeepCopy() *ArangoRouteSpecDestinationBackendMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoRouteSpecDestinationBackendMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoRouteSpecDestinationBackendMatchRule) DeepCopyInto(out *ArangoRouteSpecDestinationBackendMatchRule) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoRouteSpecDestinationBackendMatchRule.
+func (in *ArangoRouteSpecDestinationBackendMatchRule) DeepCopy() *ArangoRouteSpecDestinationBackendMatchRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoRouteSpecDestinationBackendMatchRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in ArangoRouteSpecDestinationBackendMatchRules) DeepCopyInto(out *ArangoRouteSpecDestinationBackendMatchRules) {
+	{
+		in := &in
+		*out = make(ArangoRouteSpecDestinationBackendMatchRules, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoRouteSpecDestinationBackendMatchRules.
+func (in ArangoRouteSpecDestinationBackendMatchRules) DeepCopy() ArangoRouteSpecDestinationBackendMatchRules {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoRouteSpecDestinationBackendMatchRules)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in ArangoRouteSpecDestinationBackends) DeepCopyInto(out *ArangoRouteSpecDestinationBackends) {
+	{
+		in := &in
+		*out = make(ArangoRouteSpecDestinationBackends, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoRouteSpecDestinationBackends.
+func (in ArangoRouteSpecDestinationBackends) DeepCopy() ArangoRouteSpecDestinationBackends {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoRouteSpecDestinationBackends)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArangoRouteSpecDestinationEndpoints) DeepCopyInto(out *ArangoRouteSpecDestinationEndpoints) {
 	*out = *in
@@ -242,6 +390,52 @@ func (in *ArangoRouteSpecDestinationEndpoints) DeepCopy() *ArangoRouteSpecDestin
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoRouteSpecDestinationLimits) DeepCopyInto(out *ArangoRouteSpecDestinationLimits) {
+	*out = *in
+	if in.RequestsPerSecond != nil {
+		in, out := &in.RequestsPerSecond, &out.RequestsPerSecond
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(ArangoRouteSpecDestinationLimitsKey)
+		**out = **in
+	}
+	if in.MaxConnections != nil {
+		in, out := &in.MaxConnections, &out.MaxConnections
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxPendingRequests != nil {
+		in, out := &in.MaxPendingRequests, &out.MaxPendingRequests
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxRequests != nil {
+		in, out := &in.MaxRequests, &out.MaxRequests
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoRouteSpecDestinationLimits.
+func (in *ArangoRouteSpecDestinationLimits) DeepCopy() *ArangoRouteSpecDestinationLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoRouteSpecDestinationLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArangoRouteSpecDestinationRedirect) DeepCopyInto(out *ArangoRouteSpecDestinationRedirect) {
 	*out = *in
@@ -457,6 +651,18 @@ func (in *ArangoRouteStatusTarget) DeepCopyInto(out *ArangoRouteStatusTarget) {
 	out.Route = in.Route
 	out.Timeout = in.Timeout
 	out.Redirect = in.Redirect
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = new(ArangoRouteStatusTargetLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Backends != nil {
+		in, out := &in.Backends, &out.Backends
+		*out = make(ArangoRouteStatusTargetBackends, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -486,6 +692,127 @@ func (in *ArangoRouteStatusTargetAuthentication) DeepCopy() *ArangoRouteStatusTa
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoRouteStatusTargetBackend) DeepCopyInto(out *ArangoRouteStatusTargetBackend) {
+	*out = *in
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = new(ArangoRouteStatusTargetBackendMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Destinations != nil {
+		in, out := &in.Destinations, &out.Destinations
+		*out = make(ArangoRouteStatusTargetDestinations, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoRouteStatusTargetBackend.
+func (in *ArangoRouteStatusTargetBackend) DeepCopy() *ArangoRouteStatusTargetBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoRouteStatusTargetBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoRouteStatusTargetBackendMatch) DeepCopyInto(out *ArangoRouteStatusTargetBackendMatch) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(ArangoRouteStatusTargetBackendMatchRules, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Cookies != nil {
+		in, out := &in.Cookies, &out.Cookies
+		*out = make(ArangoRouteStatusTargetBackendMatchRules, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoRouteStatusTargetBackendMatch.
+func (in *ArangoRouteStatusTargetBackendMatch) DeepCopy() *ArangoRouteStatusTargetBackendMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoRouteStatusTargetBackendMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoRouteStatusTargetBackendMatchRule) DeepCopyInto(out *ArangoRouteStatusTargetBackendMatchRule) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoRouteStatusTargetBackendMatchRule.
+func (in *ArangoRouteStatusTargetBackendMatchRule) DeepCopy() *ArangoRouteStatusTargetBackendMatchRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoRouteStatusTargetBackendMatchRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in ArangoRouteStatusTargetBackendMatchRules) DeepCopyInto(out *ArangoRouteStatusTargetBackendMatchRules) {
+	{
+		in := &in
+		*out = make(ArangoRouteStatusTargetBackendMatchRules, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoRouteStatusTargetBackendMatchRules.
+func (in ArangoRouteStatusTargetBackendMatchRules) DeepCopy() ArangoRouteStatusTargetBackendMatchRules {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoRouteStatusTargetBackendMatchRules)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in ArangoRouteStatusTargetBackends) DeepCopyInto(out *ArangoRouteStatusTargetBackends) {
+	{
+		in := &in
+		*out = make(ArangoRouteStatusTargetBackends, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoRouteStatusTargetBackends.
+func (in ArangoRouteStatusTargetBackends) DeepCopy() ArangoRouteStatusTargetBackends {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoRouteStatusTargetBackends)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArangoRouteStatusTargetDestination) DeepCopyInto(out *ArangoRouteStatusTargetDestination) {
 	*out = *in
@@ -522,6 +849,58 @@ func (in ArangoRouteStatusTargetDestinations) DeepCopy() ArangoRouteStatusTarget
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoRouteStatusTargetLimits) DeepCopyInto(out *ArangoRouteStatusTargetLimits) {
+	*out = *in
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(ArangoRouteStatusTargetLimitsRate)
+		**out = **in
+	}
+	if in.MaxConnections != nil {
+		in, out := &in.MaxConnections, &out.MaxConnections
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxPendingRequests != nil {
+		in, out := &in.MaxPendingRequests, &out.MaxPendingRequests
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxRequests != nil {
+		in, out := &in.MaxRequests, &out.MaxRequests
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoRouteStatusTargetLimits.
+func (in *ArangoRouteStatusTargetLimits) DeepCopy() *ArangoRouteStatusTargetLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoRouteStatusTargetLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoRouteStatusTargetLimitsRate) DeepCopyInto(out *ArangoRouteStatusTargetLimitsRate) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoRouteStatusTargetLimitsRate.
+func (in *ArangoRouteStatusTargetLimitsRate) DeepCopy() *ArangoRouteStatusTargetLimitsRate {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoRouteStatusTargetLimitsRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArangoRouteStatusTargetOptionUpgrade) DeepCopyInto(out *ArangoRouteStatusTargetOptionUpgrade) {
 	*out = *in
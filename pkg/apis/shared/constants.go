@@ -64,9 +64,15 @@ const (
 	TMPEphemeralVolumeName                 = "ephemeral-tmp"
 	ArangoDTimezoneVolumeName              = "arangod-timezone"
 	RocksdbEncryptionVolumeName            = "rocksdb-encryption"
+	RocksdbEncryptionWrappedVolumeName     = "rocksdb-encryption-wrapped"
+	RocksdbEncryptionKMSVolumeName         = "rocksdb-encryption-kms"
+	RocksdbEncryptionKMSCAVolumeName       = "rocksdb-encryption-kms-ca"
 	ExporterJWTVolumeName                  = "exporter-jwt"
 	ArangodVolumeMountDir                  = "/data"
 	RocksDBEncryptionVolumeMountDir        = "/secrets/rocksdb/encryption"
+	RocksDBEncryptionWrappedVolumeMountDir = "/secrets/rocksdb/wrapped"
+	RocksDBEncryptionKMSVolumeMountDir     = "/secrets/rocksdb/kms"
+	RocksDBEncryptionKMSCAVolumeMountDir   = "/secrets/rocksdb/kms-ca"
 	TLSKeyfileVolumeMountDir               = "/secrets/tls"
 	TLSSNIKeyfileVolumeMountDir            = "/secrets/sni"
 	ClientAuthCAVolumeMountDir             = "/secrets/client-auth/ca"
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -20,6 +20,8 @@
 
 package v1alpha
 
+import "k8s.io/apimachinery/pkg/api/resource"
+
 // LocalStorageStatus contains the status part of
 // an ArangoLocalStorage.
 type LocalStorageStatus struct {
@@ -27,4 +29,48 @@ type LocalStorageStatus struct {
 	State LocalStorageState `json:"state,omitempty"`
 	// Reason for the state this object is in.
 	Reason string `json:"reason,omitempty"`
+	// Nodes holds the capacity of the local paths on the nodes with the provisioner
+	Nodes LocalStorageNodesStatus `json:"nodes,omitempty"`
+}
+
+// LocalStorageNodesStatus holds the capacity of the local paths on the nodes
+type LocalStorageNodesStatus []LocalStorageNodeStatus
+
+// GetPath returns the status of the local path on the node
+func (l LocalStorageNodesStatus) GetPath(node, path string) (LocalStoragePathStatus, bool) {
+	for _, n := range l {
+		if n.Name != node {
+			continue
+		}
+
+		for _, p := range n.Paths {
+			if p.Path == path {
+				return p, true
+			}
+		}
+	}
+
+	return LocalStoragePathStatus{}, false
+}
+
+// LocalStorageNodeStatus holds the capacity of the local paths on the node
+type LocalStorageNodeStatus struct {
+	// Name of the node
+	Name string `json:"name"`
+	// Paths holds the capacity of the local paths
+	Paths []LocalStoragePathStatus `json:"paths,omitempty"`
+}
+
+// LocalStoragePathStatus holds the capacity of the local path
+type LocalStoragePathStatus struct {
+	// Path is the local path on the node
+	Path string `json:"path"`
+	// Capacity is the size of the filesystem containing the path
+	Capacity resource.Quantity `json:"capacity"`
+	// Available is the space available on the filesystem
+	Available resource.Quantity `json:"available"`
+	// Allocated is the sum of the capacity of the PersistentVolumes created in the path
+	Allocated resource.Quantity `json:"allocated"`
+	// Free is the capacity not allocated to the PersistentVolumes yet
+	Free resource.Quantity `json:"free"`
 }
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2024 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -44,6 +44,10 @@ type StorageClassSpec struct {
 	// +doc/type: core.PersistentVolumeReclaimPolicy
 	// +doc/link: Documentation of core.PersistentVolumeReclaimPolicy|https://kubernetes.io/docs/concepts/storage/persistent-volumes#reclaiming
 	ReclaimPolicy *core.PersistentVolumeReclaimPolicy `json:"reclaimPolicy,omitempty"`
+	// AllowVolumeExpansion setting specifies if the created `StorageClass` allows to expand
+	// the `PersistentVolumeClaim`. Volume is expanded only if the local path has enough unallocated space.
+	// +doc/default: false
+	AllowVolumeExpansion *bool `json:"allowVolumeExpansion,omitempty"`
 }
 
 // Validate the given spec, returning an error on validation
@@ -74,6 +78,11 @@ func (s *StorageClassSpec) GetReclaimPolicy() core.PersistentVolumeReclaimPolicy
 	return util.TypeOrDefault(s.ReclaimPolicy, core.PersistentVolumeReclaimRetain)
 }
 
+// IsVolumeExpansionAllowed returns true if StorageClass allows volume expansion
+func (s *StorageClassSpec) IsVolumeExpansionAllowed() bool {
+	return util.TypeOrDefault(s.AllowVolumeExpansion, false)
+}
+
 // ResetImmutableFields replaces all immutable fields in the given target with values from the source spec.
 // It returns a list of fields that have been reset.
 // Field names are relative to `spec.`.
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2025 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -41,15 +41,19 @@ func TestStorageClassSpecCreation(t *testing.T) {
 	storageClassSpec = StorageClassSpec{Name: "the-spec-name", IsDefault: true, ReclaimPolicy: util.NewType[core.PersistentVolumeReclaimPolicy]("Random")}
 	assert.Error(t, storageClassSpec.Validate(), "upper case letters are not allowed in resources")
 
-	storageClassSpec = StorageClassSpec{"the-spec-name", true, util.NewType(core.PersistentVolumeReclaimRetain)}
+	storageClassSpec = StorageClassSpec{"the-spec-name", true, util.NewType(core.PersistentVolumeReclaimRetain), nil}
 	assert.NoError(t, storageClassSpec.Validate())
 
-	storageClassSpec = StorageClassSpec{"the-spec-name", true, util.NewType(core.PersistentVolumeReclaimDelete)}
+	storageClassSpec = StorageClassSpec{"the-spec-name", true, util.NewType(core.PersistentVolumeReclaimDelete), nil}
 	assert.NoError(t, storageClassSpec.Validate())
 
-	storageClassSpec = StorageClassSpec{"the-spec-name", true, nil}
+	storageClassSpec = StorageClassSpec{"the-spec-name", true, nil, nil}
 	assert.NoError(t, storageClassSpec.Validate())
 
+	storageClassSpec = StorageClassSpec{"the-spec-name", true, nil, util.NewType(true)}
+	assert.NoError(t, storageClassSpec.Validate())
+	assert.True(t, storageClassSpec.IsVolumeExpansionAllowed())
+
 	storageClassSpec = StorageClassSpec{} // no proper name -> invalid
 	storageClassSpec.SetDefaults("foo")   // name is fixed -> vaild
 	assert.NoError(t, storageClassSpec.Validate())
@@ -58,8 +62,8 @@ func TestStorageClassSpecCreation(t *testing.T) {
 // test reset of storage class spec
 func TestStorageClassSpecResetImmutableFileds(t *testing.T) {
 	t.Run("Name", func(t *testing.T) {
-		specSource := StorageClassSpec{"source", true, nil}
-		specTarget := StorageClassSpec{"target", true, nil}
+		specSource := StorageClassSpec{"source", true, nil, nil}
+		specTarget := StorageClassSpec{"target", true, nil, nil}
 
 		assert.Equal(t, "target", specTarget.Name)
 		rv := specSource.ResetImmutableFields("fieldPrefix-", &specTarget)
@@ -67,8 +71,8 @@ func TestStorageClassSpecResetImmutableFileds(t *testing.T) {
 		assert.Equal(t, "source", specTarget.Name)
 	})
 	t.Run("ReclaimPolicy", func(t *testing.T) {
-		specSource := StorageClassSpec{"source", true, util.NewType(core.PersistentVolumeReclaimRetain)}
-		specTarget := StorageClassSpec{"source", true, util.NewType(core.PersistentVolumeReclaimDelete)}
+		specSource := StorageClassSpec{"source", true, util.NewType(core.PersistentVolumeReclaimRetain), nil}
+		specTarget := StorageClassSpec{"source", true, util.NewType(core.PersistentVolumeReclaimDelete), nil}
 
 		assert.Equal(t, core.PersistentVolumeReclaimDelete, *specTarget.ReclaimPolicy)
 		rv := specSource.ResetImmutableFields("fieldPrefix-", &specTarget)
@@ -36,7 +36,7 @@ func (in *ArangoLocalStorage) DeepCopyInto(out *ArangoLocalStorage) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -91,6 +91,71 @@ func (in *ArangoLocalStorageList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalStorageNodeStatus) DeepCopyInto(out *LocalStorageNodeStatus) {
+	*out = *in
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]LocalStoragePathStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalStorageNodeStatus.
+func (in *LocalStorageNodeStatus) DeepCopy() *LocalStorageNodeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalStorageNodeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in LocalStorageNodesStatus) DeepCopyInto(out *LocalStorageNodesStatus) {
+	{
+		in := &in
+		*out = make(LocalStorageNodesStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalStorageNodesStatus.
+func (in LocalStorageNodesStatus) DeepCopy() LocalStorageNodesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalStorageNodesStatus)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalStoragePathStatus) DeepCopyInto(out *LocalStoragePathStatus) {
+	*out = *in
+	out.Capacity = in.Capacity.DeepCopy()
+	out.Available = in.Available.DeepCopy()
+	out.Allocated = in.Allocated.DeepCopy()
+	out.Free = in.Free.DeepCopy()
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalStoragePathStatus.
+func (in *LocalStoragePathStatus) DeepCopy() *LocalStoragePathStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalStoragePathStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalStoragePodCustomization) DeepCopyInto(out *LocalStoragePodCustomization) {
 	*out = *in
@@ -161,6 +226,13 @@ func (in *LocalStorageSpec) DeepCopy() *LocalStorageSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalStorageStatus) DeepCopyInto(out *LocalStorageStatus) {
 	*out = *in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make(LocalStorageNodesStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -182,6 +254,11 @@ func (in *StorageClassSpec) DeepCopyInto(out *StorageClassSpec) {
 		*out = new(v1.PersistentVolumeReclaimPolicy)
 		**out = **in
 	}
+	if in.AllowVolumeExpansion != nil {
+		in, out := &in.AllowVolumeExpansion, &out.AllowVolumeExpansion
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2025 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -27,13 +27,19 @@ import (
 )
 
 func Register(cmd *cobra.Command) {
+	RegisterNamespace(cmd)
+
 	f := cmd.Flags()
-	f.StringVarP(&input.Namespace, "namespace", "n", utilConstants.NamespaceWithDefault("default"), "Kubernetes namespace")
 	f.BoolVar(&input.HideSensitiveData, "hide-sensitive-data", true, "Hide sensitive data")
 	f.BoolVar(&input.PodLogs, "pod-logs", true, "Collect pod logs")
 	f.BoolVar(&input.DebugPackageFiles, "debug-package-files", false, "Collect Debug files from Storage")
 }
 
+// RegisterNamespace registers only the namespace flag, used to discover the Operator pod
+func RegisterNamespace(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&input.Namespace, "namespace", "n", utilConstants.NamespaceWithDefault("default"), "Kubernetes namespace")
+}
+
 var input Input
 
 func GetInput() Input {
@@ -35,6 +35,7 @@ func Database(f shared.FactoryGen) {
 			shared.WithDefinitions[*api.ArangoDeployment],
 			arangoDatabaseDeploymentMembers,
 			arangoDatabaseDeploymentAgencyDump,
+			arangoDatabaseDeploymentPlanHistory,
 			arangoDatabaseDeploymentPlatform)).
 		Register("member", true, shared.WithKubernetesItems[*api.ArangoMember](arangoDatabaseV1ArangoMemberList, shared.WithDefinitions[*api.ArangoMember])).
 		Register("task", true, shared.WithKubernetesItems[*api.ArangoTask](arangoDatabaseV1ArangoTaskList, shared.WithDefinitions[*api.ArangoTask])).
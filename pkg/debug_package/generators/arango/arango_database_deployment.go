@@ -175,6 +175,17 @@ func arangoDeploymentMemberArangoD(logger zerolog.Logger, files chan<- shared.Fi
 	return nil
 }
 
+func arangoDatabaseDeploymentPlanHistory(ctx context.Context, logger zerolog.Logger, client kclient.Client, files chan<- shared.File, item *api.ArangoDeployment) error {
+	files, c := shared.WithPrefix(files, "plan/")
+	defer c()
+
+	files <- shared.NewFile("history.yaml", func() ([]byte, error) {
+		return yaml.Marshal(item.Status.PlanHistory)
+	})
+
+	return nil
+}
+
 func arangoDatabaseDeploymentPlatform(ctx context.Context, logger zerolog.Logger, client kclient.Client, files chan<- shared.File, item *api.ArangoDeployment) error {
 	files, c := shared.WithPrefix(files, "platform/")
 	defer c()
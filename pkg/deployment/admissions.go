@@ -0,0 +1,41 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package deployment
+
+import (
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/webhooks/spec"
+	inspectorConstants "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/constants"
+	"github.com/arangodb/kube-arangodb/pkg/webhook"
+)
+
+func WebhookAdmissions() webhook.Admissions {
+	return webhook.Admissions{
+		webhook.NewAdmissionHandler[*api.ArangoDeployment](
+			"spec",
+			inspectorConstants.ArangoDeploymentGroup,
+			inspectorConstants.ArangoDeploymentVersionV1,
+			inspectorConstants.ArangoDeploymentKind,
+			inspectorConstants.ArangoDeploymentResource,
+			spec.Handler(),
+		),
+	}
+}
@@ -22,6 +22,7 @@ package agency
 
 import (
 	"context"
+	"sort"
 	"sync"
 	"time"
 
@@ -86,6 +87,21 @@ func (h health) LeaderID() string {
 	return h.leaderID
 }
 
+func (h health) Agents() []HealthAgent {
+	r := make([]HealthAgent, len(h.names))
+
+	for id, name := range h.names {
+		r[id].ID = name
+		r[id].CommitIndex, r[id].Serving = h.commitIndexes[name]
+	}
+
+	sort.Slice(r, func(i, j int) bool {
+		return r[i].ID < r[j].ID
+	})
+
+	return r
+}
+
 // Healthy returns nil if all agencies have the same commit index.
 func (h health) Healthy() error {
 	if err := h.Serving(); err != nil {
@@ -128,6 +144,16 @@ func (h health) Serving() error {
 	return nil
 }
 
+// HealthAgent describes the state of the single agent.
+type HealthAgent struct {
+	// ID of the agent
+	ID string
+	// Serving is true when agent responded to the config request
+	Serving bool
+	// CommitIndex reported by the agent
+	CommitIndex uint64
+}
+
 // Health describes interface to check healthy of the environment.
 type Health interface {
 	// Healthy return nil when environment is considered as healthy.
@@ -139,6 +165,9 @@ type Health interface {
 	// LeaderID returns a leader ID or empty string if a leader is not known.
 	LeaderID() string
 
+	// Agents returns the state of the agents, sorted by ID.
+	Agents() []HealthAgent
+
 	CollectMetrics(m metrics.PushMetric)
 }
 
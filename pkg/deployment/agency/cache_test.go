@@ -0,0 +1,45 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package agency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Health_Agents(t *testing.T) {
+	h := health{
+		leaderID:   "AGNT-1",
+		agencySize: 3,
+		names:      []string{"AGNT-3", "AGNT-1", "AGNT-2"},
+		commitIndexes: map[string]uint64{
+			"AGNT-1": 10,
+			"AGNT-2": 9,
+		},
+	}
+
+	require.Equal(t, []HealthAgent{
+		{ID: "AGNT-1", Serving: true, CommitIndex: 10},
+		{ID: "AGNT-2", Serving: true, CommitIndex: 9},
+		{ID: "AGNT-3"},
+	}, h.Agents())
+}
@@ -0,0 +1,253 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package state
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Analysis keeps the findings of the agency state analysis
+type Analysis struct {
+	// OutOfSyncShards keeps shards which followers in Current are not in sync with the Plan
+	OutOfSyncShards []AnalysisShard `json:"outOfSyncShards,omitempty"`
+	// LeaderlessShards keeps shards without healthy leader
+	LeaderlessShards []AnalysisShard `json:"leaderlessShards,omitempty"`
+
+	// StuckJobs keeps ToDo and Pending supervision jobs which are not finished in time
+	StuckJobs []AnalysisJob `json:"stuckJobs,omitempty"`
+	// FailedJobs keeps failed supervision jobs
+	FailedJobs []AnalysisJob `json:"failedJobs,omitempty"`
+
+	// SupervisionMaintenance is true if the supervision maintenance mode is enabled
+	SupervisionMaintenance bool `json:"supervisionMaintenance,omitempty"`
+	// MaintenanceServers keeps DBServers in the maintenance mode
+	MaintenanceServers Servers `json:"maintenanceServers,omitempty"`
+
+	// OverReplicatedCollections keeps collections which replication factor exceeds the number of available DBServers
+	OverReplicatedCollections []AnalysisCollection `json:"overReplicatedCollections,omitempty"`
+}
+
+// IsHealthy returns true if analysis did not find any issue
+func (a Analysis) IsHealthy() bool {
+	return len(a.OutOfSyncShards) == 0 && len(a.LeaderlessShards) == 0 &&
+		len(a.StuckJobs) == 0 && len(a.FailedJobs) == 0 &&
+		!a.SupervisionMaintenance && len(a.MaintenanceServers) == 0 &&
+		len(a.OverReplicatedCollections) == 0
+}
+
+// AnalysisShard describes the shard reported by the analysis
+type AnalysisShard struct {
+	Database   string  `json:"database"`
+	Collection string  `json:"collection"`
+	Shard      string  `json:"shard"`
+	Planned    Servers `json:"planned,omitempty"`
+	Current    Servers `json:"current,omitempty"`
+	Reason     string  `json:"reason,omitempty"`
+}
+
+// AnalysisJob describes the supervision job reported by the analysis
+type AnalysisJob struct {
+	ID     JobID         `json:"id"`
+	Phase  JobPhase      `json:"phase"`
+	Type   string        `json:"type,omitempty"`
+	Reason string        `json:"reason,omitempty"`
+	Server string        `json:"server,omitempty"`
+	Age    time.Duration `json:"age,omitempty"`
+}
+
+// AnalysisCollection describes the collection reported by the analysis
+type AnalysisCollection struct {
+	Database          string `json:"database"`
+	Collection        string `json:"collection"`
+	ReplicationFactor int    `json:"replicationFactor"`
+	DBServers         int    `json:"dbServers"`
+}
+
+// Analyze inspects the agency state and reports out-of-sync and leaderless shards, stuck and failed supervision jobs,
+// servers in maintenance and collections which replication factor exceeds the number of available DBServers.
+// Jobs in ToDo or Pending phase are considered as stuck when they are older than stuckJobTimeout.
+func (s State) Analyze(now time.Time, stuckJobTimeout time.Duration) Analysis {
+	var r Analysis
+
+	dbServers := s.availableDBServers()
+
+	for db, collections := range s.Plan.Collections {
+		for colID, col := range collections {
+			name := col.GetName(colID)
+
+			if rf := col.ReplicationFactor; rf != nil && !rf.IsSatellite() && !rf.IsUnknown() && len(s.Plan.DBServers) > 0 {
+				if int(*rf) > dbServers {
+					r.OverReplicatedCollections = append(r.OverReplicatedCollections, AnalysisCollection{
+						Database:          db,
+						Collection:        name,
+						ReplicationFactor: int(*rf),
+						DBServers:         dbServers,
+					})
+				}
+			}
+
+			for shard, planned := range col.Shards {
+				details := AnalysisShard{
+					Database:   db,
+					Collection: name,
+					Shard:      shard,
+					Planned:    planned,
+				}
+
+				current, ok := s.Current.Collections[db][colID][shard]
+				if ok {
+					details.Current = current.Servers
+				}
+
+				if reason, leaderless := s.isShardLeaderless(planned, details.Current); leaderless {
+					details.Reason = reason
+					r.LeaderlessShards = append(r.LeaderlessShards, details)
+				}
+
+				if !s.IsShardInSync(db, colID, shard, planned) {
+					r.OutOfSyncShards = append(r.OutOfSyncShards, details)
+				}
+			}
+		}
+	}
+
+	r.StuckJobs = s.Target.GetStuckJobs(now, stuckJobTimeout)
+
+	for id, job := range s.Target.JobFailed {
+		j, _ := analyzeJob(now, id, JobPhaseFailed, job)
+		r.FailedJobs = append(r.FailedJobs, j)
+	}
+
+	r.SupervisionMaintenance = s.Supervision.Maintenance.Exists()
+
+	for server := range s.Current.MaintenanceDBServers {
+		if s.Current.MaintenanceDBServers.InMaintenance(server) {
+			r.MaintenanceServers = append(r.MaintenanceServers, server)
+		}
+	}
+
+	r.sort()
+
+	return r
+}
+
+// availableDBServers returns the number of DBServers which are not cleaned out
+func (s State) availableDBServers() int {
+	count := 0
+
+	for server := range s.Plan.DBServers {
+		if s.Target.CleanedServers.Contains(server) || s.Target.ToBeCleanedServers.Contains(server) {
+			continue
+		}
+
+		count++
+	}
+
+	return count
+}
+
+// isShardLeaderless checks if the shard has a leader which is planned, reported in Current and healthy
+func (s State) isShardLeaderless(planned, current Servers) (string, bool) {
+	if len(planned) == 0 {
+		return "No servers planned", true
+	}
+
+	if len(current) == 0 {
+		return "Shard is not reported in Current", true
+	}
+
+	leader := planned[0]
+
+	if len(s.Supervision.Health) == 0 {
+		// Health is not part of the dump
+		return "", false
+	}
+
+	health, ok := s.Supervision.Health[leader]
+	if !ok {
+		return fmt.Sprintf("Leader %s is unknown to the supervision", leader), true
+	}
+
+	if health.Status != SupervisionHealthServerStatusGood {
+		return fmt.Sprintf("Leader %s is %s", leader, health.Status), true
+	}
+
+	return "", false
+}
+
+// analyzeJob returns job details with the age calculated from the start or creation time.
+// Returns false if the job does not contain any time information.
+func analyzeJob(now time.Time, id JobID, phase JobPhase, job Job) (AnalysisJob, bool) {
+	j := AnalysisJob{
+		ID:     id,
+		Phase:  phase,
+		Type:   job.Type,
+		Reason: job.Reason,
+		Server: job.Server,
+	}
+
+	if t, ok := job.TimeStarted.Time(); ok {
+		j.Age = now.Sub(t)
+		return j, true
+	}
+
+	if t, ok := job.TimeCreated.Time(); ok {
+		j.Age = now.Sub(t)
+		return j, true
+	}
+
+	return j, false
+}
+
+func (a *Analysis) sort() {
+	shards := func(s []AnalysisShard) {
+		sort.Slice(s, func(i, j int) bool {
+			if s[i].Database != s[j].Database {
+				return s[i].Database < s[j].Database
+			}
+			if s[i].Collection != s[j].Collection {
+				return s[i].Collection < s[j].Collection
+			}
+			return s[i].Shard < s[j].Shard
+		})
+	}
+
+	jobs := func(s []AnalysisJob) {
+		sort.Slice(s, func(i, j int) bool {
+			return s[i].ID < s[j].ID
+		})
+	}
+
+	shards(a.OutOfSyncShards)
+	shards(a.LeaderlessShards)
+	jobs(a.StuckJobs)
+	jobs(a.FailedJobs)
+	a.MaintenanceServers.Sort()
+
+	sort.Slice(a.OverReplicatedCollections, func(i, j int) bool {
+		if a.OverReplicatedCollections[i].Database != a.OverReplicatedCollections[j].Database {
+			return a.OverReplicatedCollections[i].Database < a.OverReplicatedCollections[j].Database
+		}
+		return a.OverReplicatedCollections[i].Collection < a.OverReplicatedCollections[j].Collection
+	})
+}
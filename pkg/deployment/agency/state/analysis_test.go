@@ -0,0 +1,157 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const analysisTestState = `[{
+  "arango": {
+    "Supervision": {
+      "Maintenance": "2024-01-01T10:00:00Z",
+      "Health": {
+        "A": {"Status": "GOOD", "SyncStatus": "SERVING"},
+        "B": {"Status": "GOOD", "SyncStatus": "SERVING"},
+        "C": {"Status": "FAILED", "SyncStatus": "UNKNOWN"}
+      }
+    },
+    "Plan": {
+      "DBServers": {"A": "none", "B": "none", "C": "none", "D": "none"},
+      "Collections": {
+        "db": {
+          "1": {"name": "synced", "replicationFactor": 2, "shards": {"s1": ["A", "B"]}},
+          "2": {"name": "syncing", "replicationFactor": 2, "shards": {"s2": ["B", "A"]}},
+          "3": {"name": "failed", "replicationFactor": 1, "shards": {"s3": ["C"]}},
+          "4": {"name": "large", "replicationFactor": 4, "shards": {"s4": ["A", "B", "C"]}}
+        }
+      }
+    },
+    "Current": {
+      "MaintenanceDBServers": {"B": {"Mode": "maintenance", "Until": "2024-01-01T12:00:00Z"}},
+      "Collections": {
+        "db": {
+          "1": {"s1": {"servers": ["A", "B"]}},
+          "2": {"s2": {"servers": ["B"]}},
+          "3": {"s3": {"servers": ["C"]}},
+          "4": {"s4": {"servers": ["A", "B", "C"]}}
+        }
+      }
+    },
+    "Target": {
+      "CleanedServers": ["D"],
+      "ToDo": {
+        "1": {"type": "moveShard", "timeCreated": "2024-01-01T09:00:00Z"},
+        "2": {"type": "moveShard", "timeCreated": "2024-01-01T10:50:00Z"},
+        "3": {"type": "moveShard"}
+      },
+      "Pending": {
+        "4": {"type": "failedServer", "server": "C", "timeCreated": "2024-01-01T08:00:00Z", "timeStarted": "2024-01-01T08:10:00Z"}
+      },
+      "Failed": {
+        "5": {"type": "resignLeadership", "reason": "timeout", "server": "A"}
+      }
+    }
+  }
+}]`
+
+func Test_Analyze(t *testing.T) {
+	r, err := ParseDump([]byte(analysisTestState))
+	require.NoError(t, err)
+
+	now, err := time.Parse(time.RFC3339, "2024-01-01T11:00:00Z")
+	require.NoError(t, err)
+
+	a := r.Arango.Analyze(now, 30*time.Minute)
+
+	require.False(t, a.IsHealthy())
+
+	t.Run("Out of sync shards", func(t *testing.T) {
+		require.Len(t, a.OutOfSyncShards, 1)
+		require.Equal(t, "syncing", a.OutOfSyncShards[0].Collection)
+		require.Equal(t, "s2", a.OutOfSyncShards[0].Shard)
+		require.Equal(t, Servers{"B"}, a.OutOfSyncShards[0].Current)
+	})
+
+	t.Run("Leaderless shards", func(t *testing.T) {
+		require.Len(t, a.LeaderlessShards, 1)
+		require.Equal(t, "failed", a.LeaderlessShards[0].Collection)
+		require.Equal(t, "Leader C is FAILED", a.LeaderlessShards[0].Reason)
+	})
+
+	t.Run("Stuck jobs", func(t *testing.T) {
+		require.Len(t, a.StuckJobs, 2)
+		require.Equal(t, JobID("1"), a.StuckJobs[0].ID)
+		require.Equal(t, JobPhaseToDo, a.StuckJobs[0].Phase)
+		require.Equal(t, 2*time.Hour, a.StuckJobs[0].Age)
+		require.Equal(t, JobID("4"), a.StuckJobs[1].ID)
+		require.Equal(t, JobPhasePending, a.StuckJobs[1].Phase)
+		require.Equal(t, "C", a.StuckJobs[1].Server)
+		require.Equal(t, 2*time.Hour+50*time.Minute, a.StuckJobs[1].Age)
+	})
+
+	t.Run("Failed jobs", func(t *testing.T) {
+		require.Len(t, a.FailedJobs, 1)
+		require.Equal(t, "resignLeadership", a.FailedJobs[0].Type)
+		require.Equal(t, "timeout", a.FailedJobs[0].Reason)
+	})
+
+	t.Run("Maintenance", func(t *testing.T) {
+		require.True(t, a.SupervisionMaintenance)
+		require.Equal(t, Servers{"B"}, a.MaintenanceServers)
+	})
+
+	t.Run("Over replicated collections", func(t *testing.T) {
+		require.Len(t, a.OverReplicatedCollections, 1)
+		require.Equal(t, "large", a.OverReplicatedCollections[0].Collection)
+		require.Equal(t, 4, a.OverReplicatedCollections[0].ReplicationFactor)
+		require.Equal(t, 3, a.OverReplicatedCollections[0].DBServers)
+	})
+}
+
+func Test_Analyze_Dumps(t *testing.T) {
+	for v, data := range data {
+		t.Run(v, func(t *testing.T) {
+			r, err := ParseDump(data)
+			require.NoError(t, err)
+
+			a := r.Arango.Analyze(time.Now(), time.Hour)
+			require.Empty(t, a.OverReplicatedCollections)
+		})
+	}
+
+	t.Run("Jobs", func(t *testing.T) {
+		r, err := ParseDump(agencyDump39Jobs)
+		require.NoError(t, err)
+
+		a := r.Arango.Analyze(time.Now(), time.Hour)
+		require.Len(t, a.FailedJobs, 3)
+		require.Empty(t, a.StuckJobs, "jobs without time information are not reported as stuck")
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := ParseDump([]byte(`[]`))
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,55 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package state
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// ParseDump parses the agency dump (`{"agency": {...}}`) or the result of the agency read (`[{...}]`)
+func ParseDump(data []byte) (Root, error) {
+	data = bytes.TrimSpace(data)
+
+	if len(data) > 0 && data[0] == '[' {
+		var r []Root
+
+		if err := json.Unmarshal(data, &r); err != nil {
+			return Root{}, errors.Wrapf(err, "Unable to parse agency read result")
+		}
+
+		if len(r) != 1 {
+			return Root{}, errors.Errorf("Expected single agency read result, got %d", len(r))
+		}
+
+		return r[0], nil
+	}
+
+	var r DumpState
+
+	if err := json.Unmarshal(data, &r); err != nil {
+		return Root{}, errors.Wrapf(err, "Unable to parse agency dump")
+	}
+
+	return r.Agency, nil
+}
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2023 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -30,6 +30,15 @@ var (
 	JobPhaseFinished JobPhase = "Finished"
 )
 
+// JobTypeMoveShard defines the type of the job which moves the shard between DBServers
+const JobTypeMoveShard = "moveShard"
+
+// JobTypeCleanOutServer defines the type of the job which moves all shards out of the DBServer
+const JobTypeCleanOutServer = "cleanOutServer"
+
+// JobTypeFailedServer defines the type of the job which fails over the shards of the failed DBServer
+const JobTypeFailedServer = "failedServer"
+
 type JobID string
 
 type Jobs map[JobID]Job
@@ -37,4 +46,24 @@ type Jobs map[JobID]Job
 type Job struct {
 	Type   string `json:"type,omitempty"`
 	Reason string `json:"reason,omitempty"`
+	Server string `json:"server,omitempty"`
+
+	Database   string `json:"database,omitempty"`
+	Collection string `json:"collection,omitempty"`
+	Shard      string `json:"shard,omitempty"`
+	FromServer string `json:"fromServer,omitempty"`
+	ToServer   string `json:"toServer,omitempty"`
+
+	TimeCreated Timestamp `json:"timeCreated,omitempty"`
+	TimeStarted Timestamp `json:"timeStarted,omitempty"`
+}
+
+// IsAbortable returns true if the job can be aborted with the cancelAgencyJob API
+func (j Job) IsAbortable() bool {
+	switch j.Type {
+	case JobTypeMoveShard, JobTypeCleanOutServer:
+		return true
+	default:
+		return false
+	}
 }
@@ -0,0 +1,135 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package state
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ServerFailureImpact describes the impact of the DBServer failure on the planned shards
+type ServerFailureImpact struct {
+	// Shards keeps the number of the planned shards on the server
+	Shards int
+	// Unprotected keeps shards without replicas on the other servers (replicationFactor 1)
+	Unprotected CollectionShardDetails
+	// NotInSync keeps shards without in-sync replica on the other servers
+	NotInSync CollectionShardDetails
+}
+
+// Safe returns true if all shards of the server have an in-sync replica on the other servers
+func (s ServerFailureImpact) Safe() bool {
+	return len(s.Unprotected) == 0 && len(s.NotInSync) == 0
+}
+
+// UnprotectedCollections returns sorted list of the unprotected collections in format <database>/<collection>
+func (s ServerFailureImpact) UnprotectedCollections() []string {
+	q := map[string]bool{}
+
+	for _, shard := range s.Unprotected {
+		q[fmt.Sprintf("%s/%s", shard.Database, shard.Collection)] = true
+	}
+
+	r := make([]string, 0, len(q))
+	for k := range q {
+		r = append(r, k)
+	}
+
+	sort.Strings(r)
+
+	return r
+}
+
+// GetServerFailureImpact checks if the shards of the server are replicated and in sync on the other servers.
+// Collection names (instead of IDs) are returned in the shard details.
+func (s State) GetServerFailureImpact(id Server) ServerFailureImpact {
+	var r ServerFailureImpact
+
+	for db, collections := range s.Plan.Collections {
+		for colID, col := range collections {
+			for shard, planned := range col.Shards {
+				if !planned.Contains(id) {
+					continue
+				}
+
+				r.Shards++
+
+				details := CollectionShardDetail{
+					Database:   db,
+					Collection: col.GetName(colID),
+					Shard:      shard,
+				}
+
+				if len(planned) <= 1 || col.GetReplicationFactor(shard) == 1 {
+					r.Unprotected = append(r.Unprotected, details)
+					continue
+				}
+
+				if !s.hasInSyncReplica(db, colID, shard, planned, id) {
+					r.NotInSync = append(r.NotInSync, details)
+				}
+			}
+		}
+	}
+
+	return r
+}
+
+// IsServerFailedOver checks if the agency supervision has completed the failover of the failed server:
+// - supervision reports the server as FAILED
+// - failedServer job of the server is finished
+// - server does not lead or follow any shard in Plan
+// Return: failedOver, reason
+func (s State) IsServerFailedOver(id Server) (bool, string) {
+	if health, ok := s.Supervision.Health[id]; !ok || !health.IsFailed() {
+		return false, "DBServer is not reported as FAILED by the agency supervision"
+	}
+
+	if !s.Target.IsFailedServerJobFinished(id) {
+		return false, "failedServer job of the DBServer is not finished"
+	}
+
+	if impact := s.GetServerFailureImpact(id); impact.Shards > 0 {
+		return false, fmt.Sprintf("DBServer still leads or follows %d shards in Plan", impact.Shards)
+	}
+
+	return true, ""
+}
+
+// hasInSyncReplica returns true if any planned server, other than the given one, is reported in Current as in sync
+func (s State) hasInSyncReplica(db, col, shard string, planned Servers, id Server) bool {
+	current, ok := s.Current.Collections[db][col][shard]
+	if !ok {
+		return false
+	}
+
+	for _, server := range current.Servers {
+		if server == id {
+			continue
+		}
+
+		if planned.Contains(server) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,129 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ServerFailureImpact(t *testing.T) {
+	s := GenerateState(t,
+		NewDatabaseGenerator("db1").
+			Collection("replicated").WithReplicationFactor(2).
+			WithShard().WithPlan("A", "B").WithCurrent("A", "B").Add().
+			WithShard().WithPlan("B", "A").WithCurrent("B", "A").Add().
+			Add().
+			Collection("syncing").WithReplicationFactor(2).
+			WithShard().WithPlan("C", "B").WithCurrent("C").Add().
+			Add().
+			Add(),
+		NewDatabaseGenerator("db2").
+			Collection("single").WithReplicationFactor(1).
+			WithShard().WithPlan("C").WithCurrent("C").Add().
+			Add().
+			Add(),
+	)
+
+	t.Run("Replicated and in sync", func(t *testing.T) {
+		impact := s.GetServerFailureImpact("A")
+		require.Equal(t, 2, impact.Shards)
+		require.True(t, impact.Safe())
+	})
+
+	t.Run("Follower not in sync", func(t *testing.T) {
+		impact := s.GetServerFailureImpact("B")
+		require.Equal(t, 3, impact.Shards)
+		require.Empty(t, impact.Unprotected)
+		require.Empty(t, impact.NotInSync, "leader of the shard is in sync")
+		require.True(t, impact.Safe())
+	})
+
+	t.Run("Leader without in-sync follower and unprotected shard", func(t *testing.T) {
+		impact := s.GetServerFailureImpact("C")
+		require.Equal(t, 2, impact.Shards)
+		require.False(t, impact.Safe())
+		require.Len(t, impact.NotInSync, 1)
+		require.Equal(t, "syncing", impact.NotInSync[0].Collection)
+		require.Equal(t, []string{"db2/single"}, impact.UnprotectedCollections())
+	})
+
+	t.Run("Unknown server", func(t *testing.T) {
+		impact := s.GetServerFailureImpact("D")
+		require.Equal(t, 0, impact.Shards)
+		require.True(t, impact.Safe())
+	})
+}
+
+func Test_ServerFailedOver(t *testing.T) {
+	s := GenerateState(t,
+		NewDatabaseGenerator("db1").
+			Collection("replicated").WithReplicationFactor(2).
+			WithShard().WithPlan("A", "B").WithCurrent("A", "B").Add().
+			Add().
+			Add(),
+	)
+
+	s.Supervision.Health = ServerMap[SupervisionHealthServer]{
+		"A": {Status: SupervisionHealthServerStatusFailed},
+		"B": {Status: SupervisionHealthServerStatusGood},
+		"C": {Status: SupervisionHealthServerStatusFailed},
+		"D": {Status: SupervisionHealthServerStatusFailed},
+	}
+	s.Target.JobPending = Jobs{
+		"1": {Type: JobTypeFailedServer, Server: "D"},
+	}
+	s.Target.JobFinished = Jobs{
+		"2": {Type: JobTypeFailedServer, Server: "A"},
+		"3": {Type: JobTypeFailedServer, Server: "C"},
+		"4": {Type: JobTypeFailedServer, Server: "D"},
+	}
+
+	t.Run("Still in Plan", func(t *testing.T) {
+		failedOver, reason := s.IsServerFailedOver("A")
+		require.False(t, failedOver)
+		require.Equal(t, "DBServer still leads or follows 1 shards in Plan", reason)
+	})
+
+	t.Run("Not failed", func(t *testing.T) {
+		failedOver, reason := s.IsServerFailedOver("B")
+		require.False(t, failedOver)
+		require.Equal(t, "DBServer is not reported as FAILED by the agency supervision", reason)
+	})
+
+	t.Run("Failed over", func(t *testing.T) {
+		failedOver, reason := s.IsServerFailedOver("C")
+		require.True(t, failedOver)
+		require.Empty(t, reason)
+	})
+
+	t.Run("Job in progress", func(t *testing.T) {
+		failedOver, reason := s.IsServerFailedOver("D")
+		require.False(t, failedOver)
+		require.Equal(t, "failedServer job of the DBServer is not finished", reason)
+	})
+
+	t.Run("Unknown server", func(t *testing.T) {
+		failedOver, _ := s.IsServerFailedOver("E")
+		require.False(t, failedOver)
+	})
+}
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2025 ArangoDB GmbH, Cologne, Germany
+// Copyright 2025-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -29,7 +29,8 @@ type Supervision struct {
 type SupervisionHealthServerStatus string
 
 const (
-	SupervisionHealthServerStatusGood SupervisionHealthServerStatus = "GOOD"
+	SupervisionHealthServerStatusGood   SupervisionHealthServerStatus = "GOOD"
+	SupervisionHealthServerStatusFailed SupervisionHealthServerStatus = "FAILED"
 )
 
 type SupervisionHealthServerSyncStatus string
@@ -46,3 +47,7 @@ type SupervisionHealthServer struct {
 func (s SupervisionHealthServer) IsHealthy() bool {
 	return s.Status == SupervisionHealthServerStatusGood && s.SyncStatus == SupervisionHealthServerSyncStatusServing
 }
+
+func (s SupervisionHealthServer) IsFailed() bool {
+	return s.Status == SupervisionHealthServerStatusFailed
+}
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2025 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -80,3 +80,22 @@ func (s Target) GetJobIDs() []JobID {
 type TargetHotBackup struct {
 	Create Timestamp `json:"Create,omitempty"`
 }
+
+// IsFailedServerJobFinished returns true if the failedServer job of the server is finished and no other one is in progress
+func (s Target) IsFailedServerJobFinished(id Server) bool {
+	for _, jobs := range []Jobs{s.JobToDo, s.JobPending} {
+		for _, job := range jobs {
+			if job.Type == JobTypeFailedServer && Server(job.Server) == id {
+				return false
+			}
+		}
+	}
+
+	for _, job := range s.JobFinished {
+		if job.Type == JobTypeFailedServer && Server(job.Server) == id {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,91 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package state
+
+import (
+	"sort"
+	"time"
+)
+
+// JobsStats keeps the statistics of the agency jobs grouped by the phase and the job type
+type JobsStats map[JobPhase]map[string]JobStats
+
+// JobStats keeps the statistics of the agency jobs of one type
+type JobStats struct {
+	// Count defines the number of the jobs
+	Count int
+	// OldestAge defines the age of the oldest job
+	OldestAge time.Duration
+}
+
+// GetJobsStats returns statistics of the ToDo, Pending and Failed jobs
+func (s Target) GetJobsStats(now time.Time) JobsStats {
+	r := JobsStats{}
+
+	for phase, jobs := range map[JobPhase]Jobs{
+		JobPhaseToDo:    s.JobToDo,
+		JobPhasePending: s.JobPending,
+		JobPhaseFailed:  s.JobFailed,
+	} {
+		if len(jobs) == 0 {
+			continue
+		}
+
+		types := map[string]JobStats{}
+
+		for id, job := range jobs {
+			j, _ := analyzeJob(now, id, phase, job)
+
+			v := types[job.Type]
+			v.Count++
+			if j.Age > v.OldestAge {
+				v.OldestAge = j.Age
+			}
+			types[job.Type] = v
+		}
+
+		r[phase] = types
+	}
+
+	return r
+}
+
+// GetStuckJobs returns ToDo and Pending jobs which are older than timeout, sorted by the ID
+func (s Target) GetStuckJobs(now time.Time, timeout time.Duration) []AnalysisJob {
+	var r []AnalysisJob
+
+	for phase, jobs := range map[JobPhase]Jobs{
+		JobPhaseToDo:    s.JobToDo,
+		JobPhasePending: s.JobPending,
+	} {
+		for id, job := range jobs {
+			if j, ok := analyzeJob(now, id, phase, job); ok && j.Age > timeout {
+				r = append(r, j)
+			}
+		}
+	}
+
+	sort.Slice(r, func(i, j int) bool {
+		return r[i].ID < r[j].ID
+	})
+
+	return r
+}
@@ -0,0 +1,74 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Target_GetJobsStats(t *testing.T) {
+	r, err := ParseDump([]byte(analysisTestState))
+	require.NoError(t, err)
+
+	now, err := time.Parse(time.RFC3339, "2024-01-01T11:00:00Z")
+	require.NoError(t, err)
+
+	stats := r.Arango.Target.GetJobsStats(now)
+
+	require.Len(t, stats, 3)
+	require.Equal(t, JobStats{Count: 3, OldestAge: 2 * time.Hour}, stats[JobPhaseToDo]["moveShard"])
+	require.Equal(t, JobStats{Count: 1, OldestAge: 2*time.Hour + 50*time.Minute}, stats[JobPhasePending]["failedServer"])
+	require.Equal(t, JobStats{Count: 1}, stats[JobPhaseFailed]["resignLeadership"])
+}
+
+func Test_Target_GetStuckJobs(t *testing.T) {
+	r, err := ParseDump([]byte(analysisTestState))
+	require.NoError(t, err)
+
+	now, err := time.Parse(time.RFC3339, "2024-01-01T11:00:00Z")
+	require.NoError(t, err)
+
+	t.Run("Default timeout", func(t *testing.T) {
+		jobs := r.Arango.Target.GetStuckJobs(now, 30*time.Minute)
+		require.Len(t, jobs, 2)
+		require.Equal(t, JobID("1"), jobs[0].ID)
+		require.Equal(t, JobID("4"), jobs[1].ID)
+	})
+
+	t.Run("Short timeout", func(t *testing.T) {
+		jobs := r.Arango.Target.GetStuckJobs(now, 5*time.Minute)
+		require.Len(t, jobs, 3)
+		require.Equal(t, JobID("2"), jobs[1].ID)
+	})
+
+	t.Run("Long timeout", func(t *testing.T) {
+		require.Empty(t, r.Arango.Target.GetStuckJobs(now, 3*time.Hour))
+	})
+}
+
+func Test_Job_IsAbortable(t *testing.T) {
+	require.True(t, Job{Type: JobTypeMoveShard}.IsAbortable())
+	require.True(t, Job{Type: JobTypeCleanOutServer}.IsAbortable())
+	require.False(t, Job{Type: "failedServer"}.IsAbortable())
+}
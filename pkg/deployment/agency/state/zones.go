@@ -0,0 +1,194 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package state
+
+import (
+	"sort"
+)
+
+// ServerZones maps the DBServers to the topology zones
+type ServerZones map[Server]int
+
+// ShardZoneViolation describes the shard which replicas share the topology zone while other zones are available
+type ShardZoneViolation struct {
+	Database     string  `json:"database"`
+	Collection   string  `json:"collection"`
+	CollectionID string  `json:"collectionID"`
+	Shard        string  `json:"shard"`
+	Zone         int     `json:"zone"`
+	Servers      Servers `json:"servers"`
+
+	// prototype is true if the collection does not follow the placement of other collection
+	prototype bool
+}
+
+// ShardZoneMove describes the move which spreads the replicas of the shard across the topology zones
+type ShardZoneMove struct {
+	Database   string `json:"database"`
+	Collection string `json:"collection"`
+	Shard      string `json:"shard"`
+	From       Server `json:"from"`
+	To         Server `json:"to"`
+}
+
+// GetShardZoneViolations returns shards which replicas share the topology zone, while they could be spread across `size` zones.
+// Shards with replicas on servers without assigned zone are skipped.
+func (s State) GetShardZoneViolations(zones ServerZones, size int) []ShardZoneViolation {
+	var r []ShardZoneViolation
+
+	for db, collections := range s.Plan.Collections {
+		for colID, col := range collections {
+			for shard, planned := range col.Shards {
+				zone, servers, ok := shardZoneViolation(zones, size, planned)
+				if !ok {
+					continue
+				}
+
+				r = append(r, ShardZoneViolation{
+					Database:     db,
+					Collection:   col.GetName(colID),
+					CollectionID: colID,
+					Shard:        shard,
+					Zone:         zone,
+					Servers:      servers,
+					prototype:    col.DistributeShardsLike == nil,
+				})
+			}
+		}
+	}
+
+	sort.Slice(r, func(i, j int) bool {
+		if r[i].Database != r[j].Database {
+			return r[i].Database < r[j].Database
+		}
+		if r[i].Collection != r[j].Collection {
+			return r[i].Collection < r[j].Collection
+		}
+		return r[i].Shard < r[j].Shard
+	})
+
+	return r
+}
+
+// GetShardZoneMoves returns at most `limit` moves which spread replicas of the shards across the topology zones.
+// Follower in the shared zone is moved to the DBServer with the lowest number of shards in the zone not used by the shard.
+// Shards of the collections with `distributeShardsLike` are skipped, they follow their prototype collection.
+func (s State) GetShardZoneMoves(zones ServerZones, size int, limit int) []ShardZoneMove {
+	var r []ShardZoneMove
+
+	usage := map[Server]int{}
+	for server := range zones {
+		if !s.Plan.DBServers.Exists(server) || s.Target.CleanedServers.Contains(server) || s.Target.ToBeCleanedServers.Contains(server) {
+			continue
+		}
+
+		usage[server] = s.PlanServerUsage(server).Count()
+	}
+
+	for _, v := range s.GetShardZoneViolations(zones, size) {
+		if len(r) >= limit {
+			break
+		}
+
+		if !v.prototype {
+			continue
+		}
+
+		planned := s.Plan.Collections[v.Database][v.CollectionID].Shards[v.Shard]
+
+		// Move the last follower from the shared zone
+		from := v.Servers[len(v.Servers)-1]
+		if from == planned[0] {
+			continue
+		}
+
+		used := map[int]bool{}
+		for _, server := range planned {
+			used[zones[server]] = true
+		}
+
+		var to Server
+		for server, count := range usage {
+			if used[zones[server]] || planned.Contains(server) {
+				continue
+			}
+
+			if to == "" || count < usage[to] || (count == usage[to] && server < to) {
+				to = server
+			}
+		}
+
+		if to == "" {
+			continue
+		}
+
+		usage[to]++
+		usage[from]--
+
+		r = append(r, ShardZoneMove{
+			Database:   v.Database,
+			Collection: v.CollectionID,
+			Shard:      v.Shard,
+			From:       from,
+			To:         to,
+		})
+	}
+
+	return r
+}
+
+// shardZoneViolation returns the zone shared by the replicas of the shard and the servers in this zone.
+// Returns false if replicas are spread across all possible zones.
+func shardZoneViolation(zones ServerZones, size int, planned Servers) (int, Servers, bool) {
+	if len(planned) < 2 {
+		return 0, nil, false
+	}
+
+	servers := map[int]Servers{}
+	for _, server := range planned {
+		zone, ok := zones[server]
+		if !ok {
+			return 0, nil, false
+		}
+
+		servers[zone] = append(servers[zone], server)
+	}
+
+	expected := len(planned)
+	if size < expected {
+		expected = size
+	}
+
+	if len(servers) >= expected {
+		return 0, nil, false
+	}
+
+	// Report the zone with the highest number of replicas, the leader zone wins in case of a tie
+	leaderZone := zones[planned[0]]
+	zone := leaderZone
+	for z, s := range servers {
+		if c := len(servers[zone]); len(s) > c || (len(s) == c && zone != leaderZone && z < zone) {
+			zone = z
+		}
+	}
+
+	return zone, servers[zone], true
+}
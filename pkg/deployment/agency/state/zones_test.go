@@ -0,0 +1,103 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const zonesTestState = `[{
+  "arango": {
+    "Plan": {
+      "DBServers": {"A": "none", "B": "none", "C": "none", "D": "none", "E": "none", "F": "none"},
+      "Collections": {
+        "db": {
+          "1": {"name": "spread", "shards": {"s1": ["A", "C"]}},
+          "2": {"name": "same", "shards": {"s2": ["A", "B"]}},
+          "3": {"name": "follower", "distributeShardsLike": "2", "shards": {"s3": ["A", "B"]}},
+          "4": {"name": "large", "shards": {"s4": ["A", "C", "E", "F"]}},
+          "5": {"name": "unknown", "shards": {"s5": ["A", "X"]}},
+          "6": {"name": "single", "shards": {"s6": ["C"]}}
+        }
+      }
+    }
+  }
+}]`
+
+func zonesTestServerZones() ServerZones {
+	return ServerZones{
+		"A": 0,
+		"B": 0,
+		"C": 1,
+		"D": 1,
+		"E": 2,
+		"F": 2,
+	}
+}
+
+func Test_GetShardZoneViolations(t *testing.T) {
+	r, err := ParseDump([]byte(zonesTestState))
+	require.NoError(t, err)
+
+	v := r.Arango.GetShardZoneViolations(zonesTestServerZones(), 3)
+	require.Len(t, v, 2)
+
+	require.Equal(t, "follower", v[0].Collection)
+	require.Equal(t, "s3", v[0].Shard)
+	require.Equal(t, 0, v[0].Zone)
+	require.Equal(t, Servers{"A", "B"}, v[0].Servers)
+
+	require.Equal(t, "same", v[1].Collection)
+	require.Equal(t, "2", v[1].CollectionID)
+	require.Equal(t, "s2", v[1].Shard)
+
+	t.Run("Single zone", func(t *testing.T) {
+		require.Empty(t, r.Arango.GetShardZoneViolations(zonesTestServerZones(), 1))
+	})
+}
+
+func Test_GetShardZoneMoves(t *testing.T) {
+	r, err := ParseDump([]byte(zonesTestState))
+	require.NoError(t, err)
+
+	t.Run("Move to the least used server", func(t *testing.T) {
+		moves := r.Arango.GetShardZoneMoves(zonesTestServerZones(), 3, 10)
+		require.Equal(t, []ShardZoneMove{
+			{Database: "db", Collection: "2", Shard: "s2", From: "B", To: "D"},
+		}, moves)
+	})
+
+	t.Run("Skip cleaned servers", func(t *testing.T) {
+		s := r.Arango
+		s.Target.CleanedServers = Servers{"D"}
+
+		moves := s.GetShardZoneMoves(zonesTestServerZones(), 3, 10)
+		require.Equal(t, []ShardZoneMove{
+			{Database: "db", Collection: "2", Shard: "s2", From: "B", To: "E"},
+		}, moves)
+	})
+
+	t.Run("Limit", func(t *testing.T) {
+		require.Empty(t, r.Arango.GetShardZoneMoves(zonesTestServerZones(), 3, 0))
+	})
+}
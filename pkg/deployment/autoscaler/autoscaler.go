@@ -0,0 +1,311 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package autoscaler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency/state"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/patch"
+	"github.com/arangodb/kube-arangodb/pkg/logging"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/arangodb/kube-arangodb/pkg/util/timer"
+)
+
+const (
+	// inspectionInterval defines the time between the metric evaluations
+	inspectionInterval = 30 * time.Second
+)
+
+var (
+	autoscalerLogger = logging.Global().RegisterAndGetLogger("deployment-autoscaler", logging.Info)
+
+	groups = []api.ServerGroup{api.ServerGroupCoordinators, api.ServerGroupDBServers}
+)
+
+// Autoscaler is the service that adjusts the count of Coordinators and DBServers
+// based on the metrics exposed by the members.
+type Autoscaler struct {
+	namespace, name string
+	log             logging.Logger
+	context         Context
+
+	lock sync.Mutex
+	// previous keeps the last samples of the members, required for the cumulative metrics
+	previous map[string]samples
+	// blocked keeps the last reason of the blocked decision per group, to not repeat the events
+	blocked map[api.ServerGroup]string
+}
+
+func (a *Autoscaler) WrapLogger(in *zerolog.Event) *zerolog.Event {
+	return in.Str("namespace", a.namespace).Str("name", a.name)
+}
+
+// NewAutoscaler creates a new autoscaler with given context.
+func NewAutoscaler(namespace, name string, context Context) *Autoscaler {
+	a := &Autoscaler{
+		context:   context,
+		namespace: namespace,
+		name:      name,
+		previous:  map[string]samples{},
+		blocked:   map[api.ServerGroup]string{},
+	}
+	a.log = autoscalerLogger.WrapObj(a)
+	return a
+}
+
+// Run the autoscaler until the given channel is closed.
+func (a *Autoscaler) Run(stopCh <-chan struct{}) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for {
+		a.inspect(ctx)
+
+		select {
+		case <-timer.After(inspectionInterval):
+			// Continue
+		case <-stopCh:
+			// We're done
+			return
+		}
+	}
+}
+
+func (a *Autoscaler) inspect(ctx context.Context) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	spec := a.context.GetSpec()
+	if !spec.GetMode().IsCluster() {
+		return
+	}
+
+	for _, group := range groups {
+		if err := a.inspectGroup(ctx, spec, group); err != nil {
+			a.log.Err(err).Str("role", group.AsRole()).Warn("Autoscaler inspection failed")
+		}
+	}
+
+	// Remove samples of the members which are gone
+	status := a.context.GetStatus()
+	for id := range a.previous {
+		if _, _, ok := status.Members.ElementByID(id); !ok {
+			delete(a.previous, id)
+		}
+	}
+}
+
+func (a *Autoscaler) inspectGroup(ctx context.Context, spec api.DeploymentSpec, group api.ServerGroup) error {
+	groupSpec := spec.GetServerGroupSpec(group)
+	if !groupSpec.Autoscaling.IsEnabled() {
+		delete(a.blocked, group)
+		return nil
+	}
+
+	status := a.context.GetStatus()
+	if status.Phase != api.DeploymentPhaseRunning {
+		return nil
+	}
+
+	members := status.Members.MembersOfGroup(group)
+	if len(members) != groupSpec.GetCount() {
+		// Previous scale operation is still in progress
+		return nil
+	}
+
+	v := a.collect(ctx, group, members, groupSpec.Autoscaling.GetMetrics())
+
+	d, ok := decide(groupSpec, status.GetServerGroupStatus(group).Autoscaling, len(members), v, time.Now())
+	if !ok {
+		delete(a.blocked, group)
+		return nil
+	}
+
+	if reason, blocked := a.blockedReason(spec, status, group, members, d); blocked {
+		if a.blocked[group] != reason {
+			a.blocked[group] = reason
+			a.log.Str("role", group.AsRole()).Int("from", d.From).Int("to", d.To).Str("reason", reason).Info("Autoscaling blocked")
+			a.context.CreateEvent(k8sutil.NewAutoscalingBlockedEvent(a.context.GetAPIObject(), group.AsRole(), d.From, d.To, reason))
+		}
+		return nil
+	}
+
+	delete(a.blocked, group)
+
+	return a.apply(ctx, group, d)
+}
+
+// collect returns the group averages of the metrics. Metrics not exposed by any member are skipped
+func (a *Autoscaler) collect(ctx context.Context, group api.ServerGroup, members api.MemberStatusList, metrics []api.ServerGroupAutoscalingMetric) values {
+	sums := map[api.ServerGroupAutoscalingMetricType]float64{}
+	counts := map[api.ServerGroupAutoscalingMetricType]int{}
+
+	for _, m := range members {
+		current, err := a.fetch(ctx, group, m.ID)
+		if err != nil {
+			a.log.Err(err).Str("member", m.ID).Debug("Unable to fetch member metrics")
+			continue
+		}
+
+		previous := a.previous[m.ID]
+		a.previous[m.ID] = current
+
+		for _, metric := range metrics {
+			if value, ok := memberValue(metric.Type, current, previous); ok {
+				sums[metric.Type] += value
+				counts[metric.Type]++
+			}
+		}
+	}
+
+	r := values{}
+
+	for k, c := range counts {
+		r[k] = sums[k] / float64(c)
+	}
+
+	return r
+}
+
+func (a *Autoscaler) fetch(ctx context.Context, group api.ServerGroup, id string) (samples, error) {
+	ctxChild, cancel := globals.GetGlobalTimeouts().ArangoD().WithTimeout(ctx)
+	defer cancel()
+
+	client, err := a.context.GetServerClient(ctxChild, group, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return fetchSamples(ctxChild, client)
+}
+
+// blockedReason returns the reason if the decision cannot be applied safely
+func (a *Autoscaler) blockedReason(spec api.DeploymentSpec, status api.DeploymentStatus, group api.ServerGroup, members api.MemberStatusList, d decision) (string, bool) {
+	groupSpec := spec.GetServerGroupSpec(group)
+	groupSpec.Count = &d.To
+	spec.UpdateServerGroupSpec(group, groupSpec)
+
+	if err := spec.Validate(); err != nil {
+		return fmt.Sprintf("specification is not valid: %s", err.Error()), true
+	}
+
+	if d.To > d.From {
+		return "", false
+	}
+
+	if !status.Plan.IsEmpty() || !status.HighPriorityPlan.IsEmpty() || !status.ResourcesPlan.IsEmpty() {
+		return "plan is not empty", true
+	}
+
+	if !members.AllMembersReady() {
+		return "not all members are ready", true
+	}
+
+	if group == api.ServerGroupDBServers {
+		cache, ok := a.context.GetAgencyCache()
+		if !ok {
+			return "agency cache is not available", true
+		}
+
+		if rf := maxReplicationFactor(cache.Plan.Collections); d.To < rf {
+			return fmt.Sprintf("collections with replication factor %d require %d DBServers", rf, rf), true
+		}
+	}
+
+	return "", false
+}
+
+// apply updates the count of the group and records the decision
+func (a *Autoscaler) apply(ctx context.Context, group api.ServerGroup, d decision) error {
+	field, ok := groupField(group)
+	if !ok {
+		return errors.Errorf("Autoscaling is not supported for %s", group.AsRole())
+	}
+
+	a.log.Str("role", group.AsRole()).Int("from", d.From).Int("to", d.To).Str("reason", d.Reason).Info("Autoscaling group")
+
+	if err := a.context.ApplyPatch(ctx, patch.ItemReplace(patch.NewPath("spec", field, "count"), d.To)); err != nil {
+		return errors.Wrapf(err, "Unable to update count")
+	}
+
+	if err := a.context.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
+		gs := s.GetServerGroupStatus(group)
+		gs.Autoscaling = &api.ServerGroupAutoscalingStatus{
+			LastDecision: &api.ServerGroupAutoscalingDecision{
+				Time:   meta.Now(),
+				From:   d.From,
+				To:     d.To,
+				Reason: d.Reason,
+			},
+		}
+		s.UpdateServerGroupStatus(group, gs)
+		return true
+	}); err != nil {
+		return errors.Wrapf(err, "Unable to update status")
+	}
+
+	a.context.CreateEvent(k8sutil.NewAutoscalingEvent(a.context.GetAPIObject(), group.AsRole(), d.From, d.To, d.Reason))
+
+	return nil
+}
+
+// groupField returns the name of the group field in the specification
+func groupField(group api.ServerGroup) (string, bool) {
+	switch group {
+	case api.ServerGroupCoordinators:
+		return "coordinators", true
+	case api.ServerGroupDBServers:
+		return "dbservers", true
+	default:
+		return "", false
+	}
+}
+
+// maxReplicationFactor returns the highest replication factor of the non-satellite collections
+func maxReplicationFactor(collections state.PlanCollections) int {
+	var r int
+
+	for _, db := range collections {
+		for _, col := range db {
+			if rf := col.ReplicationFactor; rf != nil && *rf == state.SatelliteReplicationFactor {
+				continue
+			}
+
+			for shard := range col.Shards {
+				if rf := int(col.GetReplicationFactor(shard)); rf > r {
+					r = rf
+				}
+			}
+		}
+	}
+
+	return r
+}
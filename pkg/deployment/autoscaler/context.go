@@ -0,0 +1,53 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package autoscaler
+
+import (
+	"context"
+
+	adbDriverV2 "github.com/arangodb/go-driver/v2/arangodb"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency/state"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/patch"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/reconciler"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+// Context provides methods to the autoscaler package.
+type Context interface {
+	// GetSpec returns the current specification of the deployment
+	GetSpec() api.DeploymentSpec
+	// GetStatus returns the current status of the deployment
+	GetStatus() api.DeploymentStatus
+	// GetServerClient returns a cached client for a specific server.
+	GetServerClient(ctx context.Context, group api.ServerGroup, id string) (adbDriverV2.Client, error)
+	// GetAgencyCache returns the agency cache.
+	GetAgencyCache() (state.State, bool)
+	// ApplyPatch applies specified patch to the resource
+	ApplyPatch(ctx context.Context, p ...patch.Item) error
+	// WithStatusUpdate update status of ArangoDeployment with defined modifier. If action returns True action is taken
+	WithStatusUpdate(ctx context.Context, action reconciler.DeploymentStatusUpdateFunc) error
+	// GetAPIObject returns the deployment as k8s object.
+	GetAPIObject() k8sutil.APIObject
+	// CreateEvent creates a given event.
+	CreateEvent(evt *k8sutil.Event)
+}
@@ -0,0 +1,153 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package autoscaler
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+)
+
+// values keeps the group averages of the metrics
+type values map[api.ServerGroupAutoscalingMetricType]float64
+
+// decision describes the change of the group count requested by the autoscaler
+type decision struct {
+	From, To int
+	Reason   string
+}
+
+// evaluate returns the count required by the metrics, together with the reason.
+// The highest count required by any of the metrics is returned. Scale down is requested only if all metrics
+// are below the target, also after the removal of the member.
+func evaluate(spec *api.ServerGroupAutoscalingSpec, current int, v values) (int, string) {
+	if current <= 0 {
+		return current, ""
+	}
+
+	tolerance := float64(spec.GetTolerance()) / 100
+
+	desired := current
+	var upReason string
+	var downReasons []string
+
+	found := false
+	scaleDown := current > 1
+
+	for _, m := range spec.GetMetrics() {
+		value, ok := v[m.Type]
+		if !ok {
+			continue
+		}
+
+		found = true
+
+		target := float64(m.Target)
+		ratio := value / target
+
+		if ratio > 1+tolerance {
+			if d := int(math.Ceil(float64(current) * ratio)); d > desired {
+				desired = d
+				upReason = fmt.Sprintf("%s %.2f above target %d", m.Type, value, m.Target)
+			}
+		}
+
+		if ratio >= 1-tolerance {
+			scaleDown = false
+			continue
+		}
+
+		if current > 1 && value*float64(current)/float64(current-1) > target {
+			// Removal of the member would bring the metric above the target
+			scaleDown = false
+			continue
+		}
+
+		downReasons = append(downReasons, fmt.Sprintf("%s %.2f below target %d", m.Type, value, m.Target))
+	}
+
+	if !found {
+		return current, ""
+	}
+
+	if desired > current {
+		return desired, upReason
+	}
+
+	if scaleDown {
+		sort.Strings(downReasons)
+		return current - 1, strings.Join(downReasons, ", ")
+	}
+
+	return current, ""
+}
+
+// decide returns the decision of the autoscaler, taking the count range, scale up step and cooldowns into account
+func decide(spec api.ServerGroupSpec, status *api.ServerGroupAutoscalingStatus, current int, v values, now time.Time) (decision, bool) {
+	as := spec.Autoscaling
+
+	desired, reason := evaluate(as, current, v)
+
+	if min := spec.GetMinCount(); desired < min {
+		desired = min
+		reason = fmt.Sprintf("count below minCount %d", min)
+	}
+
+	if max := spec.GetMaxCount(); desired > max {
+		desired = max
+		if desired < current {
+			reason = fmt.Sprintf("count above maxCount %d", max)
+		}
+	}
+
+	if desired == current {
+		return decision{}, false
+	}
+
+	last, hasLast := status.GetLastScaleTime()
+
+	if desired > current {
+		if step := as.GetMaxScaleUpStep(); desired-current > step {
+			desired = current + step
+		}
+
+		if hasLast && now.Sub(last) < as.GetScaleUpCooldown() {
+			return decision{}, false
+		}
+	} else {
+		// Scale down always one member at a time
+		desired = current - 1
+
+		if hasLast && now.Sub(last) < as.GetScaleDownCooldown() {
+			return decision{}, false
+		}
+	}
+
+	return decision{
+		From:   current,
+		To:     desired,
+		Reason: reason,
+	}, true
+}
@@ -0,0 +1,161 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package autoscaler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func testGroupSpec(min, max int, metrics ...api.ServerGroupAutoscalingMetric) api.ServerGroupSpec {
+	return api.ServerGroupSpec{
+		MinCount: util.NewType(min),
+		MaxCount: util.NewType(max),
+		Autoscaling: &api.ServerGroupAutoscalingSpec{
+			Enabled: util.NewType(true),
+			Metrics: metrics,
+		},
+	}
+}
+
+func Test_Evaluate(t *testing.T) {
+	spec := testGroupSpec(2, 10,
+		api.ServerGroupAutoscalingMetric{Type: api.ServerGroupAutoscalingMetricTypeQueueLength, Target: 10},
+		api.ServerGroupAutoscalingMetric{Type: api.ServerGroupAutoscalingMetricTypeMemoryUsage, Target: 80},
+	).Autoscaling
+
+	t.Run("No values", func(t *testing.T) {
+		c, _ := evaluate(spec, 3, values{})
+		require.Equal(t, 3, c)
+	})
+
+	t.Run("Within tolerance", func(t *testing.T) {
+		c, _ := evaluate(spec, 3, values{
+			api.ServerGroupAutoscalingMetricTypeQueueLength: 10.5,
+			api.ServerGroupAutoscalingMetricTypeMemoryUsage: 75,
+		})
+		require.Equal(t, 3, c)
+	})
+
+	t.Run("Scale up by the highest metric", func(t *testing.T) {
+		c, reason := evaluate(spec, 3, values{
+			api.ServerGroupAutoscalingMetricTypeQueueLength: 20,
+			api.ServerGroupAutoscalingMetricTypeMemoryUsage: 100,
+		})
+		require.Equal(t, 6, c)
+		require.Equal(t, "QueueLength 20.00 above target 10", reason)
+	})
+
+	t.Run("Scale up if one metric is above", func(t *testing.T) {
+		c, reason := evaluate(spec, 3, values{
+			api.ServerGroupAutoscalingMetricTypeQueueLength: 1,
+			api.ServerGroupAutoscalingMetricTypeMemoryUsage: 100,
+		})
+		require.Equal(t, 4, c)
+		require.Equal(t, "MemoryUsage 100.00 above target 80", reason)
+	})
+
+	t.Run("Scale down if all metrics are below", func(t *testing.T) {
+		c, reason := evaluate(spec, 4, values{
+			api.ServerGroupAutoscalingMetricTypeQueueLength: 2,
+			api.ServerGroupAutoscalingMetricTypeMemoryUsage: 40,
+		})
+		require.Equal(t, 3, c)
+		require.Equal(t, "MemoryUsage 40.00 below target 80, QueueLength 2.00 below target 10", reason)
+	})
+
+	t.Run("No scale down if removal exceeds target", func(t *testing.T) {
+		c, _ := evaluate(spec, 3, values{
+			api.ServerGroupAutoscalingMetricTypeQueueLength: 2,
+			api.ServerGroupAutoscalingMetricTypeMemoryUsage: 60,
+		})
+		require.Equal(t, 3, c)
+	})
+}
+
+func Test_Decide(t *testing.T) {
+	now := time.Date(2026, time.January, 1, 12, 0, 0, 0, time.UTC)
+	spec := testGroupSpec(2, 5,
+		api.ServerGroupAutoscalingMetric{Type: api.ServerGroupAutoscalingMetricTypeQueueLength, Target: 10},
+	)
+
+	status := func(ago time.Duration) *api.ServerGroupAutoscalingStatus {
+		return &api.ServerGroupAutoscalingStatus{
+			LastDecision: &api.ServerGroupAutoscalingDecision{
+				Time: meta.NewTime(now.Add(-ago)),
+			},
+		}
+	}
+
+	t.Run("Scale up limited by the step", func(t *testing.T) {
+		d, ok := decide(spec, nil, 2, values{api.ServerGroupAutoscalingMetricTypeQueueLength: 40}, now)
+		require.True(t, ok)
+		require.Equal(t, 2, d.From)
+		require.Equal(t, 3, d.To)
+	})
+
+	t.Run("Scale up limited by the maxCount", func(t *testing.T) {
+		_, ok := decide(spec, nil, 5, values{api.ServerGroupAutoscalingMetricTypeQueueLength: 40}, now)
+		require.False(t, ok)
+	})
+
+	t.Run("Scale up in cooldown", func(t *testing.T) {
+		_, ok := decide(spec, status(time.Minute), 2, values{api.ServerGroupAutoscalingMetricTypeQueueLength: 40}, now)
+		require.False(t, ok)
+
+		_, ok = decide(spec, status(10*time.Minute), 2, values{api.ServerGroupAutoscalingMetricTypeQueueLength: 40}, now)
+		require.True(t, ok)
+	})
+
+	t.Run("Scale down limited by the minCount", func(t *testing.T) {
+		_, ok := decide(spec, nil, 2, values{api.ServerGroupAutoscalingMetricTypeQueueLength: 1}, now)
+		require.False(t, ok)
+	})
+
+	t.Run("Scale down in cooldown", func(t *testing.T) {
+		_, ok := decide(spec, status(10*time.Minute), 4, values{api.ServerGroupAutoscalingMetricTypeQueueLength: 1}, now)
+		require.False(t, ok)
+
+		d, ok := decide(spec, status(time.Hour), 4, values{api.ServerGroupAutoscalingMetricTypeQueueLength: 1}, now)
+		require.True(t, ok)
+		require.Equal(t, 3, d.To)
+	})
+
+	t.Run("Count below minCount", func(t *testing.T) {
+		d, ok := decide(spec, nil, 1, values{}, now)
+		require.True(t, ok)
+		require.Equal(t, 2, d.To)
+		require.Equal(t, "count below minCount 2", d.Reason)
+	})
+
+	t.Run("Count above maxCount", func(t *testing.T) {
+		d, ok := decide(spec, nil, 7, values{}, now)
+		require.True(t, ok)
+		require.Equal(t, 6, d.To)
+		require.Equal(t, "count above maxCount 5", d.Reason)
+	})
+}
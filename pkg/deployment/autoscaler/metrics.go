@@ -0,0 +1,174 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package autoscaler
+
+import (
+	"bytes"
+	"context"
+	goHttp "net/http"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/prom2json"
+
+	adbDriverV2 "github.com/arangodb/go-driver/v2/arangodb"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/arangod"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+const (
+	metricSchedulerQueueLength = "arangodb_scheduler_queue_length"
+	metricRequestTime          = "arangodb_client_connection_statistics_request_time"
+	metricTotalDiskSpace       = "rocksdb_total_disk_space"
+	metricFreeDiskSpace        = "rocksdb_free_disk_space"
+	metricResidentSetSize      = "arangodb_process_statistics_resident_set_size"
+	metricPhysicalMemory       = "arangodb_server_statistics_physical_memory"
+
+	histogramSumSuffix   = "_sum"
+	histogramCountSuffix = "_count"
+)
+
+// samples keeps the values of the metrics exposed by the member. Histograms are kept as `_sum` and `_count` values
+type samples map[string]float64
+
+func (s samples) get(name string) (float64, bool) {
+	if s == nil {
+		return 0, false
+	}
+
+	v, ok := s[name]
+	return v, ok
+}
+
+// fetchSamples fetches the metrics of the member
+func fetchSamples(ctx context.Context, client adbDriverV2.Client) (samples, error) {
+	data, err := arangod.GetRequest[[]byte](ctx, client.Connection(), "_admin", "metrics", "v2").
+		Do(ctx).
+		AcceptCode(goHttp.StatusOK).
+		Response()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseSamples(data)
+}
+
+// parseSamples parses the metrics in the prometheus text format. Values of the metrics with labels are summed up
+func parseSamples(data []byte) (samples, error) {
+	ch := make(chan *dto.MetricFamily, 1024)
+	done := make(chan error, 1)
+
+	go func() {
+		done <- prom2json.ParseReader(bytes.NewReader(data), ch)
+	}()
+
+	r := samples{}
+
+	for mf := range ch {
+		name := mf.GetName()
+		for _, m := range mf.GetMetric() {
+			switch mf.GetType() {
+			case dto.MetricType_GAUGE:
+				r[name] += m.GetGauge().GetValue()
+			case dto.MetricType_COUNTER:
+				r[name] += m.GetCounter().GetValue()
+			case dto.MetricType_UNTYPED:
+				r[name] += m.GetUntyped().GetValue()
+			case dto.MetricType_HISTOGRAM:
+				r[name+histogramSumSuffix] += m.GetHistogram().GetSampleSum()
+				r[name+histogramCountSuffix] += float64(m.GetHistogram().GetSampleCount())
+			case dto.MetricType_SUMMARY:
+				r[name+histogramSumSuffix] += m.GetSummary().GetSampleSum()
+				r[name+histogramCountSuffix] += float64(m.GetSummary().GetSampleCount())
+			}
+		}
+	}
+
+	if err := <-done; err != nil {
+		return nil, errors.Wrapf(err, "Unable to parse metrics")
+	}
+
+	return r, nil
+}
+
+// memberValue calculates the value of the metric from the current and the previous samples of the member
+func memberValue(metric api.ServerGroupAutoscalingMetricType, current, previous samples) (float64, bool) {
+	switch metric {
+	case api.ServerGroupAutoscalingMetricTypeQueueLength:
+		return current.get(metricSchedulerQueueLength)
+	case api.ServerGroupAutoscalingMetricTypeRequestLatency:
+		// Histogram is cumulative, so the average is calculated from the difference between the samples
+		sum, ok := current.get(metricRequestTime + histogramSumSuffix)
+		if !ok {
+			return 0, false
+		}
+		count, ok := current.get(metricRequestTime + histogramCountSuffix)
+		if !ok {
+			return 0, false
+		}
+		prevSum, ok := previous.get(metricRequestTime + histogramSumSuffix)
+		if !ok {
+			return 0, false
+		}
+		prevCount, ok := previous.get(metricRequestTime + histogramCountSuffix)
+		if !ok {
+			return 0, false
+		}
+
+		if count < prevCount || sum < prevSum {
+			// Member has been restarted
+			return 0, false
+		}
+
+		if count == prevCount {
+			// No requests since the last sample
+			return 0, true
+		}
+
+		// Seconds to milliseconds
+		return (sum - prevSum) / (count - prevCount) * 1000, true
+	case api.ServerGroupAutoscalingMetricTypeDiskUsage:
+		total, ok := current.get(metricTotalDiskSpace)
+		if !ok || total <= 0 {
+			return 0, false
+		}
+		free, ok := current.get(metricFreeDiskSpace)
+		if !ok {
+			return 0, false
+		}
+
+		return (total - free) / total * 100, true
+	case api.ServerGroupAutoscalingMetricTypeMemoryUsage:
+		physical, ok := current.get(metricPhysicalMemory)
+		if !ok || physical <= 0 {
+			return 0, false
+		}
+		rss, ok := current.get(metricResidentSetSize)
+		if !ok {
+			return 0, false
+		}
+
+		return rss / physical * 100, true
+	default:
+		return 0, false
+	}
+}
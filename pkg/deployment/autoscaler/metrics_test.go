@@ -0,0 +1,117 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package autoscaler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency/state"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+const testMetrics = `# HELP arangodb_scheduler_queue_length Server's internal queue length
+# TYPE arangodb_scheduler_queue_length gauge
+arangodb_scheduler_queue_length{role="COORDINATOR"} 7
+# HELP arangodb_client_connection_statistics_request_time Request time
+# TYPE arangodb_client_connection_statistics_request_time histogram
+arangodb_client_connection_statistics_request_time_bucket{le="0.01"} 50
+arangodb_client_connection_statistics_request_time_bucket{le="+Inf"} 100
+arangodb_client_connection_statistics_request_time_sum 20
+arangodb_client_connection_statistics_request_time_count 100
+# HELP rocksdb_total_disk_space Total disk space
+# TYPE rocksdb_total_disk_space gauge
+rocksdb_total_disk_space 1000
+# HELP rocksdb_free_disk_space Free disk space
+# TYPE rocksdb_free_disk_space gauge
+rocksdb_free_disk_space 250
+# HELP arangodb_process_statistics_resident_set_size RSS
+# TYPE arangodb_process_statistics_resident_set_size gauge
+arangodb_process_statistics_resident_set_size 300
+# HELP arangodb_server_statistics_physical_memory Physical memory
+# TYPE arangodb_server_statistics_physical_memory gauge
+arangodb_server_statistics_physical_memory 1000
+`
+
+func Test_MemberValue(t *testing.T) {
+	current, err := parseSamples([]byte(testMetrics))
+	require.NoError(t, err)
+
+	v, ok := memberValue(api.ServerGroupAutoscalingMetricTypeQueueLength, current, nil)
+	require.True(t, ok)
+	require.EqualValues(t, 7, v)
+
+	v, ok = memberValue(api.ServerGroupAutoscalingMetricTypeDiskUsage, current, nil)
+	require.True(t, ok)
+	require.EqualValues(t, 75, v)
+
+	v, ok = memberValue(api.ServerGroupAutoscalingMetricTypeMemoryUsage, current, nil)
+	require.True(t, ok)
+	require.EqualValues(t, 30, v)
+
+	// Latency requires previous sample
+	_, ok = memberValue(api.ServerGroupAutoscalingMetricTypeRequestLatency, current, nil)
+	require.False(t, ok)
+
+	previous := samples{
+		metricRequestTime + histogramSumSuffix:   10,
+		metricRequestTime + histogramCountSuffix: 50,
+	}
+
+	v, ok = memberValue(api.ServerGroupAutoscalingMetricTypeRequestLatency, current, previous)
+	require.True(t, ok)
+	require.InDelta(t, 200, v, 0.001)
+
+	// Restarted member
+	previous[metricRequestTime+histogramCountSuffix] = 500
+	_, ok = memberValue(api.ServerGroupAutoscalingMetricTypeRequestLatency, current, previous)
+	require.False(t, ok)
+
+	_, ok = memberValue(api.ServerGroupAutoscalingMetricTypeDiskUsage, samples{}, nil)
+	require.False(t, ok)
+}
+
+func Test_MaxReplicationFactor(t *testing.T) {
+	satellite := state.SatelliteReplicationFactor
+	three := state.ReplicationFactor(3)
+
+	collections := state.PlanCollections{
+		"db": state.PlanDBCollections{
+			"a": state.PlanCollection{
+				ReplicationFactor: &three,
+				Shards:            state.Shards{"s1": state.Servers{"A", "B", "C"}},
+			},
+			"b": state.PlanCollection{
+				ReplicationFactor: &satellite,
+				Shards:            state.Shards{"s2": state.Servers{"A", "B", "C", "D", "E"}},
+			},
+			"c": state.PlanCollection{
+				ReplicationFactor: util.NewType(state.ReplicationFactor(2)),
+				Shards:            state.Shards{"s3": state.Servers{"A", "B"}},
+			},
+		},
+	}
+
+	require.Equal(t, 3, maxReplicationFactor(collections))
+	require.Equal(t, 0, maxReplicationFactor(nil))
+}
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -26,7 +26,14 @@ import (
 	core "k8s.io/api/core/v1"
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	adbDriverV2 "github.com/arangodb/go-driver/v2/arangodb"
+	adbDriverV2Connection "github.com/arangodb/go-driver/v2/connection"
+
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency/state"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 )
 
 // Context provides methods to the chaos package.
@@ -38,4 +45,18 @@ type Context interface {
 	DeletePod(ctx context.Context, podName string, options meta.DeleteOptions) error
 	// GetOwnedPods returns a list of all pods owned by the deployment.
 	GetOwnedPods(ctx context.Context) ([]core.Pod, error)
+	// GetStatus returns the current status of the deployment
+	GetStatus() api.DeploymentStatus
+	// GetAgencyCache returns the agency cache.
+	GetAgencyCache() (state.State, bool)
+	// GetAgencyHealth returns the health of the agency.
+	GetAgencyHealth() (agency.Health, bool)
+	// GetDatabaseWithWrap returns a client to the database (cluster coordinators or single server) with the provided connection wrappers.
+	GetDatabaseWithWrap(wrappers ...util.ModR[adbDriverV2Connection.Connection]) (adbDriverV2.Client, error)
+	// UpdateMember updates the deployment status wrt the given member.
+	UpdateMember(ctx context.Context, member api.MemberStatus) error
+	// GetAPIObject returns the deployment as k8s object.
+	GetAPIObject() k8sutil.APIObject
+	// CreateEvent creates a given event.
+	CreateEvent(evt *k8sutil.Event)
 }
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2023 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -22,13 +22,15 @@ package chaos
 
 import (
 	"context"
+	"time"
 
 	"github.com/rs/zerolog"
-	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/generated/metric_descriptions"
 	"github.com/arangodb/kube-arangodb/pkg/logging"
 	"github.com/arangodb/kube-arangodb/pkg/util"
-	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 	"github.com/arangodb/kube-arangodb/pkg/util/timer"
 )
 
@@ -66,13 +68,12 @@ func (m Monkey) Run(stopCh <-chan struct{}) {
 
 	for {
 		spec := m.context.GetSpec()
-		if spec.Chaos.IsEnabled() {
-			// Gamble to set if we must introduce chaos
-			chance := float64(spec.Chaos.GetKillPodProbability()) / 100.0
-			if util.Rand().Float64() < chance {
-				// Let's introduce pod chaos
-				if err := m.killRandomPod(ctx); err != nil {
-					m.log.Err(err).Info("Failed to kill random pod")
+		if spec.Chaos.IsEnabled() && spec.Chaos.Windows.Contains(time.Now()) {
+			for _, scenario := range spec.Chaos.GetScenarios() {
+				// Gamble to set if we must introduce chaos
+				chance := float64(scenario.GetProbability()) / 100.0
+				if util.Rand().Float64() < chance {
+					m.runScenario(ctx, spec.Chaos, scenario.Type)
 				}
 			}
 		}
@@ -87,20 +88,26 @@ func (m Monkey) Run(stopCh <-chan struct{}) {
 	}
 }
 
-// killRandomPod fetches all owned pods and tries to kill one.
-func (m Monkey) killRandomPod(ctx context.Context) error {
-	pods, err := m.context.GetOwnedPods(ctx)
-	if err != nil {
-		return errors.WithStack(err)
+// runScenario executes the scenario and records the injected event
+func (m Monkey) runScenario(ctx context.Context, spec api.ChaosSpec, scenario api.ChaosScenarioType) {
+	impl, ok := scenarios[scenario]
+	if !ok {
+		m.log.Str("scenario", string(scenario)).Warn("Unknown chaos scenario")
+		return
 	}
-	if len(pods) <= 1 {
-		// Not enough pods
-		return nil
+
+	target, err := impl(ctx, m, spec)
+	if err != nil {
+		m.log.Err(err).Str("scenario", string(scenario)).Info("Failed to inject chaos scenario")
+		return
 	}
-	p := pods[util.Rand().Intn(len(pods))]
-	m.log.Str("pod-name", p.GetName()).Info("Killing pod")
-	if err := m.context.DeletePod(ctx, p.GetName(), meta.DeleteOptions{}); err != nil {
-		return errors.WithStack(err)
+
+	if target == "" {
+		// Nothing to do
+		return
 	}
-	return nil
+
+	m.log.Str("scenario", string(scenario)).Str("target", target).Info("Chaos scenario injected")
+	m.context.CreateEvent(k8sutil.NewChaosEvent(string(scenario), target, m.context.GetAPIObject()))
+	metric_descriptions.GlobalArangodbOperatorChaosEventsCounter().Inc(metric_descriptions.NewArangodbOperatorChaosEventsInput(m.namespace, m.name, string(scenario)))
 }
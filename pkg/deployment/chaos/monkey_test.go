@@ -0,0 +1,240 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package chaos
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	adbDriverV2 "github.com/arangodb/go-driver/v2/arangodb"
+	adbDriverV2Connection "github.com/arangodb/go-driver/v2/connection"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency/state"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/arangodb/kube-arangodb/pkg/util/metrics"
+)
+
+type testHealth struct {
+	leader string
+}
+
+func (t testHealth) Healthy() error { return nil }
+
+func (t testHealth) Serving() error { return nil }
+
+func (t testHealth) LeaderID() string { return t.leader }
+
+func (t testHealth) Agents() []agency.HealthAgent { return nil }
+
+func (t testHealth) CollectMetrics(m metrics.PushMetric) {}
+
+type testContext struct {
+	spec   api.DeploymentSpec
+	status api.DeploymentStatus
+	pods   []core.Pod
+	health agency.Health
+
+	deleted []string
+	updated api.MemberStatusList
+	events  []*k8sutil.Event
+}
+
+func (t *testContext) GetSpec() api.DeploymentSpec {
+	return t.spec
+}
+
+func (t *testContext) DeletePod(ctx context.Context, podName string, options meta.DeleteOptions) error {
+	t.deleted = append(t.deleted, podName)
+	return nil
+}
+
+func (t *testContext) GetOwnedPods(ctx context.Context) ([]core.Pod, error) {
+	return t.pods, nil
+}
+
+func (t *testContext) GetStatus() api.DeploymentStatus {
+	return t.status
+}
+
+func (t *testContext) GetAgencyCache() (state.State, bool) {
+	return state.State{}, false
+}
+
+func (t *testContext) GetAgencyHealth() (agency.Health, bool) {
+	return t.health, t.health != nil
+}
+
+func (t *testContext) GetDatabaseWithWrap(wrappers ...util.ModR[adbDriverV2Connection.Connection]) (adbDriverV2.Client, error) {
+	return nil, errors.Errorf("Not implemented")
+}
+
+func (t *testContext) UpdateMember(ctx context.Context, member api.MemberStatus) error {
+	t.updated = append(t.updated, member)
+	return nil
+}
+
+func (t *testContext) GetAPIObject() k8sutil.APIObject {
+	return &api.ArangoDeployment{}
+}
+
+func (t *testContext) CreateEvent(evt *k8sutil.Event) {
+	t.events = append(t.events, evt)
+}
+
+func newTestPod(name string, group api.ServerGroup, ready bool) core.Pod {
+	status := core.ConditionFalse
+	if ready {
+		status = core.ConditionTrue
+	}
+
+	return core.Pod{
+		ObjectMeta: meta.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				k8sutil.LabelKeyRole: group.AsRole(),
+			},
+		},
+		Status: core.PodStatus{
+			Conditions: []core.PodCondition{
+				{Type: core.PodScheduled, Status: core.ConditionTrue},
+				{Type: core.PodReady, Status: status},
+			},
+		},
+	}
+}
+
+func newTestContext() *testContext {
+	return &testContext{
+		pods: []core.Pod{
+			newTestPod("agnt-1", api.ServerGroupAgents, true),
+			newTestPod("agnt-2", api.ServerGroupAgents, true),
+			newTestPod("prmr-1", api.ServerGroupDBServers, true),
+		},
+		status: api.DeploymentStatus{
+			Members: api.DeploymentStatusMembers{
+				Agents: api.MemberStatusList{
+					{ID: "AGNT-1", Pod: &api.MemberPodStatus{Name: "agnt-1"}},
+					{ID: "AGNT-2", Pod: &api.MemberPodStatus{Name: "agnt-2"}},
+				},
+				DBServers: api.MemberStatusList{
+					{
+						ID:  "PRMR-1",
+						Pod: &api.MemberPodStatus{Name: "prmr-1"},
+						Conditions: api.ConditionList{
+							{Type: api.ConditionTypeReady, Status: core.ConditionTrue},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func Test_Scenario_KillPod_Groups(t *testing.T) {
+	c := newTestContext()
+	m := NewMonkey("ns", "name", c)
+
+	m.runScenario(context.Background(), api.ChaosSpec{Groups: []string{"dbserver"}}, api.ChaosScenarioKillPod)
+
+	require.Equal(t, []string{"prmr-1"}, c.deleted)
+	require.Len(t, c.events, 1)
+	require.Equal(t, "Chaos", c.events[0].Reason)
+	require.Equal(t, "Chaos scenario KillPod injected on prmr-1", c.events[0].Message)
+}
+
+func Test_Scenario_KillAgencyLeader(t *testing.T) {
+	t.Run("Leader", func(t *testing.T) {
+		c := newTestContext()
+		c.health = testHealth{leader: "AGNT-2"}
+		m := NewMonkey("ns", "name", c)
+
+		m.runScenario(context.Background(), api.ChaosSpec{}, api.ChaosScenarioKillAgencyLeader)
+
+		require.Equal(t, []string{"agnt-2"}, c.deleted)
+		require.Len(t, c.events, 1)
+	})
+
+	t.Run("Group not allowed", func(t *testing.T) {
+		c := newTestContext()
+		c.health = testHealth{leader: "AGNT-2"}
+		m := NewMonkey("ns", "name", c)
+
+		m.runScenario(context.Background(), api.ChaosSpec{Groups: []string{"dbserver"}}, api.ChaosScenarioKillAgencyLeader)
+
+		require.Empty(t, c.deleted)
+		require.Empty(t, c.events)
+	})
+
+	t.Run("Missing health", func(t *testing.T) {
+		c := newTestContext()
+		m := NewMonkey("ns", "name", c)
+
+		m.runScenario(context.Background(), api.ChaosSpec{}, api.ChaosScenarioKillAgencyLeader)
+
+		require.Empty(t, c.deleted)
+		require.Empty(t, c.events)
+	})
+}
+
+func Test_Scenario_PauseReadiness(t *testing.T) {
+	t.Run("Ready member", func(t *testing.T) {
+		c := newTestContext()
+		m := NewMonkey("ns", "name", c)
+
+		m.runScenario(context.Background(), api.ChaosSpec{}, api.ChaosScenarioPauseReadiness)
+
+		require.Empty(t, c.deleted)
+		require.Len(t, c.events, 1)
+		require.Equal(t, "Chaos scenario PauseReadiness injected on prmr-1", c.events[0].Message)
+		require.Len(t, c.updated, 1)
+		require.Equal(t, "PRMR-1", c.updated[0].ID)
+		require.True(t, c.updated[0].Conditions.IsTrue(api.ConditionTypeReadinessPaused))
+	})
+
+	t.Run("Already paused", func(t *testing.T) {
+		c := newTestContext()
+		c.status.Members.DBServers[0].Conditions.Update(api.ConditionTypeReadinessPaused, true, "ChaosMonkey", "")
+		m := NewMonkey("ns", "name", c)
+
+		m.runScenario(context.Background(), api.ChaosSpec{}, api.ChaosScenarioPauseReadiness)
+
+		require.Empty(t, c.updated)
+		require.Empty(t, c.events)
+	})
+
+	t.Run("Group not allowed", func(t *testing.T) {
+		c := newTestContext()
+		m := NewMonkey("ns", "name", c)
+
+		m.runScenario(context.Background(), api.ChaosSpec{Groups: []string{"agent"}}, api.ChaosScenarioPauseReadiness)
+
+		require.Empty(t, c.updated)
+		require.Empty(t, c.events)
+	})
+}
@@ -0,0 +1,218 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package chaos
+
+import (
+	"context"
+	"strings"
+
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	adbDriverV2 "github.com/arangodb/go-driver/v2/arangodb"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency/state"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+// scenario injects the chaos event and returns the affected target. Empty target means that nothing has been done.
+type scenario func(ctx context.Context, m Monkey, spec api.ChaosSpec) (string, error)
+
+var scenarios = map[api.ChaosScenarioType]scenario{
+	api.ChaosScenarioKillPod:               killRandomPod,
+	api.ChaosScenarioKillAgencyLeader:      killAgencyLeader,
+	api.ChaosScenarioKillShardLeader:       killShardLeader,
+	api.ChaosScenarioPauseReadiness:        pauseReadiness,
+	api.ChaosScenarioResignLeadershipStorm: resignLeadershipStorm,
+}
+
+// getAllowedPods returns all owned pods which belong to the allowed groups
+func getAllowedPods(ctx context.Context, m Monkey, spec api.ChaosSpec) ([]core.Pod, error) {
+	pods, err := m.context.GetOwnedPods(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if len(pods) <= 1 {
+		// Not enough pods
+		return nil, nil
+	}
+
+	r := make([]core.Pod, 0, len(pods))
+	for _, p := range pods {
+		if spec.IsGroupAllowed(api.ServerGroupFromRole(p.GetLabels()[k8sutil.LabelKeyRole])) {
+			r = append(r, p)
+		}
+	}
+
+	return r, nil
+}
+
+// killMemberPod deletes the pod of the given member
+func killMemberPod(ctx context.Context, m Monkey, member api.MemberStatus) (string, error) {
+	podName := member.Pod.GetName()
+	if podName == "" {
+		return "", nil
+	}
+
+	if err := m.context.DeletePod(ctx, podName, meta.DeleteOptions{}); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return podName, nil
+}
+
+// killRandomPod fetches all owned pods and tries to kill one.
+func killRandomPod(ctx context.Context, m Monkey, spec api.ChaosSpec) (string, error) {
+	pods, err := getAllowedPods(ctx, m, spec)
+	if err != nil || len(pods) == 0 {
+		return "", err
+	}
+
+	p := pods[util.Rand().Intn(len(pods))]
+	if err := m.context.DeletePod(ctx, p.GetName(), meta.DeleteOptions{}); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return p.GetName(), nil
+}
+
+// killAgencyLeader kills the pod of the current agency leader.
+func killAgencyLeader(ctx context.Context, m Monkey, spec api.ChaosSpec) (string, error) {
+	if !spec.IsGroupAllowed(api.ServerGroupAgents) {
+		return "", nil
+	}
+
+	health, ok := m.context.GetAgencyHealth()
+	if !ok {
+		return "", errors.Errorf("Agency health is not available")
+	}
+
+	leader := health.LeaderID()
+	if leader == "" {
+		return "", errors.Errorf("Agency leader is not known")
+	}
+
+	member, group, ok := m.context.GetStatus().Members.ElementByID(leader)
+	if !ok || group != api.ServerGroupAgents {
+		return "", errors.Errorf("Agency leader %s not found in members", leader)
+	}
+
+	return killMemberPod(ctx, m, member)
+}
+
+// getShardLeaders returns DBServers which hold shard leaders
+func getShardLeaders(m Monkey) (api.MemberStatusList, error) {
+	agencyState, ok := m.context.GetAgencyCache()
+	if !ok {
+		return nil, errors.Errorf("Agency cache is not available")
+	}
+
+	leaders := agencyState.PlanLeaderServers()
+
+	var r api.MemberStatusList
+	for _, member := range m.context.GetStatus().Members.DBServers {
+		if leaders.Contains(state.Server(member.ID)) {
+			r = append(r, member)
+		}
+	}
+
+	return r, nil
+}
+
+// killShardLeader kills the pod of a random DBServer which holds shard leaders.
+func killShardLeader(ctx context.Context, m Monkey, spec api.ChaosSpec) (string, error) {
+	if !spec.IsGroupAllowed(api.ServerGroupDBServers) {
+		return "", nil
+	}
+
+	leaders, err := getShardLeaders(m)
+	if err != nil || len(leaders) == 0 {
+		return "", err
+	}
+
+	return killMemberPod(ctx, m, leaders[util.Rand().Intn(len(leaders))])
+}
+
+// pauseReadiness marks a random ready member as not ready. The operator restores the readiness after the chaos interval.
+func pauseReadiness(ctx context.Context, m Monkey, spec api.ChaosSpec) (string, error) {
+	var members api.MemberStatusList
+	for _, e := range m.context.GetStatus().Members.AsList() {
+		if !spec.IsGroupAllowed(e.Group) || e.Member.Pod.GetName() == "" {
+			continue
+		}
+
+		if !e.Member.Conditions.IsTrue(api.ConditionTypeReady) || e.Member.Conditions.IsTrue(api.ConditionTypeReadinessPaused) {
+			continue
+		}
+
+		members = append(members, e.Member)
+	}
+
+	if len(members) == 0 {
+		return "", nil
+	}
+
+	member := members[util.Rand().Intn(len(members))]
+	member.Conditions.Update(api.ConditionTypeReadinessPaused, true, "ChaosMonkey", "Readiness paused by the chaos monkey")
+
+	if err := m.context.UpdateMember(ctx, member); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return member.Pod.GetName(), nil
+}
+
+// resignLeadershipStorm resigns the leadership of all DBServers which hold shard leaders.
+func resignLeadershipStorm(ctx context.Context, m Monkey, spec api.ChaosSpec) (string, error) {
+	if !spec.IsGroupAllowed(api.ServerGroupDBServers) {
+		return "", nil
+	}
+
+	leaders, err := getShardLeaders(m)
+	if err != nil || len(leaders) == 0 {
+		return "", err
+	}
+
+	client, err := m.context.GetDatabaseWithWrap()
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	var resigned []string
+	for _, member := range leaders {
+		err := globals.GetGlobalTimeouts().ArangoD().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+			_, err := client.ResignServer(ctxChild, adbDriverV2.ServerID(member.ID))
+			return err
+		})
+		if err != nil {
+			return "", errors.Wrapf(err, "Unable to resign leadership of %s", member.ID)
+		}
+
+		resigned = append(resigned, member.ID)
+	}
+
+	return strings.Join(resigned, ","), nil
+}
@@ -57,6 +57,8 @@ type Client interface {
 	Inventory(ctx context.Context) (Inventory, error)
 
 	DeploymentID(ctx context.Context) (DeploymentID, error)
+
+	CollectionFigures(ctx context.Context, database, collection string) (CollectionFiguresResponse, error)
 }
 
 type client struct {
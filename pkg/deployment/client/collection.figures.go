@@ -0,0 +1,52 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package client
+
+import (
+	"context"
+	goHttp "net/http"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/arangod"
+)
+
+type CollectionFiguresResponse struct {
+	Figures CollectionFigures `json:"figures"`
+}
+
+type CollectionFigures struct {
+	DocumentsSize int64 `json:"documentsSize"`
+
+	Indexes CollectionFiguresIndexes `json:"indexes"`
+}
+
+type CollectionFiguresIndexes struct {
+	Count int64 `json:"count"`
+	Size  int64 `json:"size"`
+}
+
+// GetSize returns the size of the documents and indexes of the collection
+func (c CollectionFigures) GetSize() int64 {
+	return c.DocumentsSize + c.Indexes.Size
+}
+
+func (c *client) CollectionFigures(ctx context.Context, database, collection string) (CollectionFiguresResponse, error) {
+	return arangod.GetRequest[CollectionFiguresResponse](ctx, c.c, "_db", database, "_api", "collection", collection, "figures").Do(ctx).AcceptCode(goHttp.StatusOK).Response()
+}
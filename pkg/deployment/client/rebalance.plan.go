@@ -43,9 +43,21 @@ type RebalancePlanResponse struct {
 }
 
 type RebalancePlanResponseResult struct {
+	ImbalanceBefore *RebalancePlanImbalance `json:"imbalanceBefore,omitempty"`
+	ImbalanceAfter  *RebalancePlanImbalance `json:"imbalanceAfter,omitempty"`
+
 	Moves RebalancePlanMoves `json:"moves"`
 }
 
+type RebalancePlanImbalance struct {
+	Leader *RebalancePlanImbalanceStats `json:"leader,omitempty"`
+	Shards *RebalancePlanImbalanceStats `json:"shards,omitempty"`
+}
+
+type RebalancePlanImbalanceStats struct {
+	Imbalance float64 `json:"imbalance"`
+}
+
 type RebalancePlanMoves []RebalancePlanMove
 
 type RebalancePlanMove struct {
@@ -53,6 +65,8 @@ type RebalancePlanMove struct {
 	To    string `json:"to"`
 	Shard string `json:"shard"`
 
+	IsLeader bool `json:"isLeader,omitempty"`
+
 	Collection intstr.IntOrString `json:"collection"`
 }
 
@@ -41,6 +41,7 @@ import (
 	"github.com/arangodb/kube-arangodb/pkg/deployment/acs/sutil"
 	"github.com/arangodb/kube-arangodb/pkg/deployment/agency"
 	"github.com/arangodb/kube-arangodb/pkg/deployment/agency/state"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/autoscaler"
 	"github.com/arangodb/kube-arangodb/pkg/deployment/chaos"
 	deploymentClient "github.com/arangodb/kube-arangodb/pkg/deployment/client"
 	"github.com/arangodb/kube-arangodb/pkg/deployment/features"
@@ -139,6 +140,7 @@ type Deployment struct {
 	resilience                *resilience.Resilience
 	resources                 *resources.Resources
 	chaosMonkey               *chaos.Monkey
+	autoscaler                *autoscaler.Autoscaler
 	acs                       sutil.ACS
 	haveServiceMonitorCRD     bool
 
@@ -312,6 +314,9 @@ func New(config Config, deps Dependencies, apiObject *api.ArangoDeployment) (*De
 		ci := newClusterScalingIntegration(d)
 		d.clusterScalingIntegration = ci
 		go ci.ListenForClusterEvents(d.stopCh)
+
+		d.autoscaler = autoscaler.NewAutoscaler(apiObject.GetNamespace(), apiObject.GetName(), d)
+		go d.autoscaler.Run(d.stopCh)
 	}
 	if config.AllowChaos {
 		d.chaosMonkey = chaos.NewMonkey(apiObject.GetNamespace(), apiObject.GetName(), d)
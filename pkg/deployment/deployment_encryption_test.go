@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2025 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -21,11 +21,14 @@
 package deployment
 
 import (
+	"context"
 	"fmt"
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 	shared "github.com/arangodb/kube-arangodb/pkg/apis/shared"
@@ -34,6 +37,8 @@ import (
 )
 
 func TestEnsurePod_ArangoDB_Encryption(t *testing.T) {
+	binaryPath, _ := os.Executable()
+
 	testCases := []testCaseStruct{
 		{
 			Name: "Agent CE 3.7.0 Pod with encrypted rocksdb",
@@ -309,6 +314,94 @@ func TestEnsurePod_ArangoDB_Encryption(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "Agent EE 3.7.0 Pod with encrypted rocksdb, KMS",
+			ArangoDeployment: &api.ArangoDeployment{
+				Spec: api.DeploymentSpec{
+					Image:          util.NewType[string](testImage),
+					Authentication: noAuthentication,
+					TLS:            noTLS,
+					RocksDB: api.RocksDBSpec{
+						Encryption: api.RocksDBEncryptionSpec{
+							KeySecretName: util.NewType[string](testRocksDBEncryptionKey),
+							KMS: &api.RocksDBEncryptionKMSSpec{
+								Provider: api.RocksDBEncryptionKMSProviderFile,
+								File: &api.RocksDBEncryptionKMSFileSpec{
+									SecretName: "kek",
+								},
+							},
+						},
+					},
+				},
+			},
+			Features: testCaseFeatures{
+				EncryptionRotation: true,
+			},
+			Helper: func(t *testing.T, deployment *Deployment, testCase *testCaseStruct) {
+				deployment.currentObjectStatus = &api.DeploymentStatus{
+					Members: api.DeploymentStatusMembers{
+						Agents: api.MemberStatusList{
+							firstAgentStatus,
+						},
+					},
+					Images: createTestImagesWithVersion(true, testVersion),
+				}
+
+				testCase.createTestPodData(deployment, api.ServerGroupAgents, firstAgentStatus)
+
+				_, err := deployment.SecretsModInterface().Create(context.Background(), &core.Secret{
+					ObjectMeta: meta.ObjectMeta{
+						Name: fmt.Sprintf("%s-encryption-folder", testDeploymentName),
+					},
+				}, meta.CreateOptions{})
+				require.NoError(t, err)
+			},
+			ExpectedEvent: "member agent is created",
+			ExpectedPod: core.Pod{
+				Spec: core.PodSpec{
+					Volumes: []core.Volume{
+						k8sutil.CreateVolumeEmptyDir(shared.ArangodVolumeName),
+						k8sutil.CreateVolumeMemoryEmptyDir(shared.RocksdbEncryptionVolumeName),
+						k8sutil.CreateVolumeWithSecret(shared.RocksdbEncryptionWrappedVolumeName, fmt.Sprintf("%s-encryption-folder", testDeploymentName)),
+						k8sutil.CreateVolumeWithSecret(shared.RocksdbEncryptionKMSVolumeName, "kek"),
+					},
+					InitContainers: []core.Container{
+						k8sutil.ArangodEncryptionKMSInitContainer(api.ServerGroupReservedInitContainerNameEncryption, binaryPath,
+							testImageOperator, []string{"--provider", "File", "--file.path", shared.RocksDBEncryptionKMSVolumeMountDir},
+							securityContext.NewSecurityContext(), false),
+					},
+					Containers: []core.Container{
+						{
+							Name:  shared.ServerContainerName,
+							Image: testImage,
+							Command: BuildTestAgentArgs(t, firstAgentStatus.ID,
+								AgentArgsWithTLS(firstAgentStatus.ID, false),
+								ArgsWithAuth(false),
+								ArgsWithEncryptionFolder(), func(t *testing.T) map[string]string {
+									return map[string]string{
+										"rocksdb.encryption-key-rotation": "true",
+									}
+								}),
+							Ports: createTestPorts(api.ServerGroupAgents),
+							VolumeMounts: []core.VolumeMount{
+								k8sutil.ArangodVolumeMount(),
+								k8sutil.RocksdbEncryptionReadOnlyVolumeMount(),
+							},
+							Resources:       emptyResources,
+							LivenessProbe:   createTestLivenessProbe(httpProbe, false, "", shared.ServerPortName),
+							ImagePullPolicy: core.PullIfNotPresent,
+							SecurityContext: securityContext.NewSecurityContext(),
+						},
+					},
+					RestartPolicy:                 core.RestartPolicyNever,
+					TerminationGracePeriodSeconds: &defaultAgentTerminationTimeout,
+					Hostname:                      testDeploymentName + "-" + api.ServerGroupAgentsString + "-" + firstAgentStatus.ID,
+					Subdomain:                     testDeploymentName + "-int",
+					Affinity: k8sutil.CreateAffinity(testDeploymentName, api.ServerGroupAgentsString,
+						false, ""),
+				},
+			},
+		},
 	}
 
 	runTestCases(t, testCases...)
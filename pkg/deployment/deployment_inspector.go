@@ -337,6 +337,11 @@ func (d *Deployment) inspectDeploymentWithError(ctx context.Context, lastInterva
 	// Refresh maintenance lock
 	d.refreshMaintenanceTTL(ctx)
 
+	// Apply plan execution controls
+	if err := d.inspectPlanControl(ctx); err != nil {
+		return minInspectionInterval, errors.Wrapf(err, "Plan control inspection failed")
+	}
+
 	// Create scale/update plan
 	if _, ok := d.currentObject.Annotations[deployment.ArangoDeploymentPlanCleanAnnotation]; ok {
 		if err := d.ApplyPatch(ctx, patch.ItemRemove(patch.NewPath("metadata", "annotations", deployment.ArangoDeploymentPlanCleanAnnotation))); err != nil {
@@ -0,0 +1,160 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package deployment
+
+import (
+	"context"
+
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/patch"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/reconcile"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+// inspectPlanControl applies the plan skip and step annotations and keeps the PlanPaused condition up to date
+func (d *Deployment) inspectPlanControl(ctx context.Context) error {
+	control := d.GetPlanExecutionControl()
+
+	if control.Skip != "" {
+		var skipped api.Action
+		var found bool
+		var skipErr error
+
+		if err := d.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
+			skipped, found, skipErr = reconcile.PlanRemoveAction(s, control.Skip, control.SkipForce)
+			return found
+		}); err != nil {
+			return errors.Wrapf(err, "Unable to skip plan action")
+		}
+
+		if skipErr != nil {
+			d.log.Err(skipErr).Str("action", string(skipped.Type)).Str("id", skipped.ID).Warn("Plan action not skipped, use the skip-force annotation to remove the started action")
+			d.CreateEvent(k8sutil.NewPlanActionSkipRejectedEvent(d.GetAPIObject(), string(skipped.Type), skipped.ID, skipped.MemberID, skipped.Group.AsRole()))
+		}
+
+		if found {
+			d.log.Str("action", string(skipped.Type)).Str("id", skipped.ID).Str("member", skipped.MemberID).Info("Plan action skipped")
+			d.CreateEvent(k8sutil.NewPlanActionSkippedEvent(d.GetAPIObject(), string(skipped.Type), skipped.ID, skipped.MemberID, skipped.Group.AsRole()))
+		}
+
+		if err := d.ApplyPatch(ctx, d.planSkipAnnotationsRemoval(control)...); err != nil {
+			return errors.Wrapf(err, "Unable to remove skip annotation")
+		}
+	}
+
+	status := d.GetStatus()
+
+	if control.Step != "" && !reconcile.PlanContainsAction(status, control.Step) {
+		// Stepped action is done
+		if err := d.ApplyPatch(ctx, patch.ItemRemove(patch.NewPath("metadata", "annotations", deployment.ArangoDeploymentPlanStepAnnotation))); err != nil {
+			return errors.Wrapf(err, "Unable to remove step annotation")
+		}
+	}
+
+	if control.Paused {
+		message := reconcile.PlanPausedMessage(status)
+		hash := util.SHA256FromString(message)
+
+		if c, ok := status.Conditions.Get(api.ConditionTypePlanPaused); !ok || !c.IsTrue() || c.Hash != hash {
+			return d.updateConditionWithHash(ctx, api.ConditionTypePlanPaused, true, "Plan Paused", message, hash)
+		}
+	} else if _, ok := status.Conditions.Get(api.ConditionTypePlanPaused); ok {
+		if err := d.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
+			return s.Conditions.Remove(api.ConditionTypePlanPaused)
+		}); err != nil {
+			return errors.Wrapf(err, "Unable to remove PlanPaused condition")
+		}
+	}
+
+	return nil
+}
+
+// planSkipAnnotationsRemoval returns the patch items which remove the processed skip annotations
+func (d *Deployment) planSkipAnnotationsRemoval(control reconcile.PlanExecutionControl) []patch.Item {
+	items := []patch.Item{
+		patch.ItemRemove(patch.NewPath("metadata", "annotations", deployment.ArangoDeploymentPlanSkipAnnotation)),
+	}
+
+	if control.SkipForce {
+		items = append(items, patch.ItemRemove(patch.NewPath("metadata", "annotations", deployment.ArangoDeploymentPlanSkipForceAnnotation)))
+	}
+
+	return items
+}
+
+// GetPlanExecutionControl returns the plan execution controls of the deployment
+func (d *Deployment) GetPlanExecutionControl() reconcile.PlanExecutionControl {
+	d.currentObjectLock.RLock()
+	defer d.currentObjectLock.RUnlock()
+
+	return reconcile.GetPlanExecutionControl(d.currentObject)
+}
+
+// UpdatePlanExecutionControl sets the plan execution control annotations of the deployment
+func (d *Deployment) UpdatePlanExecutionControl(ctx context.Context, control reconcile.PlanExecutionControl) error {
+	d.currentObjectLock.Lock()
+	defer d.currentObjectLock.Unlock()
+
+	expected := map[string]string{
+		deployment.ArangoDeploymentPlanStepAnnotation: control.Step,
+		deployment.ArangoDeploymentPlanSkipAnnotation: control.Skip,
+	}
+
+	if control.SkipForce {
+		expected[deployment.ArangoDeploymentPlanSkipForceAnnotation] = "true"
+	} else {
+		expected[deployment.ArangoDeploymentPlanSkipForceAnnotation] = ""
+	}
+
+	if control.Paused {
+		expected[deployment.ArangoDeploymentPlanPauseAnnotation] = "true"
+	} else {
+		expected[deployment.ArangoDeploymentPlanPauseAnnotation] = ""
+	}
+
+	current := d.currentObject.GetAnnotations()
+
+	if current == nil {
+		// Annotations need to be created first
+		if err := d.applyPatch(ctx, patch.ItemAdd(patch.NewPath("metadata", "annotations"), map[string]string{})); err != nil {
+			return err
+		}
+	}
+
+	var items []patch.Item
+
+	for _, key := range util.SortKeys(expected) {
+		value, exists := current[key]
+
+		if expected[key] == "" {
+			if exists {
+				items = append(items, patch.ItemRemove(patch.NewPath("metadata", "annotations", key)))
+			}
+		} else if !exists || value != expected[key] {
+			items = append(items, patch.ItemAdd(patch.NewPath("metadata", "annotations", key), expected[key]))
+		}
+	}
+
+	return d.applyPatch(ctx, items...)
+}
@@ -0,0 +1,52 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package deployment
+
+import (
+	"context"
+	goStrings "strings"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/reconcile"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// DryRunPlan returns the plan and the member rotation decisions which would be generated for the given candidate spec.
+// Spec is defaulted and validated in the same way as during the acceptance of the new spec.
+func (d *Deployment) DryRunPlan(ctx context.Context, spec api.DeploymentSpec) (reconcile.PlanDryRun, error) {
+	candidate := spec.DeepCopy()
+
+	candidate.SetDefaults(d.name)
+
+	if accepted := d.GetStatus().AcceptedSpec; accepted != nil {
+		candidate.SetDefaultsFrom(*accepted)
+
+		if fields := accepted.ResetImmutableFields(candidate); len(fields) > 0 {
+			return reconcile.PlanDryRun{}, errors.Errorf("Immutable fields cannot be changed: %s", goStrings.Join(fields, ", "))
+		}
+	}
+
+	if err := candidate.Validate(); err != nil {
+		return reconcile.PlanDryRun{}, err
+	}
+
+	return d.reconciler.DryRunPlan(ctx, *candidate)
+}
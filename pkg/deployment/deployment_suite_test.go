@@ -37,6 +37,8 @@ import (
 	core "k8s.io/api/core/v1"
 	extfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/kubernetes/fake"
 	recordfake "k8s.io/client-go/tools/record"
 
@@ -525,6 +527,7 @@ func createTestDeployment(t *testing.T, config Config, arangoDeployment *api.Ara
 	kubernetesExtClientSet := extfake.NewSimpleClientset()
 	monitoringClientSet := monitoringFakeClient.NewSimpleClientset()
 	arangoClientSet := arangofake.NewSimpleClientset()
+	dynamicClientSet := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
 
 	arangoDeployment.ObjectMeta = meta.ObjectMeta{
 		Name:      testDeploymentName,
@@ -544,7 +547,7 @@ func createTestDeployment(t *testing.T, config Config, arangoDeployment *api.Ara
 
 	deps := Dependencies{
 		EventRecorder: eventRecorder,
-		Client:        kclient.NewStaticClient(nil, kubernetesClientSet, kubernetesExtClientSet, arangoClientSet, monitoringClientSet),
+		Client:        kclient.NewStaticClient(nil, kubernetesClientSet, kubernetesExtClientSet, arangoClientSet, monitoringClientSet, dynamicClientSet),
 	}
 
 	i := inspector.NewInspector(throttle.NewAlwaysThrottleComponents(), deps.Client, arangoDeployment.GetNamespace(), arangoDeployment.GetName())
@@ -732,15 +735,17 @@ func defaultPodAppender(t *testing.T, pod *core.Pod, f ...func(t *testing.T, p *
 
 func podDataSort() func(t *testing.T, p *core.Pod) {
 	sortVolumes := map[string]int{
-		"rocksdb-encryption": -1,
-		"cluster-jwt":        1,
-		"tls-keyfile":        -2,
-		"arangod-data":       -3,
-		"exporter-jwt":       0,
-		"lifecycle":          2,
-		"uuid":               3,
-		"volume":             40,
-		"volume2":            40,
+		"rocksdb-encryption":         -1,
+		"rocksdb-encryption-wrapped": 0,
+		"rocksdb-encryption-kms":     1,
+		"cluster-jwt":                1,
+		"tls-keyfile":                -2,
+		"arangod-data":               -3,
+		"exporter-jwt":               0,
+		"lifecycle":                  2,
+		"uuid":                       3,
+		"volume":                     40,
+		"volume2":                    40,
 	}
 	sortVolumeMounts := map[string]int{
 		"tls-keyfile":        1,
@@ -754,6 +759,7 @@ func podDataSort() func(t *testing.T, p *core.Pod) {
 	sortInitContainers := map[string]int{
 		"init-lifecycle": 0,
 		"uuid":           1,
+		"encryption-kms": 2,
 	}
 
 	return func(t *testing.T, p *core.Pod) {
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2024 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -21,6 +21,8 @@
 package deployment
 
 import (
+	"time"
+
 	"github.com/arangodb/kube-arangodb/pkg/generated/metric_descriptions"
 	"github.com/arangodb/kube-arangodb/pkg/util/metrics"
 )
@@ -79,6 +81,15 @@ func (d *Deployment) CollectMetrics(m metrics.PushMetric) {
 		} else {
 			m.Push(metric_descriptions.ArangodbOperatorAgencyCacheHealthPresentGauge(0, d.namespace, d.name))
 		}
+
+		if s, ok := c.Data(); ok {
+			for phase, types := range s.Target.GetJobsStats(time.Now()) {
+				for t, stats := range types {
+					m.Push(metric_descriptions.ArangodbOperatorAgencyJobsGauge(float64(stats.Count), d.namespace, d.name, string(phase), t))
+					m.Push(metric_descriptions.ArangodbOperatorAgencyJobsOldestAgeGauge(stats.OldestAge.Seconds(), d.namespace, d.name, string(phase), t))
+				}
+			}
+		}
 	} else {
 		m.Push(metric_descriptions.ArangodbOperatorAgencyCachePresentGauge(0, d.namespace, d.name))
 	}
@@ -95,4 +106,23 @@ func (d *Deployment) CollectMetrics(m metrics.PushMetric) {
 
 	// Conditions
 	d.metrics.Conditions.CollectMetrics(d.namespace, d.name, m)
+
+	// JWT Keys
+	d.collectJWTKeysMetrics(m)
+}
+
+func (d *Deployment) collectJWTKeysMetrics(m metrics.PushMetric) {
+	status := d.GetStatus()
+	now := time.Now()
+
+	for hash, key := range status.Hashes.JWT.Keys {
+		state := "Pending"
+		if key.IsRetired() {
+			state = "Retired"
+		} else if key.IsActivated() {
+			state = "Active"
+		}
+
+		m.Push(metric_descriptions.ArangodbOperatorDeploymentJwtKeyAgeGauge(key.Age(now).Seconds(), d.namespace, d.name, hash, state))
+	}
 }
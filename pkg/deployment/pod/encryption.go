@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2025 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -66,7 +66,7 @@ func GroupEncryptionSupported(mode api.DeploymentMode, group api.ServerGroup) bo
 	}
 }
 
-func GetEncryptionKey(ctx context.Context, secrets generic.ReadClient[*core.Secret], name string) (string, []byte, bool, error) {
+func GetEncryptionKey(ctx context.Context, secrets generic.ReadClient[*core.Secret], spec api.RocksDBEncryptionSpec, name string) (string, []byte, bool, error) {
 	ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
 	defer cancel()
 
@@ -78,7 +78,7 @@ func GetEncryptionKey(ctx context.Context, secrets generic.ReadClient[*core.Secr
 		return "", nil, false, errors.Wrapf(err, "Unable to fetch secret")
 	}
 
-	sha, data, err := GetEncryptionKeyFromSecret(keyfile)
+	sha, data, err := GetEncryptionKeyfolderEntryFromSecret(ctx, secrets, spec, keyfile)
 
 	return sha, data, true, err
 }
@@ -158,6 +158,8 @@ func (e encryption) Volumes(i Input) ([]core.Volume, []core.VolumeMount) {
 	if !MultiFileMode(i) {
 		vol := k8sutil.CreateVolumeWithSecret(shared.RocksdbEncryptionVolumeName, i.Deployment.RocksDB.Encryption.GetKeySecretName())
 		return []core.Volume{vol}, []core.VolumeMount{k8sutil.RocksdbEncryptionVolumeMount()}
+	} else if IsEncryptionKMSEnabled(i) {
+		return encryptionKMSVolumes(i), []core.VolumeMount{k8sutil.RocksdbEncryptionReadOnlyVolumeMount()}
 	} else {
 		vol := k8sutil.CreateVolumeWithSecret(shared.RocksdbEncryptionVolumeName, GetEncryptionFolderSecretName(i.ApiObject.GetName()))
 		return []core.Volume{vol}, []core.VolumeMount{k8sutil.RocksdbEncryptionReadOnlyVolumeMount()}
@@ -174,6 +176,9 @@ func (e encryption) Verify(i Input, cachedStatus interfaces.Inspector) error {
 	}
 
 	if !MultiFileMode(i) {
+		if i.Deployment.RocksDB.Encryption.IsKMS() {
+			return errors.Errorf("RocksDB encryption with KMS requires encryption key rotation support")
+		}
 
 		secret, exists := cachedStatus.Secret().V1().GetSimple(i.Deployment.RocksDB.Encryption.GetKeySecretName())
 		if !exists {
@@ -186,5 +191,11 @@ func (e encryption) Verify(i Input, cachedStatus interfaces.Inspector) error {
 		return nil
 	}
 
+	if i.Deployment.RocksDB.Encryption.IsKMS() {
+		if _, exists := cachedStatus.Secret().V1().GetSimple(GetEncryptionFolderSecretName(i.ApiObject.GetName())); !exists {
+			return errors.Errorf("Encryption keyfolder secret does not exist %s", GetEncryptionFolderSecretName(i.ApiObject.GetName()))
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,276 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package pod
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	shared "github.com/arangodb/kube-arangodb/pkg/apis/shared"
+	utilConstants "github.com/arangodb/kube-arangodb/pkg/util/constants"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/generic"
+	"github.com/arangodb/kube-arangodb/pkg/util/kms"
+)
+
+const encryptionKMSVersionTTL = time.Minute
+
+type encryptionKMSProvider struct {
+	fingerprint string
+
+	provider kms.Provider
+}
+
+var (
+	encryptionKMSProvidersLock sync.Mutex
+	encryptionKMSProviders     = map[string]encryptionKMSProvider{}
+
+	// encryptionKMSKeyHashes keeps sha256 of the unwrapped keys, the plain keys are never kept in memory
+	encryptionKMSKeyHashes sync.Map
+)
+
+// IsEncryptionKMSEnabled returns true when the encryption keys are unwrapped by the KMS in the init container
+func IsEncryptionKMSEnabled(i Input) bool {
+	return IsEncryptionEnabled(i) && i.Deployment.RocksDB.Encryption.IsKMS() && MultiFileMode(i)
+}
+
+// GetEncryptionKMSProvider returns the KMS provider with the credentials loaded from the secrets.
+// Providers are reused until the credentials change.
+func GetEncryptionKMSProvider(ctx context.Context, secrets generic.ReadClient[*core.Secret], spec *api.RocksDBEncryptionKMSSpec) (kms.Provider, error) {
+	switch spec.GetProvider() {
+	case api.RocksDBEncryptionKMSProviderVault:
+		v := spec.GetVault()
+
+		token, err := getEncryptionKMSSecret(ctx, secrets, v.TokenSecretName)
+		if err != nil {
+			return nil, err
+		}
+
+		tokenData, ok := token.Data[utilConstants.SecretKeyToken]
+		if !ok {
+			return nil, errors.Errorf("Field '%s' is missing in secret %s", utilConstants.SecretKeyToken, v.TokenSecretName)
+		}
+
+		cfg := kms.VaultConfig{
+			Address:   v.Address,
+			MountPath: v.GetMountPath(),
+			KeyName:   v.KeyName,
+			Namespace: v.GetNamespace(),
+			Token:     strings.TrimSpace(string(tokenData)),
+		}
+
+		id := fmt.Sprintf("vault/%s/%s/%s/%s/%s", token.GetUID(), v.Address, cfg.Namespace, cfg.MountPath, cfg.KeyName)
+		fingerprint := token.GetResourceVersion()
+
+		if name := v.GetCASecretName(); name != "" {
+			ca, err := getEncryptionKMSSecret(ctx, secrets, name)
+			if err != nil {
+				return nil, err
+			}
+
+			caData, ok := ca.Data[utilConstants.SecretCACertificate]
+			if !ok {
+				return nil, errors.Errorf("Field '%s' is missing in secret %s", utilConstants.SecretCACertificate, name)
+			}
+
+			cfg.CA = caData
+			fingerprint = fmt.Sprintf("%s/%s", fingerprint, ca.GetResourceVersion())
+		}
+
+		return getEncryptionKMSProvider(id, fingerprint, func() (kms.Provider, error) {
+			return kms.NewVaultProvider(cfg)
+		})
+	case api.RocksDBEncryptionKMSProviderFile:
+		f := spec.GetFile()
+
+		keys, err := getEncryptionKMSSecret(ctx, secrets, f.SecretName)
+		if err != nil {
+			return nil, err
+		}
+
+		return getEncryptionKMSProvider(fmt.Sprintf("file/%s", keys.GetUID()), keys.GetResourceVersion(), func() (kms.Provider, error) {
+			return kms.NewFileProvider(keys.Data)
+		})
+	default:
+		return nil, errors.Errorf("Unsupported KMS provider: %s", spec.GetProvider())
+	}
+}
+
+func getEncryptionKMSSecret(ctx context.Context, secrets generic.ReadClient[*core.Secret], name string) (*core.Secret, error) {
+	ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
+	defer cancel()
+
+	secret, err := secrets.Get(ctxChild, name, meta.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to fetch KMS secret %s", name)
+	}
+
+	return secret, nil
+}
+
+func getEncryptionKMSProvider(id, fingerprint string, factory func() (kms.Provider, error)) (kms.Provider, error) {
+	encryptionKMSProvidersLock.Lock()
+	defer encryptionKMSProvidersLock.Unlock()
+
+	if p, ok := encryptionKMSProviders[id]; ok && p.fingerprint == fingerprint {
+		return p.provider, nil
+	}
+
+	p, err := factory()
+	if err != nil {
+		return nil, err
+	}
+
+	p = kms.WithVersionCache(p, encryptionKMSVersionTTL)
+
+	encryptionKMSProviders[id] = encryptionKMSProvider{
+		fingerprint: fingerprint,
+		provider:    p,
+	}
+
+	return p, nil
+}
+
+// GetEncryptionKMSKeyFromSecret returns sha256 of the unwrapped encryption key and the wrapped key from the secret
+func GetEncryptionKMSKeyFromSecret(ctx context.Context, provider kms.Provider, keyfile *core.Secret) (string, []byte, error) {
+	if len(keyfile.Data) == 0 {
+		return "", nil, errors.Errorf("Current encryption key is not valid - missing data section")
+	}
+
+	d, ok := keyfile.Data[utilConstants.SecretEncryptionWrappedKey]
+	if !ok {
+		return "", nil, errors.Errorf("Current encryption key is not valid - missing field")
+	}
+
+	sha, err := GetEncryptionKMSKeySHA(ctx, provider, d)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return sha, d, nil
+}
+
+// GetEncryptionKMSKeySHA returns sha256 of the unwrapped encryption key
+func GetEncryptionKMSKeySHA(ctx context.Context, provider kms.Provider, wrapped []byte) (string, error) {
+	w := strings.TrimSpace(string(wrapped))
+
+	if sha, ok := encryptionKMSKeyHashes.Load(w); ok {
+		return sha.(string), nil
+	}
+
+	ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
+	defer cancel()
+
+	key, err := provider.Unwrap(ctxChild, w)
+	if err != nil {
+		return "", errors.Wrapf(err, "Unable to unwrap encryption key")
+	}
+
+	if len(key) != 32 {
+		return "", errors.Errorf("Current encryption key is not valid")
+	}
+
+	sha := fmt.Sprintf("%0x", sha256.Sum256(key))
+
+	encryptionKMSKeyHashes.Store(w, sha)
+
+	return sha, nil
+}
+
+// GetEncryptionKeyfolderEntryFromSecret returns the name and the content of the keyfolder entry for the encryption key.
+// The name is sha256 of the encryption key, the content is the plain key or, when KMS is enabled, the wrapped key.
+func GetEncryptionKeyfolderEntryFromSecret(ctx context.Context, secrets generic.ReadClient[*core.Secret], spec api.RocksDBEncryptionSpec, keyfile *core.Secret) (string, []byte, error) {
+	if !spec.IsKMS() {
+		return GetEncryptionKeyFromSecret(keyfile)
+	}
+
+	provider, err := GetEncryptionKMSProvider(ctx, secrets, spec.KMS)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return GetEncryptionKMSKeyFromSecret(ctx, provider, keyfile)
+}
+
+// EncryptionKMSInitContainerArgs returns the KMS arguments of the init container which unwraps the encryption keys
+func EncryptionKMSInitContainerArgs(spec *api.RocksDBEncryptionKMSSpec) []string {
+	switch spec.GetProvider() {
+	case api.RocksDBEncryptionKMSProviderVault:
+		v := spec.GetVault()
+
+		args := []string{
+			"--provider", string(api.RocksDBEncryptionKMSProviderVault),
+			"--vault.address", v.Address,
+			"--vault.mount-path", v.GetMountPath(),
+			"--vault.key-name", v.KeyName,
+			"--vault.token-path", filepath.Join(shared.RocksDBEncryptionKMSVolumeMountDir, utilConstants.SecretKeyToken),
+		}
+
+		if n := v.GetNamespace(); n != "" {
+			args = append(args, "--vault.namespace", n)
+		}
+
+		if v.GetCASecretName() != "" {
+			args = append(args, "--vault.ca-path", filepath.Join(shared.RocksDBEncryptionKMSCAVolumeMountDir, utilConstants.SecretCACertificate))
+		}
+
+		return args
+	case api.RocksDBEncryptionKMSProviderFile:
+		return []string{
+			"--provider", string(api.RocksDBEncryptionKMSProviderFile),
+			"--file.path", shared.RocksDBEncryptionKMSVolumeMountDir,
+		}
+	default:
+		return nil
+	}
+}
+
+func encryptionKMSVolumes(i Input) []core.Volume {
+	spec := i.Deployment.RocksDB.Encryption.KMS
+
+	volumes := []core.Volume{
+		k8sutil.CreateVolumeMemoryEmptyDir(shared.RocksdbEncryptionVolumeName),
+		k8sutil.CreateVolumeWithSecret(shared.RocksdbEncryptionWrappedVolumeName, GetEncryptionFolderSecretName(i.ApiObject.GetName())),
+	}
+
+	switch spec.GetProvider() {
+	case api.RocksDBEncryptionKMSProviderVault:
+		volumes = append(volumes, k8sutil.CreateVolumeWithSecret(shared.RocksdbEncryptionKMSVolumeName, spec.GetVault().TokenSecretName))
+		if n := spec.GetVault().GetCASecretName(); n != "" {
+			volumes = append(volumes, k8sutil.CreateVolumeWithSecret(shared.RocksdbEncryptionKMSCAVolumeName, n))
+		}
+	case api.RocksDBEncryptionKMSProviderFile:
+		volumes = append(volumes, k8sutil.CreateVolumeWithSecret(shared.RocksdbEncryptionKMSVolumeName, spec.GetFile().SecretName))
+	}
+
+	return volumes
+}
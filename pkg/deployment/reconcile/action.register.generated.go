@@ -19,14 +19,16 @@
 package reconcile
 
 import (
-	"time"
-
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"time"
 )
 
 var (
 	// Ensure implementation
 
+	_ Action        = &actionAbortAgencyJob{}
+	_ actionFactory = newAbortAgencyJobAction
+
 	_ Action        = &actionAddMember{}
 	_ actionFactory = newAddMemberAction
 
@@ -69,6 +71,9 @@ var (
 	_ Action        = &actionCompactMember{}
 	_ actionFactory = newCompactMemberAction
 
+	_ Action        = &actionDebugInfoCollect{}
+	_ actionFactory = newDebugInfoCollectAction
+
 	_ Action        = &actionDelay{}
 	_ actionFactory = newDelayAction
 
@@ -96,6 +101,9 @@ var (
 	_ Action        = &actionEncryptionKeyRemove{}
 	_ actionFactory = newEncryptionKeyRemoveAction
 
+	_ Action        = &actionEncryptionKeyRewrap{}
+	_ actionFactory = newEncryptionKeyRewrapAction
+
 	_ Action        = &actionEncryptionKeyStatusUpdate{}
 	_ actionFactory = newEncryptionKeyStatusUpdateAction
 
@@ -111,6 +119,12 @@ var (
 	_ Action        = &actionJWTClean{}
 	_ actionFactory = newJWTCleanAction
 
+	_ Action        = &actionJWTGenerate{}
+	_ actionFactory = newJWTGenerateAction
+
+	_ Action        = &actionJWTPromote{}
+	_ actionFactory = newJWTPromoteAction
+
 	_ Action        = &actionJWTPropagated{}
 	_ actionFactory = newJWTPropagatedAction
 
@@ -165,6 +179,9 @@ var (
 	_ Action        = &actionRebalancerGenerateV2{}
 	_ actionFactory = newRebalancerGenerateV2Action
 
+	_ Action        = &actionRebalancerWaitV2{}
+	_ actionFactory = newRebalancerWaitV2Action
+
 	_ Action        = &actionRebuildOutSyncedShards{}
 	_ actionFactory = newRebuildOutSyncedShardsAction
 
@@ -195,6 +212,9 @@ var (
 	_ Action        = &actionResourceSync{}
 	_ actionFactory = newResourceSyncAction
 
+	_ Action        = &actionRetryAgencyJob{}
+	_ actionFactory = newRetryAgencyJobAction
+
 	_ Action        = &actionRotateMember{}
 	_ actionFactory = newRotateMemberAction
 
@@ -246,6 +266,12 @@ var (
 	_ Action        = &actionTLSPropagated{}
 	_ actionFactory = newTLSPropagatedAction
 
+	_ Action        = &actionTaskFinish{}
+	_ actionFactory = newTaskFinishAction
+
+	_ Action        = &actionTaskStart{}
+	_ actionFactory = newTaskStartAction
+
 	_ Action        = &actionTimezoneSecretSet{}
 	_ actionFactory = newTimezoneSecretSetAction
 
@@ -283,6 +309,20 @@ var (
 func init() {
 	// Register all actions
 
+	// AbortAgencyJob
+	{
+		// Get Action type
+		action := api.ActionTypeAbortAgencyJob
+
+		// Get Action defition
+		function := newAbortAgencyJobAction
+
+		// Wrap action main function
+
+		// Register action
+		registerAction(action, function)
+	}
+
 	// AddMember
 	{
 		// Get Action type
@@ -479,6 +519,20 @@ func init() {
 		registerAction(action, function)
 	}
 
+	// DebugInfoCollect
+	{
+		// Get Action type
+		action := api.ActionTypeDebugInfoCollect
+
+		// Get Action defition
+		function := newDebugInfoCollectAction
+
+		// Wrap action main function
+
+		// Register action
+		registerAction(action, function)
+	}
+
 	// Delay
 	{
 		// Get Action type
@@ -631,6 +685,20 @@ func init() {
 		registerAction(action, function)
 	}
 
+	// EncryptionKeyRewrap
+	{
+		// Get Action type
+		action := api.ActionTypeEncryptionKeyRewrap
+
+		// Get Action defition
+		function := newEncryptionKeyRewrapAction
+
+		// Wrap action main function
+
+		// Register action
+		registerAction(action, function)
+	}
+
 	// EncryptionKeyStatusUpdate
 	{
 		// Get Action type
@@ -701,6 +769,34 @@ func init() {
 		registerAction(action, function)
 	}
 
+	// JWTGenerate
+	{
+		// Get Action type
+		action := api.ActionTypeJWTGenerate
+
+		// Get Action defition
+		function := newJWTGenerateAction
+
+		// Wrap action main function
+
+		// Register action
+		registerAction(action, function)
+	}
+
+	// JWTPromote
+	{
+		// Get Action type
+		action := api.ActionTypeJWTPromote
+
+		// Get Action defition
+		function := newJWTPromoteAction
+
+		// Wrap action main function
+
+		// Register action
+		registerAction(action, function)
+	}
+
 	// JWTPropagated
 	{
 		// Get Action type
@@ -966,6 +1062,20 @@ func init() {
 		registerAction(action, function)
 	}
 
+	// RebalancerWaitV2
+	{
+		// Get Action type
+		action := api.ActionTypeRebalancerWaitV2
+
+		// Get Action defition
+		function := newRebalancerWaitV2Action
+
+		// Wrap action main function
+
+		// Register action
+		registerAction(action, function)
+	}
+
 	// RebuildOutSyncedShards
 	{
 		// Get Action type
@@ -1106,6 +1216,20 @@ func init() {
 		registerAction(action, function)
 	}
 
+	// RetryAgencyJob
+	{
+		// Get Action type
+		action := api.ActionTypeRetryAgencyJob
+
+		// Get Action defition
+		function := newRetryAgencyJobAction
+
+		// Wrap action main function
+
+		// Register action
+		registerAction(action, function)
+	}
+
 	// RotateMember
 	{
 		// Get Action type
@@ -1379,6 +1503,34 @@ func init() {
 		registerAction(action, function)
 	}
 
+	// TaskFinish
+	{
+		// Get Action type
+		action := api.ActionTypeTaskFinish
+
+		// Get Action defition
+		function := newTaskFinishAction
+
+		// Wrap action main function
+
+		// Register action
+		registerAction(action, function)
+	}
+
+	// TaskStart
+	{
+		// Get Action type
+		action := api.ActionTypeTaskStart
+
+		// Get Action defition
+		function := newTaskStartAction
+
+		// Wrap action main function
+
+		// Register action
+		registerAction(action, function)
+	}
+
 	// TimezoneSecretSet
 	{
 		// Get Action type
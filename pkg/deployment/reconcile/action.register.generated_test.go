@@ -30,6 +30,16 @@ import (
 func Test_Actions(t *testing.T) {
 	// Iterate over all actions
 
+	t.Run("AbortAgencyJob", func(t *testing.T) {
+		ActionsExistence(t, api.ActionTypeAbortAgencyJob)
+		t.Run("Internal", func(t *testing.T) {
+			require.False(t, api.ActionTypeAbortAgencyJob.Internal())
+		})
+		t.Run("Optional", func(t *testing.T) {
+			require.False(t, api.ActionTypeAbortAgencyJob.Optional())
+		})
+	})
+
 	t.Run("AddMember", func(t *testing.T) {
 		ActionsExistence(t, api.ActionTypeAddMember)
 		t.Run("Internal", func(t *testing.T) {
@@ -170,6 +180,16 @@ func Test_Actions(t *testing.T) {
 		})
 	})
 
+	t.Run("DebugInfoCollect", func(t *testing.T) {
+		ActionsExistence(t, api.ActionTypeDebugInfoCollect)
+		t.Run("Internal", func(t *testing.T) {
+			require.False(t, api.ActionTypeDebugInfoCollect.Internal())
+		})
+		t.Run("Optional", func(t *testing.T) {
+			require.False(t, api.ActionTypeDebugInfoCollect.Optional())
+		})
+	})
+
 	t.Run("Delay", func(t *testing.T) {
 		ActionsExistence(t, api.ActionTypeDelay)
 		t.Run("Internal", func(t *testing.T) {
@@ -286,6 +306,16 @@ func Test_Actions(t *testing.T) {
 		})
 	})
 
+	t.Run("EncryptionKeyRewrap", func(t *testing.T) {
+		ActionsExistence(t, api.ActionTypeEncryptionKeyRewrap)
+		t.Run("Internal", func(t *testing.T) {
+			require.False(t, api.ActionTypeEncryptionKeyRewrap.Internal())
+		})
+		t.Run("Optional", func(t *testing.T) {
+			require.False(t, api.ActionTypeEncryptionKeyRewrap.Optional())
+		})
+	})
+
 	t.Run("EncryptionKeyStatusUpdate", func(t *testing.T) {
 		ActionsExistence(t, api.ActionTypeEncryptionKeyStatusUpdate)
 		t.Run("Internal", func(t *testing.T) {
@@ -336,6 +366,26 @@ func Test_Actions(t *testing.T) {
 		})
 	})
 
+	t.Run("JWTGenerate", func(t *testing.T) {
+		ActionsExistence(t, api.ActionTypeJWTGenerate)
+		t.Run("Internal", func(t *testing.T) {
+			require.False(t, api.ActionTypeJWTGenerate.Internal())
+		})
+		t.Run("Optional", func(t *testing.T) {
+			require.False(t, api.ActionTypeJWTGenerate.Optional())
+		})
+	})
+
+	t.Run("JWTPromote", func(t *testing.T) {
+		ActionsExistence(t, api.ActionTypeJWTPromote)
+		t.Run("Internal", func(t *testing.T) {
+			require.False(t, api.ActionTypeJWTPromote.Internal())
+		})
+		t.Run("Optional", func(t *testing.T) {
+			require.False(t, api.ActionTypeJWTPromote.Optional())
+		})
+	})
+
 	t.Run("JWTPropagated", func(t *testing.T) {
 		ActionsExistence(t, api.ActionTypeJWTPropagated)
 		t.Run("Internal", func(t *testing.T) {
@@ -529,6 +579,16 @@ func Test_Actions(t *testing.T) {
 		})
 	})
 
+	t.Run("RebalancerWaitV2", func(t *testing.T) {
+		ActionsExistence(t, api.ActionTypeRebalancerWaitV2)
+		t.Run("Internal", func(t *testing.T) {
+			require.True(t, api.ActionTypeRebalancerWaitV2.Internal())
+		})
+		t.Run("Optional", func(t *testing.T) {
+			require.False(t, api.ActionTypeRebalancerWaitV2.Optional())
+		})
+	})
+
 	t.Run("RebuildOutSyncedShards", func(t *testing.T) {
 		ActionsExistence(t, api.ActionTypeRebuildOutSyncedShards)
 		t.Run("Internal", func(t *testing.T) {
@@ -629,6 +689,16 @@ func Test_Actions(t *testing.T) {
 		})
 	})
 
+	t.Run("RetryAgencyJob", func(t *testing.T) {
+		ActionsExistence(t, api.ActionTypeRetryAgencyJob)
+		t.Run("Internal", func(t *testing.T) {
+			require.False(t, api.ActionTypeRetryAgencyJob.Internal())
+		})
+		t.Run("Optional", func(t *testing.T) {
+			require.False(t, api.ActionTypeRetryAgencyJob.Optional())
+		})
+	})
+
 	t.Run("RotateMember", func(t *testing.T) {
 		ActionsExistence(t, api.ActionTypeRotateMember)
 		ActionsWrapWithActionStartFailureGracePeriod(t, api.ActionTypeRotateMember, 60*time.Second)
@@ -828,6 +898,26 @@ func Test_Actions(t *testing.T) {
 		})
 	})
 
+	t.Run("TaskFinish", func(t *testing.T) {
+		ActionsExistence(t, api.ActionTypeTaskFinish)
+		t.Run("Internal", func(t *testing.T) {
+			require.True(t, api.ActionTypeTaskFinish.Internal())
+		})
+		t.Run("Optional", func(t *testing.T) {
+			require.False(t, api.ActionTypeTaskFinish.Optional())
+		})
+	})
+
+	t.Run("TaskStart", func(t *testing.T) {
+		ActionsExistence(t, api.ActionTypeTaskStart)
+		t.Run("Internal", func(t *testing.T) {
+			require.True(t, api.ActionTypeTaskStart.Internal())
+		})
+		t.Run("Optional", func(t *testing.T) {
+			require.False(t, api.ActionTypeTaskStart.Optional())
+		})
+	})
+
 	t.Run("TimezoneSecretSet", func(t *testing.T) {
 		ActionsExistence(t, api.ActionTypeTimezoneSecretSet)
 		t.Run("Internal", func(t *testing.T) {
@@ -0,0 +1,112 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency/state"
+	"github.com/arangodb/kube-arangodb/pkg/util/arangod"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+)
+
+const (
+	actionAgencyJobID         = "jobID"
+	actionAgencyJobDatabase   = "database"
+	actionAgencyJobCollection = "collection"
+	actionAgencyJobShard      = "shard"
+	actionAgencyJobFromServer = "fromServer"
+	actionAgencyJobToServer   = "toServer"
+	actionAgencyJobServer     = "server"
+	actionAgencyJobType       = "type"
+)
+
+// newAbortAgencyJobAction creates a new Action that implements the given
+// planned AbortAgencyJob action.
+func newAbortAgencyJobAction(action api.Action, actionCtx ActionContext) Action {
+	a := &actionAbortAgencyJob{}
+
+	a.actionImpl = newActionImplDefRef(action, actionCtx)
+
+	return a
+}
+
+// actionAbortAgencyJob implements an AbortAgencyJobAction.
+type actionAbortAgencyJob struct {
+	// actionImpl implement timeout and member id functions
+	actionImpl
+
+	// actionEmptyCheckProgress implement check progress with empty implementation
+	actionEmptyCheckProgress
+}
+
+// Start performs the start of the action.
+// Returns true if the action is completely finished, false in case
+// the start time needs to be recorded and a ready condition needs to be checked.
+func (a *actionAbortAgencyJob) Start(ctx context.Context) (bool, error) {
+	id, ok := a.action.GetParam(actionAgencyJobID)
+	if !ok {
+		a.log.Error("*jobID* key not found in action params")
+		return true, nil
+	}
+
+	if !isAgencyJobRunning(a.actionCtx, state.JobID(id)) {
+		a.log.Str("job-id", id).Info("Agency job is not running anymore")
+		return true, nil
+	}
+
+	if err := cancelAgencyJob(ctx, a.actionCtx, id); err != nil {
+		a.log.Err(err).Str("job-id", id).Warn("Unable to abort agency job")
+		return true, nil
+	}
+
+	a.log.Str("job-id", id).Info("Agency job aborted")
+
+	return true, nil
+}
+
+// isAgencyJobRunning returns true if the job is in ToDo or Pending phase
+func isAgencyJobRunning(actionCtx ActionContext, id state.JobID) bool {
+	cache, ok := actionCtx.GetAgencyCache()
+	if !ok {
+		return false
+	}
+
+	switch _, phase := cache.Target.GetJob(id); phase {
+	case state.JobPhaseToDo, state.JobPhasePending:
+		return true
+	default:
+		return false
+	}
+}
+
+// cancelAgencyJob aborts the agency job using the cluster API
+func cancelAgencyJob(ctx context.Context, actionCtx ActionContext, id string) error {
+	c, err := actionCtx.GetMembersState().State().GetDatabaseClient()
+	if err != nil {
+		return err
+	}
+
+	return globals.GetGlobalTimeouts().ArangoD().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+		return arangod.CancelAgencyJob(ctxChild, c.Connection(), id)
+	})
+}
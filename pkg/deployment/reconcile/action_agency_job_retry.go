@@ -0,0 +1,141 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+
+	adbDriverV2 "github.com/arangodb/go-driver/v2/arangodb"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency/state"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+)
+
+// newRetryAgencyJobAction creates a new Action that implements the given
+// planned RetryAgencyJob action.
+func newRetryAgencyJobAction(action api.Action, actionCtx ActionContext) Action {
+	a := &actionRetryAgencyJob{}
+
+	a.actionImpl = newActionImplDefRef(action, actionCtx)
+
+	return a
+}
+
+// actionRetryAgencyJob implements an RetryAgencyJobAction.
+// Stuck job is aborted first, once the abort is finished the job is scheduled again with the same parameters.
+type actionRetryAgencyJob struct {
+	// actionImpl implement timeout and member id functions
+	actionImpl
+}
+
+// Start performs the start of the action.
+// Returns true if the action is completely finished, false in case
+// the start time needs to be recorded and a ready condition needs to be checked.
+func (a *actionRetryAgencyJob) Start(ctx context.Context) (bool, error) {
+	id, ok := a.action.GetParam(actionAgencyJobID)
+	if !ok {
+		a.log.Error("*jobID* key not found in action params")
+		return true, nil
+	}
+
+	if !isAgencyJobRunning(a.actionCtx, state.JobID(id)) {
+		a.log.Str("job-id", id).Info("Agency job is not running anymore")
+		return true, nil
+	}
+
+	if err := cancelAgencyJob(ctx, a.actionCtx, id); err != nil {
+		a.log.Err(err).Str("job-id", id).Warn("Unable to abort agency job")
+		return true, nil
+	}
+
+	a.log.Str("job-id", id).Info("Agency job aborted, waiting for the abort to finish")
+
+	return false, nil
+}
+
+// CheckProgress returns: ready, abort, error.
+func (a *actionRetryAgencyJob) CheckProgress(ctx context.Context) (bool, bool, error) {
+	id, ok := a.action.GetParam(actionAgencyJobID)
+	if !ok {
+		return true, false, nil
+	}
+
+	if _, ok := a.actionCtx.GetAgencyCache(); !ok {
+		a.log.Debug("AgencyCache is not ready")
+		return false, false, nil
+	}
+
+	if isAgencyJobRunning(a.actionCtx, state.JobID(id)) {
+		// Abort is still in progress
+		return false, false, nil
+	}
+
+	newID, err := a.schedule(ctx)
+	if err != nil {
+		a.log.Err(err).Str("job-id", id).Warn("Unable to schedule agency job again")
+		return false, true, nil
+	}
+
+	a.log.Str("job-id", id).Str("new-job-id", newID).Info("Agency job scheduled again")
+
+	return true, false, nil
+}
+
+func (a *actionRetryAgencyJob) schedule(ctx context.Context) (string, error) {
+	c, err := a.actionCtx.GetMembersState().State().GetDatabaseClient()
+	if err != nil {
+		return "", err
+	}
+
+	ctxChild, cancel := globals.GetGlobalTimeouts().ArangoD().WithTimeout(ctx)
+	defer cancel()
+
+	t, _ := a.action.GetParam(actionAgencyJobType)
+
+	switch t {
+	case state.JobTypeMoveShard:
+		database, _ := a.action.GetParam(actionAgencyJobDatabase)
+		collection, _ := a.action.GetParam(actionAgencyJobCollection)
+		shard, _ := a.action.GetParam(actionAgencyJobShard)
+		from, _ := a.action.GetParam(actionAgencyJobFromServer)
+		to, _ := a.action.GetParam(actionAgencyJobToServer)
+
+		db, err := c.GetDatabase(ctxChild, database, nil)
+		if err != nil {
+			return "", err
+		}
+
+		col, err := db.GetCollection(ctxChild, collection, nil)
+		if err != nil {
+			return "", err
+		}
+
+		return c.MoveShard(ctxChild, col, adbDriverV2.ShardID(shard), adbDriverV2.ServerID(from), adbDriverV2.ServerID(to))
+	case state.JobTypeCleanOutServer:
+		server, _ := a.action.GetParam(actionAgencyJobServer)
+
+		return c.CleanOutServer(ctxChild, adbDriverV2.ServerID(server))
+	default:
+		return "", errors.Errorf("Agency job type %s cannot be scheduled again", t)
+	}
+}
@@ -0,0 +1,144 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency/state"
+)
+
+// newDebugInfoCollectAction creates a new Action that implements the given
+// planned DebugInfoCollect action.
+func newDebugInfoCollectAction(action api.Action, actionCtx ActionContext) Action {
+	a := &actionDebugInfoCollect{}
+
+	a.actionImpl = newActionImplDefRef(action, actionCtx)
+
+	return a
+}
+
+// actionDebugInfoCollect collects the deployment debug information into the ArangoTask status
+type actionDebugInfoCollect struct {
+	// actionImpl implement timeout and member id functions
+	actionImpl
+
+	actionEmptyCheckProgress
+}
+
+type debugInfo struct {
+	Phase   api.DeploymentPhase `json:"phase,omitempty"`
+	Members []debugInfoMember   `json:"members,omitempty"`
+	Agency  *debugInfoAgency    `json:"agency,omitempty"`
+	Plans   map[string]int      `json:"plans,omitempty"`
+}
+
+type debugInfoMember struct {
+	ID         string          `json:"id"`
+	Group      string          `json:"group"`
+	Phase      api.MemberPhase `json:"phase,omitempty"`
+	Image      string          `json:"image,omitempty"`
+	Version    string          `json:"version,omitempty"`
+	Conditions []string        `json:"conditions,omitempty"`
+}
+
+type debugInfoAgency struct {
+	Leader      string `json:"leader,omitempty"`
+	Healthy     string `json:"healthy,omitempty"`
+	Maintenance bool   `json:"maintenance"`
+}
+
+// Start collects the debug information and saves it in the ArangoTask status details
+func (a *actionDebugInfoCollect) Start(ctx context.Context) (bool, error) {
+	if a.action.TaskID == "" {
+		a.log.Warn("DebugInfoCollect action is not assigned to any ArangoTask")
+		return true, nil
+	}
+
+	info := a.collect()
+
+	if err := withArangoTaskStatusUpdate(ctx, a.actionCtx.ACS().CurrentClusterCache(), a.action.TaskID, func(_ *api.ArangoTask, status *api.ArangoTaskStatus) bool {
+		if err := status.Details.Set(info); err != nil {
+			a.log.Err(err).Warn("Unable to serialize debug info")
+			return false
+		}
+
+		return true
+	}); err != nil {
+		a.log.Err(err).Error("Unable to save debug info")
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (a *actionDebugInfoCollect) collect() debugInfo {
+	status := a.actionCtx.GetStatus()
+
+	info := debugInfo{
+		Phase: status.Phase,
+		Plans: map[string]int{
+			"high":      len(status.HighPriorityPlan),
+			"normal":    len(status.Plan),
+			"resources": len(status.ResourcesPlan),
+		},
+	}
+
+	for _, e := range status.Members.AsList() {
+		m := debugInfoMember{
+			ID:    e.Member.ID,
+			Group: e.Group.AsRole(),
+			Phase: e.Member.Phase,
+		}
+
+		if i := e.Member.Image; i != nil {
+			m.Image = i.ImageID
+			m.Version = string(i.ArangoDBVersion)
+		}
+
+		for _, c := range e.Member.Conditions {
+			if c.IsTrue() {
+				m.Conditions = append(m.Conditions, string(c.Type))
+			}
+		}
+
+		info.Members = append(info.Members, m)
+	}
+
+	if health, ok := a.actionCtx.GetAgencyHealth(); ok {
+		agency := &debugInfoAgency{
+			Leader: health.LeaderID(),
+		}
+
+		if err := health.Healthy(); err != nil {
+			agency.Healthy = err.Error()
+		}
+
+		a.actionCtx.WithAgencyCache(func(s state.State) {
+			agency.Maintenance = s.Supervision.Maintenance.Exists()
+		})
+
+		info.Agency = agency
+	}
+
+	return info
+}
@@ -75,7 +75,7 @@ func (a *actionEncryptionKeyAdd) Start(ctx context.Context) (bool, error) {
 		secret = s
 	}
 
-	sha, d, exists, err := pod.GetEncryptionKey(ctx, a.actionCtx.ACS().CurrentClusterCache().Secret().V1().Read(), secret)
+	sha, d, exists, err := pod.GetEncryptionKey(ctx, a.actionCtx.ACS().CurrentClusterCache().Secret().V1().Read(), a.actionCtx.GetSpec().RocksDB.Encryption, secret)
 	if err != nil {
 		a.log.Err(err).Error("Unable to fetch current encryption key")
 		return true, nil
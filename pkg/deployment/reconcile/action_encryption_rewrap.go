@@ -0,0 +1,153 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"encoding/base64"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/patch"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/pod"
+	utilConstants "github.com/arangodb/kube-arangodb/pkg/util/constants"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/kms"
+)
+
+func newEncryptionKeyRewrapAction(action api.Action, actionCtx ActionContext) Action {
+	a := &actionEncryptionKeyRewrap{}
+
+	a.actionImpl = newActionImplDefRef(action, actionCtx)
+
+	return a
+}
+
+// actionEncryptionKeyRewrap rewraps the keys in the keyfolder and in the encryption key secret
+// with the current version of the KMS key-encryption key. Encryption keys itself are not changed.
+type actionEncryptionKeyRewrap struct {
+	actionImpl
+
+	actionEmptyCheckProgress
+}
+
+func (a *actionEncryptionKeyRewrap) Start(ctx context.Context) (bool, error) {
+	if err := ensureEncryptionSupport(a.actionCtx); err != nil {
+		a.log.Err(err).Error("Action not supported")
+		return true, nil
+	}
+
+	spec := a.actionCtx.GetSpec().RocksDB.Encryption
+	if !spec.IsKMS() {
+		return true, nil
+	}
+
+	cache := a.actionCtx.ACS().CurrentClusterCache()
+
+	provider, err := pod.GetEncryptionKMSProvider(ctx, cache.Secret().V1().Read(), spec.KMS)
+	if err != nil {
+		a.log.Err(err).Error("Unable to create KMS provider")
+		return true, nil
+	}
+
+	if folder, ok := cache.Secret().V1().GetSimple(pod.GetEncryptionFolderSecretName(a.actionCtx.GetAPIObject().GetName())); ok {
+		p := patch.NewPatch()
+		for name, wrapped := range folder.Data {
+			rewrapped, changed, err := a.rewrap(ctx, provider, name, wrapped)
+			if err != nil {
+				return false, err
+			}
+
+			if changed {
+				p = p.ItemReplace(patch.NewPath("data", name), base64.StdEncoding.EncodeToString(rewrapped))
+			}
+		}
+
+		if err := a.patch(ctx, folder.GetName(), p); err != nil {
+			return false, err
+		}
+	}
+
+	if keyfile, ok := cache.Secret().V1().GetSimple(spec.GetKeySecretName()); ok {
+		name, wrapped, err := pod.GetEncryptionKMSKeyFromSecret(ctx, provider, keyfile)
+		if err != nil {
+			a.log.Err(err).Error("Unable to fetch current encryption key")
+			return true, nil
+		}
+
+		rewrapped, changed, err := a.rewrap(ctx, provider, name, wrapped)
+		if err != nil {
+			return false, err
+		}
+
+		if changed {
+			if err := a.patch(ctx, keyfile.GetName(), patch.NewPatch().ItemReplace(patch.NewPath("data", utilConstants.SecretEncryptionWrappedKey), base64.StdEncoding.EncodeToString(rewrapped))); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	return true, nil
+}
+
+func (a *actionEncryptionKeyRewrap) rewrap(ctx context.Context, provider kms.Provider, name string, wrapped []byte) ([]byte, bool, error) {
+	if required, err := kms.RewrapRequired(ctx, provider, string(wrapped)); err != nil {
+		return nil, false, errors.Wrapf(err, "Unable to check version of the key %s", name)
+	} else if !required {
+		return nil, false, nil
+	}
+
+	ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
+	defer cancel()
+
+	rewrapped, err := provider.Rewrap(ctxChild, string(wrapped))
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "Unable to rewrap the key %s", name)
+	}
+
+	// Ensure that the encryption key did not change
+	if sha, err := pod.GetEncryptionKMSKeySHA(ctx, provider, []byte(rewrapped)); err != nil {
+		return nil, false, err
+	} else if sha != name {
+		return nil, false, errors.Errorf("Rewrapped key %s does not match the encryption key", name)
+	}
+
+	return []byte(rewrapped), true, nil
+}
+
+func (a *actionEncryptionKeyRewrap) patch(ctx context.Context, name string, p patch.Patch) error {
+	if len(p) == 0 {
+		return nil
+	}
+
+	data, err := p.Marshal()
+	if err != nil {
+		return errors.Wrapf(err, "Unable to encrypt patch")
+	}
+
+	return globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+		_, err := a.actionCtx.ACS().CurrentClusterCache().SecretsModInterface().V1().Patch(ctxChild, name, types.JSONPatchType, data, meta.PatchOptions{})
+		return err
+	})
+}
@@ -0,0 +1,127 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"encoding/base64"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/patch"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/pod"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	utilConstants "github.com/arangodb/kube-arangodb/pkg/util/constants"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/kerrors"
+	utilToken "github.com/arangodb/kube-arangodb/pkg/util/token"
+)
+
+func newJWTGenerateAction(action api.Action, actionCtx ActionContext) Action {
+	a := &actionJWTGenerate{}
+
+	a.actionImpl = newActionImplDefRef(action, actionCtx)
+
+	return a
+}
+
+type actionJWTGenerate struct {
+	actionImpl
+
+	actionEmptyCheckProgress
+}
+
+func (a *actionJWTGenerate) Start(ctx context.Context) (bool, error) {
+	folder, err := ensureJWTFolderSupportFromAction(a.actionCtx)
+	if err != nil {
+		a.log.Err(err).Error("Action not supported")
+		return true, nil
+	}
+
+	if !folder {
+		a.log.Error("Action not supported")
+		return true, nil
+	}
+
+	f, ok := a.actionCtx.ACS().CurrentClusterCache().Secret().V1().GetSimple(pod.JWTSecretFolder(a.actionCtx.GetName()))
+	if !ok {
+		a.log.Error("Unable to get JWT folder info")
+		return true, nil
+	}
+
+	activeKeyData, ok := f.Data[utilConstants.ActiveJWTKey]
+	if !ok {
+		a.log.Error("Active Key is required")
+		return true, nil
+	}
+
+	activeSha := util.TrimSpaceSHA256(activeKeyData)
+	lifecycle := a.actionCtx.GetStatus().Hashes.JWT.Keys
+
+	for key := range f.Data {
+		if key == utilConstants.ActiveJWTKey || key == utilConstants.SecretKeyToken || key == activeSha {
+			continue
+		}
+
+		if k, ok := lifecycle.Get(jwtKeyHash(key)); !ok || (!k.IsActivated() && !k.IsRetired()) {
+			a.log.Info("Pending JWT key already exists")
+			return true, nil
+		}
+	}
+
+	jwt, err := generateJWTKeyLike(activeKeyData)
+	if err != nil {
+		return false, errors.Wrapf(err, "Unable to generate JWT key")
+	}
+
+	p := patch.NewPatch()
+	p = p.ItemAdd(patch.NewPath("data", util.TrimSpaceSHA256(jwt)), base64.StdEncoding.EncodeToString(jwt))
+
+	patch, err := p.Marshal()
+	if err != nil {
+		a.log.Err(err).Error("Unable to encrypt patch")
+		return true, nil
+	}
+
+	err = globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+		_, err := a.actionCtx.ACS().CurrentClusterCache().SecretsModInterface().V1().Patch(ctxChild, pod.JWTSecretFolder(a.actionCtx.GetName()), types.JSONPatchType, patch, meta.PatchOptions{})
+		return err
+	})
+	if err != nil {
+		if !kerrors.IsInvalid(err) {
+			return false, errors.Wrapf(err, "Unable to update secret: %s", pod.JWTSecretFolder(a.actionCtx.GetName()))
+		}
+	}
+
+	return true, nil
+}
+
+// generateJWTKeyLike generates new JWT key of the same type as the given key
+func generateJWTKeyLike(key []byte) ([]byte, error) {
+	if s, err := utilToken.NewECDSAFromData(key); err == nil && s.Exists() {
+		return utilToken.GenerateECDSASecret()
+	}
+
+	return utilToken.GenerateJWTSecret(), nil
+}
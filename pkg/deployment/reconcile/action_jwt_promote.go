@@ -0,0 +1,134 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"encoding/base64"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/patch"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/pod"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	utilConstants "github.com/arangodb/kube-arangodb/pkg/util/constants"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/kerrors"
+)
+
+func newJWTPromoteAction(action api.Action, actionCtx ActionContext) Action {
+	a := &actionJWTPromote{}
+
+	a.actionImpl = newActionImplDefRef(action, actionCtx)
+
+	return a
+}
+
+// actionJWTPromote stores the propagated JWT key in the JWT secret.
+// Activation of the key is done by the JWTSetActive action, once key from the secret is propagated.
+type actionJWTPromote struct {
+	actionImpl
+
+	actionEmptyCheckProgress
+}
+
+func (a *actionJWTPromote) Start(ctx context.Context) (bool, error) {
+	folder, err := ensureJWTFolderSupportFromAction(a.actionCtx)
+	if err != nil {
+		a.log.Err(err).Error("Action not supported")
+		return true, nil
+	}
+
+	if !folder {
+		a.log.Error("Action not supported")
+		return true, nil
+	}
+
+	promoteChecksum, exists := a.action.Params[checksum]
+	if !exists {
+		a.log.Warn("Key %s is missing in action", checksum)
+		return true, nil
+	}
+
+	f, ok := a.actionCtx.ACS().CurrentClusterCache().Secret().V1().GetSimple(pod.JWTSecretFolder(a.actionCtx.GetName()))
+	if !ok {
+		a.log.Error("Unable to get JWT folder info")
+		return true, nil
+	}
+
+	promoteData, ok := f.Data[promoteChecksum]
+	if !ok {
+		a.log.Error("JWT key which is desired to be promoted is not anymore in secret")
+		return true, nil
+	}
+
+	activeKeyData, ok := f.Data[utilConstants.ActiveJWTKey]
+	if !ok {
+		a.log.Error("Active Key is required")
+		return true, nil
+	}
+
+	secretName := a.actionCtx.GetSpec().Authentication.GetJWTSecretName()
+
+	s, ok := a.actionCtx.ACS().CurrentClusterCache().Secret().V1().GetSimple(secretName)
+	if !ok {
+		a.log.Error("JWT Secret is missing, no rotation will take place")
+		return true, nil
+	}
+
+	jwt, ok := s.Data[utilConstants.SecretKeyToken]
+	if !ok {
+		a.log.Error("JWT Secret is invalid, no rotation will take place")
+		return true, nil
+	}
+
+	if jwtSha := util.TrimSpaceSHA256(jwt); jwtSha == promoteChecksum {
+		a.log.Info("JWT key is already promoted")
+		return true, nil
+	} else if jwtSha != util.TrimSpaceSHA256(activeKeyData) {
+		a.log.Error("JWT Secret changed")
+		return true, nil
+	}
+
+	p := patch.NewPatch()
+	p = p.ItemReplace(patch.NewPath("data", utilConstants.SecretKeyToken), base64.StdEncoding.EncodeToString(promoteData))
+
+	patch, err := p.Marshal()
+	if err != nil {
+		a.log.Err(err).Error("Unable to encrypt patch")
+		return true, nil
+	}
+
+	err = globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+		_, err := a.actionCtx.ACS().CurrentClusterCache().SecretsModInterface().V1().Patch(ctxChild, secretName, types.JSONPatchType, patch, meta.PatchOptions{})
+		return err
+	})
+	if err != nil {
+		if !kerrors.IsInvalid(err) {
+			return false, errors.Wrapf(err, "Unable to update secret: %s", secretName)
+		}
+	}
+
+	return true, nil
+}
@@ -24,6 +24,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"time"
 
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 	"github.com/arangodb/kube-arangodb/pkg/deployment/features"
@@ -133,6 +134,11 @@ func (a *actionJWTStatusUpdate) Start(ctx context.Context) (bool, error) {
 			}
 		}
 
+		if keys, changed := updateJWTKeysLifecycle(f.Data, s.Hashes.JWT.Keys, time.Now()); changed {
+			s.Hashes.JWT.Keys = keys
+			update = true
+		}
+
 		if len(f.Data) == 0 {
 			if s.Hashes.JWT.Passive != nil {
 				s.Hashes.JWT.Passive = nil
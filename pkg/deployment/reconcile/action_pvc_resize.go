@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2024 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -145,6 +145,10 @@ func (a *actionPVCResize) CheckProgress(ctx context.Context) (bool, bool, error)
 		return true, false, nil
 	}
 
+	if reason, ok := k8sutil.GetPersistentVolumeClaimResizeError(pvc); ok {
+		a.log.Str("pvc", pvc.GetName()).Str("reason", reason).Warn("PVC resize is rejected by the storage provisioner")
+	}
+
 	if requestedSize, ok := pvc.Spec.Resources.Requests[core.ResourceStorage]; ok {
 		if volumeSize, ok := pvc.Status.Capacity[core.ResourceStorage]; ok {
 			cmp := volumeSize.Cmp(requestedSize)
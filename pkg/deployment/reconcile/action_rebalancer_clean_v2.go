@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2023 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -42,7 +42,7 @@ type actionRebalancerCleanV2 struct {
 
 func (r actionRebalancerCleanV2) Start(ctx context.Context) (bool, error) {
 	return true, r.actionCtx.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
-		if s.Rebalancer != nil {
+		if s.Rebalancer != nil && !s.Rebalancer.IsMoveInProgress() {
 			s.Rebalancer = nil
 			return true
 		}
@@ -22,13 +22,17 @@ package reconcile
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	adbDriverV2 "github.com/arangodb/go-driver/v2/arangodb"
 
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency/state"
 	"github.com/arangodb/kube-arangodb/pkg/deployment/client"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/topology"
 	"github.com/arangodb/kube-arangodb/pkg/util"
 	"github.com/arangodb/kube-arangodb/pkg/util/globals"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
@@ -51,9 +55,10 @@ type actionRebalancerGenerateV2 struct {
 func (r actionRebalancerGenerateV2) Start(ctx context.Context) (bool, error) {
 	spec := r.actionCtx.GetSpec()
 
-	if spec.Rebalancer == nil {
+	// Rebalance requested by the ArangoTask is executed even if Rebalancer is not configured
+	if spec.Rebalancer == nil && r.action.TaskID == "" {
 		if err := r.actionCtx.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
-			if s.Rebalancer == nil {
+			if s.Rebalancer == nil || s.Rebalancer.IsMoveInProgress() {
 				return false
 			}
 
@@ -73,25 +78,33 @@ func (r actionRebalancerGenerateV2) Start(ctx context.Context) (bool, error) {
 		return true, nil
 	}
 
-	nctx, cancel := globals.GetGlobalTimeouts().ArangoD().WithTimeout(ctx)
-	defer cancel()
-
-	resp, err := client.NewClient(c.Connection()).RebalancePlan(nctx, &client.RebalancePlanRequest{
-		MaximumNumberOfMoves: util.NewType(spec.Rebalancer.GetParallelMoves()),
-	})
-	if err != nil {
-		r.log.Err(err).Error("Unable to generate rebalancer moves")
+	cache, ok := r.actionCtx.GetAgencyCache()
+	if !ok {
+		r.log.Debug("AgencyCache is not ready")
 		return true, nil
 	}
 
-	if len(resp.Result.Moves) > 0 {
-		cache, ok := r.actionCtx.GetAgencyCache()
-		if !ok {
-			r.log.Debug("AgencyCache is not ready")
+	var actions RebalanceActions
+	var imbalanceBefore, imbalanceAfter *api.ArangoDeploymentRebalancerImbalance
+
+	if spec.Rebalancer != nil && spec.Rebalancer.Optimizers.IsZonesEnabled() {
+		// Spread replicas across the topology zones first
+		actions = r.zoneActions(spec)
+	}
+
+	if len(actions) == 0 {
+		nctx, cancel := globals.GetGlobalTimeouts().ArangoD().WithTimeout(ctx)
+		defer cancel()
+
+		resp, err := client.NewClient(c.Connection()).RebalancePlan(nctx, &client.RebalancePlanRequest{
+			MaximumNumberOfMoves: util.NewType(spec.Rebalancer.GetParallelMoves()),
+		})
+		if err != nil {
+			r.log.Err(err).Error("Unable to generate rebalancer moves")
 			return true, nil
 		}
 
-		actions := make(RebalanceActions, len(resp.Result.Moves))
+		actions = make(RebalanceActions, len(resp.Result.Moves))
 
 		for id, move := range resp.Result.Moves {
 			db, ok := cache.GetCollectionDatabaseByID(move.Collection.String())
@@ -106,20 +119,64 @@ func (r actionRebalancerGenerateV2) Start(ctx context.Context) (bool, error) {
 				Shard:      move.Shard,
 				From:       move.From,
 				To:         move.To,
+				Leader:     move.IsLeader,
 			}
 		}
 
-		if err := r.executeActions(ctx, spec.Rebalancer.GetParallelMoves(), c, actions); err != nil {
-			r.log.Err(err).Warn("Unable to execute actions")
-			return true, nil
+		imbalanceBefore = asRebalancerImbalance(resp.Result.ImbalanceBefore)
+		imbalanceAfter = asRebalancerImbalance(resp.Result.ImbalanceAfter)
+	}
+
+	if size := spec.Rebalancer.GetParallelMoves(); len(actions) > size {
+		actions = actions[0:size]
+	}
+
+	r.estimateSizes(ctx, client.NewClient(c.Connection()), cache, actions)
+
+	now := meta.Now()
+	status := r.actionCtx.GetStatus()
+	movedData := status.Rebalancer.GetMovedDataSince(now.Add(-time.Hour))
+
+	if spec.Rebalancer.IsDryRun() {
+		r.log.Int("moves", len(actions)).Info("Rebalancer plan computed in the dry-run mode")
+
+		if err := r.actionCtx.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
+			s.Rebalancer = &api.ArangoDeploymentRebalancerStatus{
+				LastCheckTime: k8sutil.NewTime(now),
+				MovedData:     movedData,
+				DryRun: &api.ArangoDeploymentRebalancerDryRunStatus{
+					Time:            now,
+					Moves:           actions.AsMoves(),
+					Bytes:           actions.GetBytes(),
+					ImbalanceBefore: imbalanceBefore,
+					ImbalanceAfter:  imbalanceAfter,
+				},
+			}
+
+			return true
+		}); err != nil {
+			r.log.Err(err).Warn("Unable to save plan")
 		}
 
 		return true, nil
 	}
 
+	if len(actions) > 0 {
+		if budgeted := applyRebalancerBudget(spec.Rebalancer.GetBudget(), status.Rebalancer.GetMovedData(now.Add(-time.Hour)), actions); len(budgeted) > 0 {
+			if err := r.executeActions(ctx, spec.Rebalancer.GetParallelMoves(), c, budgeted, movedData); err != nil {
+				r.log.Err(err).Warn("Unable to execute actions")
+			}
+
+			return true, nil
+		}
+
+		r.log.Int("moves", len(actions)).Info("Rebalancer budget exhausted")
+	}
+
 	if err := r.actionCtx.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
 		s.Rebalancer = &api.ArangoDeploymentRebalancerStatus{
-			LastCheckTime: k8sutil.NewTime(meta.Now()),
+			LastCheckTime: k8sutil.NewTime(now),
+			MovedData:     movedData,
 		}
 
 		return true
@@ -131,7 +188,90 @@ func (r actionRebalancerGenerateV2) Start(ctx context.Context) (bool, error) {
 	return true, nil
 }
 
-func (r actionRebalancerGenerateV2) executeActions(ctx context.Context, size int, client adbDriverV2.Client, a RebalanceActions) error {
+// estimateSizes sets the estimated size of the moved shards, calculated from the collection figures
+func (r actionRebalancerGenerateV2) estimateSizes(ctx context.Context, c client.Client, cache state.State, actions RebalanceActions) {
+	sizes := map[string]int64{}
+
+	for id := range actions {
+		key := fmt.Sprintf("%s/%s", actions[id].Database, actions[id].Collection)
+
+		size, ok := sizes[key]
+		if !ok {
+			var figures client.CollectionFiguresResponse
+
+			if err := globals.GetGlobalTimeouts().ArangoD().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+				f, err := c.CollectionFigures(ctxChild, actions[id].Database, actions[id].Collection)
+				if err != nil {
+					return err
+				}
+
+				figures = f
+				return nil
+			}); err != nil {
+				r.log.Err(err).Str("database", actions[id].Database).Str("collection", actions[id].Collection).Warn("Unable to get collection figures")
+			} else if shards := len(cache.Plan.Collections[actions[id].Database][actions[id].Collection].Shards); shards > 0 {
+				size = figures.Figures.GetSize() / int64(shards)
+			}
+
+			sizes[key] = size
+		}
+
+		actions[id].Bytes = size
+	}
+}
+
+func asRebalancerImbalance(in *client.RebalancePlanImbalance) *api.ArangoDeploymentRebalancerImbalance {
+	if in == nil {
+		return nil
+	}
+
+	var r api.ArangoDeploymentRebalancerImbalance
+
+	if in.Leader != nil {
+		r.Leader = in.Leader.Imbalance
+	}
+
+	if in.Shards != nil {
+		r.Shards = in.Shards.Imbalance
+	}
+
+	return &r
+}
+
+// zoneActions returns moves which spread replicas of the shards across the topology zones
+func (r actionRebalancerGenerateV2) zoneActions(spec api.DeploymentSpec) RebalanceActions {
+	status := r.actionCtx.GetStatus()
+	if !status.Topology.Enabled() {
+		return nil
+	}
+
+	cache, ok := r.actionCtx.GetAgencyCache()
+	if !ok {
+		return nil
+	}
+
+	moves := cache.GetShardZoneMoves(topology.GetDBServerZones(status), status.Topology.Size, spec.Rebalancer.GetParallelMoves())
+	if len(moves) == 0 {
+		return nil
+	}
+
+	actions := make(RebalanceActions, len(moves))
+	for id, move := range moves {
+		actions[id] = RebalanceAction{
+			Database:   move.Database,
+			Collection: move.Collection,
+			Shard:      move.Shard,
+			From:       string(move.From),
+			To:         string(move.To),
+		}
+	}
+
+	r.log.Int("moves", len(actions)).Info("Spreading replicas of the shards across the topology zones")
+
+	return actions
+}
+
+func (r actionRebalancerGenerateV2) executeActions(ctx context.Context, size int, client adbDriverV2.Client, a RebalanceActions, movedData []api.ArangoDeploymentRebalancerMovedData) error {
 	if len(a) > size {
 		a = a[0:size]
 	}
@@ -147,7 +287,12 @@ func (r actionRebalancerGenerateV2) executeActions(ctx context.Context, size int
 	}
 
 	if err := r.actionCtx.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
-		s.Rebalancer = &api.ArangoDeploymentRebalancerStatus{}
+		s.Rebalancer = &api.ArangoDeploymentRebalancerStatus{
+			MovedData: append(movedData, api.ArangoDeploymentRebalancerMovedData{
+				Time:  meta.Now(),
+				Bytes: a.GetBytes(),
+			}),
+		}
 
 		s.Rebalancer.MoveJobs = append(s.Rebalancer.MoveJobs, ids...)
 
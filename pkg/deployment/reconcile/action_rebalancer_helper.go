@@ -24,6 +24,8 @@ import (
 	"context"
 
 	adbDriverV2 "github.com/arangodb/go-driver/v2/arangodb"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 )
 
 type RebalanceActions []RebalanceAction
@@ -37,6 +39,82 @@ type RebalanceAction struct {
 	To    string `json:"to"`
 
 	DependsOn []int `json:"depends_on,omitempty"`
+
+	Leader bool  `json:"leader,omitempty"`
+	Bytes  int64 `json:"bytes,omitempty"`
+}
+
+// GetBytes returns the estimated amount of the data moved by the actions
+func (a RebalanceActions) GetBytes() int64 {
+	var r int64
+
+	for _, z := range a {
+		r += z.Bytes
+	}
+
+	return r
+}
+
+// AsMoves returns the actions in the status format
+func (a RebalanceActions) AsMoves() []api.ArangoDeploymentRebalancerMove {
+	if len(a) == 0 {
+		return nil
+	}
+
+	r := make([]api.ArangoDeploymentRebalancerMove, len(a))
+
+	for id, z := range a {
+		r[id] = api.ArangoDeploymentRebalancerMove{
+			Database:   z.Database,
+			Collection: z.Collection,
+			Shard:      z.Shard,
+			From:       z.From,
+			To:         z.To,
+			Leader:     z.Leader,
+			Bytes:      z.Bytes,
+		}
+	}
+
+	return r
+}
+
+// applyRebalancerBudget returns the actions which fit into the budget, taking into account the data moved within the last hour.
+// Action bigger than the budget is returned alone if no data was moved within the last hour.
+func applyRebalancerBudget(budget *api.ArangoDeploymentRebalancerBudgetSpec, moved int64, a RebalanceActions) RebalanceActions {
+	limit, ok := budget.GetPerRun()
+
+	if perHour, hok := budget.GetPerHour(); hok {
+		left := perHour - moved
+		if left <= 0 {
+			return nil
+		}
+
+		if !ok || left < limit {
+			limit, ok = left, true
+		}
+	}
+
+	if !ok {
+		return a
+	}
+
+	var r RebalanceActions
+	var total int64
+
+	for _, z := range a {
+		if total+z.Bytes > limit {
+			continue
+		}
+
+		r = append(r, z)
+		total += z.Bytes
+	}
+
+	if len(r) == 0 && moved == 0 && len(a) > 0 {
+		return a[0:1]
+	}
+
+	return r
 }
 
 func runMoveJobs(ctx context.Context, client adbDriverV2.Client, a RebalanceActions) ([]string, []error) {
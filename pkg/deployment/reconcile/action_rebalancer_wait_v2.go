@@ -0,0 +1,166 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency/state"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/definitions"
+)
+
+const (
+	// rebalancerWaitV2UnknownJobTimeout defines how long the job which is not yet visible in the agency cache is considered as pending
+	rebalancerWaitV2UnknownJobTimeout = time.Minute
+
+	actionRebalancerWaitV2LocalTotal  api.PlanLocalKey = "totalMoveJobs"
+	actionRebalancerWaitV2LocalFailed api.PlanLocalKey = "failedMoveJobs"
+)
+
+func newRebalancerWaitV2Action(action api.Action, actionCtx ActionContext) Action {
+	a := &actionRebalancerWaitV2{}
+
+	a.actionImpl = newActionImplDefRef(action, actionCtx)
+
+	return a
+}
+
+// actionRebalancerWaitV2 waits until the Rebalancer move jobs are finished.
+// ArangoTask is marked as failed if any of the move jobs failed.
+type actionRebalancerWaitV2 struct {
+	actionImpl
+}
+
+func (r *actionRebalancerWaitV2) ReloadComponents() (types.UID, []definitions.Component) {
+	return r.actionCtx.ACS().UID(), []definitions.Component{
+		definitions.ArangoTask,
+	}
+}
+
+func (r *actionRebalancerWaitV2) Start(ctx context.Context) (bool, error) {
+	var total int
+	if rebalancerStatus := r.actionCtx.GetStatus().Rebalancer; rebalancerStatus != nil {
+		total = len(rebalancerStatus.MoveJobs)
+	}
+
+	r.actionCtx.Add(actionRebalancerWaitV2LocalTotal, strconv.Itoa(total), true)
+	r.actionCtx.Add(actionRebalancerWaitV2LocalFailed, "0", true)
+
+	return false, nil
+}
+
+func (r *actionRebalancerWaitV2) CheckProgress(ctx context.Context) (bool, bool, error) {
+	rebalancerStatus := r.actionCtx.GetStatus().Rebalancer
+
+	if !rebalancerStatus.IsMoveInProgress() {
+		return true, false, nil
+	}
+
+	cache, ok := r.actionCtx.GetAgencyCache()
+	if !ok {
+		r.log.Debug("AgencyCache is not ready")
+		return false, false, nil
+	}
+
+	var pending []string
+	var succeeded, failed int
+
+	if v, ok := r.actionCtx.Get(r.action, actionRebalancerWaitV2LocalFailed); ok {
+		failed, _ = strconv.Atoi(v)
+	}
+
+	for _, id := range rebalancerStatus.MoveJobs {
+		switch _, phase := cache.Target.GetJob(state.JobID(id)); phase {
+		case state.JobPhaseFinished:
+			succeeded++
+		case state.JobPhaseFailed:
+			failed++
+		case state.JobPhaseUnknown:
+			if r.action.StartTime != nil && r.action.StartTime.Add(rebalancerWaitV2UnknownJobTimeout).Before(time.Now()) {
+				failed++
+				continue
+			}
+
+			pending = append(pending, id)
+		default:
+			pending = append(pending, id)
+		}
+	}
+
+	if len(pending) != len(rebalancerStatus.MoveJobs) {
+		r.actionCtx.Metrics().GetRebalancer().AddSuccesses(succeeded)
+		r.actionCtx.Metrics().GetRebalancer().AddFailures(len(rebalancerStatus.MoveJobs) - len(pending) - succeeded)
+		r.actionCtx.Add(actionRebalancerWaitV2LocalFailed, strconv.Itoa(failed), true)
+
+		if err := r.actionCtx.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
+			if s.Rebalancer == nil {
+				return false
+			}
+
+			s.Rebalancer.MoveJobs = pending
+
+			if len(s.Rebalancer.MoveJobs) == 0 {
+				s.Rebalancer.LastCheckTime = nil
+			}
+
+			return true
+		}); err != nil {
+			return false, false, errors.Wrapf(err, "Unable to update Rebalancer status")
+		}
+	}
+
+	r.actionCtx.SetProgress(fmt.Sprintf("%d move jobs pending", len(pending)))
+
+	if len(pending) > 0 {
+		return false, false, nil
+	}
+
+	if failed > 0 && r.action.TaskID != "" {
+		total, _ := r.actionCtx.Get(r.action, actionRebalancerWaitV2LocalTotal)
+
+		r.log.Int("failed", failed).Warn("Rebalancer move jobs failed")
+
+		if err := withArangoTaskStatusUpdate(ctx, r.actionCtx.ACS().CurrentClusterCache(), r.action.TaskID, func(_ *api.ArangoTask, status *api.ArangoTaskStatus) bool {
+			if status.State.IsFinished() {
+				return false
+			}
+
+			status.State = api.ArangoTaskFailedState
+			status.FinishTime = k8sutil.NewTime(meta.Now())
+			status.Message = fmt.Sprintf("%d of %s Rebalancer move jobs failed", failed, total)
+
+			return true
+		}); err != nil {
+			return false, false, errors.Wrapf(err, "Unable to mark ArangoTask as failed")
+		}
+	}
+
+	return true, false, nil
+}
@@ -30,6 +30,7 @@ import (
 	adbDriverV2Shared "github.com/arangodb/go-driver/v2/arangodb/shared"
 
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency/state"
 	"github.com/arangodb/kube-arangodb/pkg/util/arangod"
 	"github.com/arangodb/kube-arangodb/pkg/util/errors"
 	"github.com/arangodb/kube-arangodb/pkg/util/globals"
@@ -83,6 +84,12 @@ func (a *actionRemoveMember) Start(ctx context.Context) (bool, error) {
 				a.log.Err(err).Str("member-id", m.ID).Error("Failed to remove server from cluster")
 				// ignore this error, maybe all coordinators are failed and no connection to cluster is possible
 			} else if adbDriverV2Shared.IsPreconditionFailed(err) {
+				if a.action.Group == api.ServerGroupDBServers && !a.isCleanedOut(m.ID) {
+					// DBServer is still in use by the cluster, its data can not be removed
+					a.log.Err(err).Str("member-id", m.ID).Warn("DBServer can not be removed from cluster. Aborting plan")
+					return false, errors.Wrapf(err, "can not remove DBServer %s from cluster", m.ID)
+				}
+
 				health, _ := a.actionCtx.GetMembersState().Health()
 				if health.Error != nil {
 					a.log.Err(err).Str("member-id", m.ID).Error("Failed get cluster health")
@@ -136,3 +143,13 @@ func (a *actionRemoveMember) Start(ctx context.Context) (bool, error) {
 	}
 	return true, nil
 }
+
+// isCleanedOut returns true if the agency reports the DBServer as cleaned out
+func (a *actionRemoveMember) isCleanedOut(id string) bool {
+	cache, ok := a.actionCtx.GetAgencyCache()
+	if !ok {
+		return false
+	}
+
+	return cache.Target.CleanedServers.Contains(state.Server(id))
+}
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2023-2024 ArangoDB GmbH, Cologne, Germany
+// Copyright 2023-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -30,6 +30,7 @@ import (
 	"github.com/arangodb/kube-arangodb/pkg/deployment/agency/state"
 	"github.com/arangodb/kube-arangodb/pkg/util/errors"
 	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 )
 
 // newRemoveMemberPVCAction creates a new Action that implements the given
@@ -101,6 +102,8 @@ func (a *actionRemoveMemberPVC) Start(ctx context.Context) (bool, error) {
 
 				return true, err
 			}
+
+			a.actionCtx.CreateEvent(k8sutil.NewMemberVolumeReleasedEvent(a.actionCtx.GetAPIObject(), m.ID, a.action.Group.AsRole(), n))
 		}
 	}
 
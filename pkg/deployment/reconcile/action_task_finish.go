@@ -0,0 +1,96 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/definitions"
+)
+
+const (
+	actionTaskFinishStateKey   string = "state"
+	actionTaskFinishMessageKey string = "message"
+)
+
+// newTaskFinishAction creates a new Action that implements the given
+// planned TaskFinish action.
+func newTaskFinishAction(action api.Action, actionCtx ActionContext) Action {
+	a := &actionTaskFinish{}
+
+	a.actionImpl = newActionImplDefRef(action, actionCtx)
+
+	return a
+}
+
+// actionTaskFinish marks the ArangoTask as finished
+type actionTaskFinish struct {
+	// actionImpl implement timeout and member id functions
+	actionImpl
+
+	actionEmptyCheckProgress
+}
+
+func (a *actionTaskFinish) ReloadComponents() (types.UID, []definitions.Component) {
+	return a.actionCtx.ACS().UID(), []definitions.Component{
+		definitions.ArangoTask,
+	}
+}
+
+// Start saves the final state, finish time and message in the ArangoTask status
+func (a *actionTaskFinish) Start(ctx context.Context) (bool, error) {
+	state := api.ArangoTaskSuccessState
+	if v, ok := a.action.GetParam(actionTaskFinishStateKey); ok {
+		state = api.ArangoTaskState(v)
+	}
+
+	message, _ := a.action.GetParam(actionTaskFinishMessageKey)
+
+	if err := withArangoTaskStatusUpdate(ctx, a.actionCtx.ACS().CurrentClusterCache(), a.action.TaskID, func(task *api.ArangoTask, status *api.ArangoTaskStatus) bool {
+		if status.State.IsFinished() {
+			return false
+		}
+
+		if status.AcceptedSpec == nil {
+			status.AcceptedSpec = task.Spec.DeepCopy()
+		}
+
+		status.State = state
+		status.FinishTime = k8sutil.NewTime(meta.Now())
+		status.Message = message
+
+		if state == api.ArangoTaskSuccessState && status.Progress != nil {
+			status.Progress.Completed = status.Progress.Total
+		}
+
+		return true
+	}); err != nil {
+		a.log.Err(err).Error("Unable to finish ArangoTask")
+		return false, err
+	}
+
+	return true, nil
+}
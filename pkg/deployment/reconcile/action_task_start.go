@@ -0,0 +1,81 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/definitions"
+)
+
+// newTaskStartAction creates a new Action that implements the given
+// planned TaskStart action.
+func newTaskStartAction(action api.Action, actionCtx ActionContext) Action {
+	a := &actionTaskStart{}
+
+	a.actionImpl = newActionImplDefRef(action, actionCtx)
+
+	return a
+}
+
+// actionTaskStart marks the ArangoTask as Running
+type actionTaskStart struct {
+	// actionImpl implement timeout and member id functions
+	actionImpl
+
+	actionEmptyCheckProgress
+}
+
+func (a *actionTaskStart) ReloadComponents() (types.UID, []definitions.Component) {
+	return a.actionCtx.ACS().UID(), []definitions.Component{
+		definitions.ArangoTask,
+	}
+}
+
+// Start saves the accepted spec, start time and the number of the task actions in the ArangoTask status
+func (a *actionTaskStart) Start(ctx context.Context) (bool, error) {
+	total := len(a.actionCtx.GetStatus().Plan.Filter(func(p api.Action) bool {
+		return p.TaskID == a.action.TaskID && p.Type != api.ActionTypeTaskStart && p.Type != api.ActionTypeTaskFinish
+	}))
+
+	if err := withArangoTaskStatusUpdate(ctx, a.actionCtx.ACS().CurrentClusterCache(), a.action.TaskID, func(task *api.ArangoTask, status *api.ArangoTaskStatus) bool {
+		status.State = api.ArangoTaskRunningState
+		status.AcceptedSpec = task.Spec.DeepCopy()
+		status.StartTime = k8sutil.NewTime(meta.Now())
+		status.FinishTime = nil
+		status.Message = ""
+		status.Progress = &api.ArangoTaskProgress{
+			Total: total,
+		}
+
+		return true
+	}); err != nil {
+		a.log.Err(err).Error("Unable to start ArangoTask")
+		return false, err
+	}
+
+	return true, nil
+}
@@ -33,7 +33,6 @@ import (
 	"github.com/arangodb/kube-arangodb/pkg/util"
 	"github.com/arangodb/kube-arangodb/pkg/util/errors"
 	"github.com/arangodb/kube-arangodb/pkg/util/globals"
-	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/kerrors"
 )
 
@@ -74,7 +73,7 @@ func (a *actionAppendTLSCACertificate) Start(ctx context.Context) (bool, error)
 		return true, nil
 	}
 
-	ca, _, err := k8sutil.GetKeyCertFromSecret(caSecret, resources.CACertName, resources.CAKeyName)
+	ca, err := resources.GetTLSCACertificates(a.actionCtx.GetSpec().TLS, caSecret)
 	if err != nil {
 		a.log.Err(err).Warn("Cert %s is invalid", resources.GetCASecretName(a.actionCtx.GetAPIObject()))
 		return true, nil
@@ -0,0 +1,122 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"fmt"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	inspectorInterface "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector"
+)
+
+// withArangoTaskStatusUpdate updates the status of the ArangoTask with the given UID when the update func returns true.
+// Missing task is not considered as an error, as it can be removed by the user at any time.
+func withArangoTaskStatusUpdate(ctx context.Context, cache inspectorInterface.Inspector, uid types.UID, update func(task *api.ArangoTask, status *api.ArangoTaskStatus) bool) error {
+	inspector, err := cache.ArangoTask().V1()
+	if err != nil {
+		return errors.Wrapf(err, "ArangoTask inspector is not available")
+	}
+
+	tasks := inspector.Filter(func(task *api.ArangoTask) bool {
+		return task.GetUID() == uid
+	})
+
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	task := tasks[0].DeepCopy()
+
+	if !update(task, &task.Status) {
+		return nil
+	}
+
+	ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
+	defer cancel()
+
+	if _, err := cache.ArangoTaskModInterface().V1().UpdateStatus(ctxChild, task, meta.UpdateOptions{}); err != nil {
+		return errors.Wrapf(err, "Unable to update ArangoTask %s status", task.GetName())
+	}
+
+	return nil
+}
+
+// arangoTaskProgress keeps the number of the task actions completed during the plan execution
+type arangoTaskProgress map[types.UID]int
+
+// add counts the completed task action
+func (p arangoTaskProgress) add(action api.Action) {
+	if action.TaskID == "" || action.Type == api.ActionTypeTaskStart || action.Type == api.ActionTypeTaskFinish {
+		return
+	}
+
+	p[action.TaskID]++
+}
+
+// updateArangoTaskProgress increases the number of the completed task actions, once per task
+func (d *Reconciler) updateArangoTaskProgress(ctx context.Context, progress arangoTaskProgress) {
+	for uid, completed := range progress {
+		if err := withArangoTaskStatusUpdate(ctx, d.context.ACS().CurrentClusterCache(), uid, func(_ *api.ArangoTask, status *api.ArangoTaskStatus) bool {
+			if status.State != api.ArangoTaskRunningState || status.Progress == nil {
+				return false
+			}
+
+			status.Progress.Completed += completed
+
+			return true
+		}); err != nil {
+			d.planLogger.Err(err).Str("task", string(uid)).Warn("Unable to update ArangoTask progress")
+		}
+	}
+}
+
+// failArangoTask marks the task as failed when one of its actions failed or was aborted
+func (d *Reconciler) failArangoTask(ctx context.Context, action api.Action, reason error) {
+	if action.TaskID == "" {
+		return
+	}
+
+	message := "Action aborted"
+	if reason != nil {
+		message = reason.Error()
+	}
+
+	if err := withArangoTaskStatusUpdate(ctx, d.context.ACS().CurrentClusterCache(), action.TaskID, func(_ *api.ArangoTask, status *api.ArangoTaskStatus) bool {
+		if status.State.IsFinished() {
+			return false
+		}
+
+		status.State = api.ArangoTaskFailedState
+		status.FinishTime = k8sutil.NewTime(meta.Now())
+		status.Message = fmt.Sprintf("Action %s failed: %s", action.Type, message)
+
+		return true
+	}); err != nil {
+		d.planLogger.Err(err).Str("task", string(action.TaskID)).Warn("Unable to mark ArangoTask as failed")
+	}
+}
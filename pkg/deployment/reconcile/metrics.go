@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -27,6 +27,7 @@ import (
 
 type Metrics struct {
 	Rebalancer MetricsRebalancer
+	Topology   MetricsTopology
 }
 
 func (m *Metrics) GetRebalancer() *MetricsRebalancer {
@@ -80,6 +81,26 @@ func (m *MetricsRebalancer) AddSuccesses(i int) {
 	m.succeeded += i
 }
 
+type MetricsTopology struct {
+	enabled bool
+
+	shardsSameZone int
+}
+
+func (m *MetricsTopology) SetEnabled(enabled bool) {
+	if m == nil {
+		return
+	}
+	m.enabled = enabled
+}
+
+func (m *MetricsTopology) SetShardsSameZone(shards int) {
+	if m == nil {
+		return
+	}
+	m.shardsSameZone = shards
+}
+
 func (r *Reconciler) CollectMetrics(m metrics.PushMetric) {
 	if r.metrics.Rebalancer.enabled {
 		m.Push(metric_descriptions.ArangodbOperatorRebalancerEnabledGauge(1, r.namespace, r.name))
@@ -90,4 +111,8 @@ func (r *Reconciler) CollectMetrics(m metrics.PushMetric) {
 	} else {
 		m.Push(metric_descriptions.ArangodbOperatorRebalancerEnabledGauge(0, r.namespace, r.name))
 	}
+
+	if r.metrics.Topology.enabled {
+		m.Push(metric_descriptions.ArangodbOperatorTopologyShardsSameZoneGauge(float64(r.metrics.Topology.shardsSameZone), r.namespace, r.name))
+	}
 }
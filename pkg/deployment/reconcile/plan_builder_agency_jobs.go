@@ -0,0 +1,173 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/actions"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency/state"
+	sharedReconcile "github.com/arangodb/kube-arangodb/pkg/deployment/reconcile/shared"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+const agencyJobsStuckMessageLimit = 10
+
+// createAgencyJobsStuckConditionPlan creates plan to update AgencyJobsStuck condition
+// when agency supervision jobs stay in ToDo or Pending phase longer than the recovery timeout
+func (r *Reconciler) createAgencyJobsStuckConditionPlan(ctx context.Context, apiObject k8sutil.APIObject,
+	spec api.DeploymentSpec, status api.DeploymentStatus,
+	context PlanBuilderContext) api.Plan {
+	c, exists := status.Conditions.Get(api.ConditionTypeAgencyJobsStuck)
+
+	policy := spec.Recovery.Get().AgencyJobs
+
+	if spec.Mode.Get() != api.DeploymentModeCluster || !policy.IsEnabled() {
+		if exists {
+			return api.Plan{sharedReconcile.RemoveConditionActionV2("Agency Jobs Watcher Disabled", api.ConditionTypeAgencyJobsStuck)}
+		}
+		return nil
+	}
+
+	cache, ok := context.GetAgencyCache()
+	if !ok {
+		return nil
+	}
+
+	jobs := cache.Target.GetStuckJobs(time.Now(), policy.GetTimeout())
+	if len(jobs) == 0 {
+		if exists {
+			return api.Plan{sharedReconcile.RemoveConditionActionV2("Agency Jobs Finished", api.ConditionTypeAgencyJobsStuck)}
+		}
+		return nil
+	}
+
+	ids := make([]string, len(jobs))
+	for i, job := range jobs {
+		ids[i] = string(job.ID)
+	}
+
+	hash := util.SHA256FromStringArray(ids...)
+
+	if exists && c.IsTrue() && c.Hash == hash {
+		return nil
+	}
+
+	return api.Plan{sharedReconcile.UpdateConditionActionV2("Agency Jobs Stuck", api.ConditionTypeAgencyJobsStuck, true,
+		"Agency Jobs Stuck", agencyJobsStuckMessage(jobs), hash)}
+}
+
+// createAgencyJobsRecoveryPlan creates plan to abort or retry the first stuck agency job
+func (r *Reconciler) createAgencyJobsRecoveryPlan(ctx context.Context, apiObject k8sutil.APIObject,
+	spec api.DeploymentSpec, status api.DeploymentStatus,
+	context PlanBuilderContext) api.Plan {
+	if spec.Mode.Get() != api.DeploymentModeCluster {
+		return nil
+	}
+
+	policy := spec.Recovery.Get().AgencyJobs
+
+	var actionType api.ActionType
+	switch policy.GetAction() {
+	case api.ArangoDeploymentRecoveryAgencyJobsActionAbort:
+		actionType = api.ActionTypeAbortAgencyJob
+	case api.ArangoDeploymentRecoveryAgencyJobsActionRetry:
+		actionType = api.ActionTypeRetryAgencyJob
+	default:
+		return nil
+	}
+
+	if !status.Conditions.IsTrue(api.ConditionTypeAgencyJobsStuck) {
+		return nil
+	}
+
+	cache, ok := context.GetAgencyCache()
+	if !ok {
+		return nil
+	}
+
+	// CleanOut jobs of the members are managed by the CleanOutMember action
+	managed := map[state.JobID]bool{}
+	for _, e := range status.Members.AsList() {
+		if id := e.Member.CleanoutJobID; id != "" {
+			managed[state.JobID(id)] = true
+		}
+	}
+
+	// MoveShard jobs of the rebalancer are managed by the rebalancer itself
+	if rebalancer := status.Rebalancer; rebalancer != nil {
+		for _, id := range rebalancer.MoveJobs {
+			managed[state.JobID(id)] = true
+		}
+	}
+
+	for _, j := range cache.Target.GetStuckJobs(time.Now(), policy.GetTimeout()) {
+		if managed[j.ID] {
+			continue
+		}
+
+		job, _ := cache.Target.GetJob(j.ID)
+		if !job.IsAbortable() {
+			continue
+		}
+
+		r.log.Str("job-id", string(j.ID)).Str("type", job.Type).Str("action", string(actionType)).Info("Agency job is stuck")
+
+		return api.Plan{actions.NewClusterAction(actionType, fmt.Sprintf("Agency job %s is not finished in time", j.ID)).
+			AddParam(actionAgencyJobID, string(j.ID)).
+			AddParam(actionAgencyJobType, job.Type).
+			AddParam(actionAgencyJobDatabase, job.Database).
+			AddParam(actionAgencyJobCollection, job.Collection).
+			AddParam(actionAgencyJobShard, job.Shard).
+			AddParam(actionAgencyJobFromServer, job.FromServer).
+			AddParam(actionAgencyJobToServer, job.ToServer).
+			AddParam(actionAgencyJobServer, job.Server)}
+	}
+
+	return nil
+}
+
+// agencyJobsStuckMessage returns the condition message with the list of the stuck jobs
+func agencyJobsStuckMessage(jobs []state.AnalysisJob) string {
+	descriptions := make([]string, 0, len(jobs))
+	for _, j := range jobs {
+		if len(descriptions) == agencyJobsStuckMessageLimit {
+			break
+		}
+
+		if j.Server != "" {
+			descriptions = append(descriptions, fmt.Sprintf("%s (%s %s on %s)", j.ID, j.Phase, j.Type, j.Server))
+		} else {
+			descriptions = append(descriptions, fmt.Sprintf("%s (%s %s)", j.ID, j.Phase, j.Type))
+		}
+	}
+
+	if len(jobs) > agencyJobsStuckMessageLimit {
+		return fmt.Sprintf("Agency jobs are not finished in time: %s and %d more", strings.Join(descriptions, ", "), len(jobs)-agencyJobsStuckMessageLimit)
+	}
+
+	return fmt.Sprintf("Agency jobs are not finished in time: %s", strings.Join(descriptions, ", "))
+}
@@ -0,0 +1,297 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency/state"
+	sharedReconcile "github.com/arangodb/kube-arangodb/pkg/deployment/reconcile/shared"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func agencyJobsContext(t *testing.T) *testContext {
+	stuck := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	recent := time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+
+	var target state.Target
+	require.NoError(t, json.Unmarshal([]byte(fmt.Sprintf(`{
+  "ToDo": {
+    "1": {"type": "moveShard", "database": "db", "collection": "100", "shard": "s101", "fromServer": "A", "toServer": "B", "timeCreated": %q}
+  },
+  "Pending": {
+    "2": {"type": "failedServer", "server": "C", "timeCreated": %q, "timeStarted": %q},
+    "3": {"type": "cleanOutServer", "server": "D", "timeCreated": %q, "timeStarted": %q},
+    "4": {"type": "moveShard", "timeCreated": %q}
+  }
+}`, stuck, stuck, stuck, stuck, stuck, recent)), &target))
+
+	return &testContext{
+		AgencyState: state.State{
+			Target: target,
+		},
+		ArangoDeployment: &api.ArangoDeployment{},
+	}
+}
+
+func Test_CreateAgencyJobsStuckConditionPlan(t *testing.T) {
+	enabled := &api.ArangoDeploymentRecoveryAgencyJobsSpec{
+		Enabled: util.NewType(true),
+	}
+
+	type testCase struct {
+		policy    *api.ArangoDeploymentRecoveryAgencyJobsSpec
+		condition *api.Condition
+		mode      api.DeploymentMode
+
+		remove  bool
+		message string
+	}
+
+	testCases := map[string]testCase{
+		"Policy disabled": {},
+		"Policy disabled - condition cleanup": {
+			condition: &api.Condition{Type: api.ConditionTypeAgencyJobsStuck},
+
+			remove: true,
+		},
+		"Single mode - condition cleanup": {
+			policy:    enabled,
+			condition: &api.Condition{Type: api.ConditionTypeAgencyJobsStuck},
+			mode:      api.DeploymentModeSingle,
+
+			remove: true,
+		},
+		"Stuck jobs": {
+			policy: enabled,
+
+			message: "Agency jobs are not finished in time: 1 (ToDo moveShard), 2 (Pending failedServer on C), 3 (Pending cleanOutServer on D)",
+		},
+		"Stuck jobs - condition up to date": {
+			policy: enabled,
+			condition: &api.Condition{
+				Type:   api.ConditionTypeAgencyJobsStuck,
+				Status: "True",
+				Hash:   util.SHA256FromStringArray("1", "2", "3"),
+			},
+		},
+		"Stuck jobs - condition outdated": {
+			policy: enabled,
+			condition: &api.Condition{
+				Type:   api.ConditionTypeAgencyJobsStuck,
+				Status: "True",
+				Hash:   util.SHA256FromStringArray("1"),
+			},
+
+			message: "Agency jobs are not finished in time: 1 (ToDo moveShard), 2 (Pending failedServer on C), 3 (Pending cleanOutServer on D)",
+		},
+		"Jobs finished in time": {
+			policy: &api.ArangoDeploymentRecoveryAgencyJobsSpec{
+				Enabled: util.NewType(true),
+				Timeout: &meta.Duration{Duration: 2 * time.Hour},
+			},
+			condition: &api.Condition{Type: api.ConditionTypeAgencyJobsStuck},
+
+			remove: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c := agencyJobsContext(t)
+
+			mode := api.DeploymentModeCluster
+			if tc.mode != "" {
+				mode = tc.mode
+			}
+
+			var status api.DeploymentStatus
+			if tc.condition != nil {
+				status.Conditions = api.ConditionList{*tc.condition}
+			}
+
+			r := newTestReconciler()
+			plan := r.createAgencyJobsStuckConditionPlan(context.Background(), c.ArangoDeployment, api.DeploymentSpec{
+				Mode: api.NewMode(mode),
+				Recovery: &api.ArangoDeploymentRecoverySpec{
+					AgencyJobs: tc.policy,
+				},
+			}, status, c)
+
+			if !tc.remove && tc.message == "" {
+				require.Empty(t, plan)
+				return
+			}
+
+			require.Len(t, plan, 1)
+			require.Equal(t, api.ActionTypeSetConditionV2, plan[0].Type)
+
+			if tc.remove {
+				v, _ := plan[0].GetParam(sharedReconcile.SetConditionActionV2KeyType)
+				require.Equal(t, sharedReconcile.SetConditionActionV2KeyTypeRemove, v)
+				return
+			}
+
+			v, _ := plan[0].GetParam(sharedReconcile.SetConditionActionV2KeyMessage)
+			require.Equal(t, tc.message, v)
+		})
+	}
+}
+
+func Test_CreateAgencyJobsRecoveryPlan(t *testing.T) {
+	policy := func(action api.ArangoDeploymentRecoveryAgencyJobsAction) *api.ArangoDeploymentRecoveryAgencyJobsSpec {
+		return &api.ArangoDeploymentRecoveryAgencyJobsSpec{
+			Enabled: util.NewType(true),
+			Action:  &action,
+		}
+	}
+
+	type testCase struct {
+		policy       *api.ArangoDeploymentRecoveryAgencyJobsSpec
+		noCondition  bool
+		cleanoutJob  string
+		expectedType api.ActionType
+		expectedJob  string
+	}
+
+	testCases := map[string]testCase{
+		"Action not defined": {
+			policy: &api.ArangoDeploymentRecoveryAgencyJobsSpec{Enabled: util.NewType(true)},
+		},
+		"Action None": {
+			policy: policy(api.ArangoDeploymentRecoveryAgencyJobsActionNone),
+		},
+		"Condition not set": {
+			policy:      policy(api.ArangoDeploymentRecoveryAgencyJobsActionAbort),
+			noCondition: true,
+		},
+		"Abort": {
+			policy: policy(api.ArangoDeploymentRecoveryAgencyJobsActionAbort),
+
+			expectedType: api.ActionTypeAbortAgencyJob,
+			expectedJob:  "1",
+		},
+		"Retry": {
+			policy: policy(api.ArangoDeploymentRecoveryAgencyJobsActionRetry),
+
+			expectedType: api.ActionTypeRetryAgencyJob,
+			expectedJob:  "1",
+		},
+		"Retry - skip member cleanout job": {
+			policy:      policy(api.ArangoDeploymentRecoveryAgencyJobsActionRetry),
+			cleanoutJob: "3",
+
+			expectedType: api.ActionTypeRetryAgencyJob,
+			expectedJob:  "1",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c := agencyJobsContext(t)
+
+			var status api.DeploymentStatus
+			if !tc.noCondition {
+				status.Conditions.Update(api.ConditionTypeAgencyJobsStuck, true, "", "")
+			}
+			require.NoError(t, status.Members.Add(api.MemberStatus{ID: "D", CleanoutJobID: tc.cleanoutJob}, api.ServerGroupDBServers))
+
+			r := newTestReconciler()
+			plan := r.createAgencyJobsRecoveryPlan(context.Background(), c.ArangoDeployment, api.DeploymentSpec{
+				Mode: api.NewMode(api.DeploymentModeCluster),
+				Recovery: &api.ArangoDeploymentRecoverySpec{
+					AgencyJobs: tc.policy,
+				},
+			}, status, c)
+
+			if tc.expectedType == "" {
+				require.Empty(t, plan)
+				return
+			}
+
+			require.Len(t, plan, 1)
+			require.Equal(t, tc.expectedType, plan[0].Type)
+
+			v, _ := plan[0].GetParam(actionAgencyJobID)
+			require.Equal(t, tc.expectedJob, v)
+
+			v, _ = plan[0].GetParam(actionAgencyJobShard)
+			require.Equal(t, "s101", v)
+		})
+	}
+
+	t.Run("Skip not abortable and managed jobs", func(t *testing.T) {
+		c := agencyJobsContext(t)
+		delete(c.AgencyState.Target.JobToDo, "1")
+
+		var status api.DeploymentStatus
+		status.Conditions.Update(api.ConditionTypeAgencyJobsStuck, true, "", "")
+		require.NoError(t, status.Members.Add(api.MemberStatus{ID: "D", CleanoutJobID: "3"}, api.ServerGroupDBServers))
+
+		r := newTestReconciler()
+		plan := r.createAgencyJobsRecoveryPlan(context.Background(), c.ArangoDeployment, api.DeploymentSpec{
+			Mode: api.NewMode(api.DeploymentModeCluster),
+			Recovery: &api.ArangoDeploymentRecoverySpec{
+				AgencyJobs: policy(api.ArangoDeploymentRecoveryAgencyJobsActionAbort),
+			},
+		}, status, c)
+
+		require.Empty(t, plan)
+	})
+
+	t.Run("Skip rebalancer move jobs", func(t *testing.T) {
+		c := agencyJobsContext(t)
+
+		var status api.DeploymentStatus
+		status.Conditions.Update(api.ConditionTypeAgencyJobsStuck, true, "", "")
+		status.Rebalancer = &api.ArangoDeploymentRebalancerStatus{
+			MoveJobs: []string{"1"},
+		}
+
+		r := newTestReconciler()
+		spec := api.DeploymentSpec{
+			Mode: api.NewMode(api.DeploymentModeCluster),
+			Recovery: &api.ArangoDeploymentRecoverySpec{
+				AgencyJobs: policy(api.ArangoDeploymentRecoveryAgencyJobsActionAbort),
+			},
+		}
+
+		plan := r.createAgencyJobsRecoveryPlan(context.Background(), c.ArangoDeployment, spec, status, c)
+		require.Len(t, plan, 1)
+		require.Equal(t, api.ActionTypeAbortAgencyJob, plan[0].Type)
+
+		v, _ := plan[0].GetParam(actionAgencyJobID)
+		require.Equal(t, "3", v)
+
+		require.NoError(t, status.Members.Add(api.MemberStatus{ID: "D", CleanoutJobID: "3"}, api.ServerGroupDBServers))
+
+		plan = r.createAgencyJobsRecoveryPlan(context.Background(), c.ArangoDeployment, spec, status, c)
+		require.Empty(t, plan)
+	})
+}
@@ -0,0 +1,249 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	core "k8s.io/api/core/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/patch"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/reconciler"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/resources"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/rotation"
+	"github.com/arangodb/kube-arangodb/pkg/logging"
+	"github.com/arangodb/kube-arangodb/pkg/util/compare"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+// PlanDryRun keeps the result of the plan generation for the candidate spec
+type PlanDryRun struct {
+	// High keeps the high priority plan
+	High api.Plan
+	// Resources keeps the resources plan
+	Resources api.Plan
+	// Normal keeps the normal plan
+	Normal api.Plan
+	// Members keeps the rotation decision for each member
+	Members []PlanDryRunMember
+}
+
+// PlanDryRunMember keeps the rotation decision of the member for the candidate spec
+type PlanDryRunMember struct {
+	Group  api.ServerGroup
+	ID     string
+	Mode   compare.Mode
+	Reason string
+}
+
+// DryRunPlan generates the plans and the member rotation decisions for the given candidate spec.
+// Generated plans are not saved and no action is executed. Actions held outside of the maintenance windows
+// are returned with the held flag (see IsPlanDryRunActionHeld).
+func (d *Reconciler) DryRunPlan(ctx context.Context, spec api.DeploymentSpec) (PlanDryRun, error) {
+	r := d.newDryRunReconciler(spec)
+
+	apiObject := r.context.GetAPIObject()
+	status := r.context.GetStatus()
+
+	// BackOffs are ignored, all plan builders are evaluated
+	status.BackOff = nil
+
+	builderCtx := newPlanBuilderContext(r.context)
+
+	var result PlanDryRun
+	var held bool
+
+	result.High, _, _ = r.createHighPlan(ctx, apiObject, nil, spec, status, builderCtx)
+	result.High, held = filterMaintenanceWindowHold(result.High, held)
+	result.Resources, _, _ = r.createResourcesPlan(ctx, apiObject, nil, spec, status, builderCtx)
+	result.Resources, held = filterMaintenanceWindowHold(result.Resources, held)
+	result.Normal, _, _ = r.createNormalPlan(ctx, apiObject, nil, spec, status, builderCtx)
+	result.Normal, _ = filterMaintenanceWindowHold(result.Normal, held)
+
+	for _, e := range status.Members.AsList() {
+		mode, reason, err := r.dryRunMemberRotation(ctx, apiObject, spec, status, e.Group, e.Member, builderCtx)
+		if err != nil {
+			return PlanDryRun{}, errors.Wrapf(err, "Unable to check rotation of member %s", e.Member.ID)
+		}
+
+		result.Members = append(result.Members, PlanDryRunMember{
+			Group:  e.Group,
+			ID:     e.Member.ID,
+			Mode:   mode,
+			Reason: reason,
+		})
+	}
+
+	return result, nil
+}
+
+// newDryRunReconciler returns the reconciler isolated from the running one.
+// It keeps own metrics, discards the logs and blocks all modifications of the deployment.
+func (d *Reconciler) newDryRunReconciler(spec api.DeploymentSpec) *Reconciler {
+	r := &Reconciler{
+		namespace: d.namespace,
+		name:      d.name,
+		context:   newPlanDryRunContext(d.context, spec),
+		dryRun:    true,
+	}
+	r.log = planDryRunLogger.WrapObj(r)
+	r.planLogger = r.log.Str("section", "plan")
+	return r
+}
+
+// IsPlanDryRunActionHeld returns true if the action generated in the dry-run mode is held until the maintenance window opens
+func IsPlanDryRunActionHeld(a api.Action) bool {
+	_, ok := a.GetParam(maintenanceWindowHeldParam)
+	return ok
+}
+
+// dryRunMemberRotation renders the pod template of the member for the candidate spec and compares it with the template of the running pod
+func (d *Reconciler) dryRunMemberRotation(ctx context.Context, apiObject k8sutil.APIObject, spec api.DeploymentSpec, status api.DeploymentStatus,
+	group api.ServerGroup, m api.MemberStatus, planCtx PlanBuilderContext) (compare.Mode, string, error) {
+	if current := status.CurrentImage; current != nil && current.Image != spec.GetImage() && group.IsArangod() {
+		return compare.GracefulRotation, fmt.Sprintf("Image changed from %s to %s, member needs to be upgraded", current.Image, spec.GetImage()), nil
+	}
+
+	cache, ok := planCtx.ACS().ClusterCache(m.ClusterID)
+	if !ok {
+		return compare.SkippedRotation, "Cluster is not ready", nil
+	}
+
+	member, ok := cache.ArangoMember().V1().GetSimple(m.ArangoMemberName(apiObject.GetName(), group))
+	if !ok {
+		return compare.SkippedRotation, "ArangoMember not found", nil
+	}
+
+	imageInfo, ok := planCtx.SelectImageForMember(spec, status, m)
+	if !ok {
+		return compare.SkippedRotation, "Image is not yet discovered", nil
+	}
+
+	renderedPod, err := planCtx.RenderPodTemplateForMember(ctx, planCtx.ACS(), spec, status, m.ID, imageInfo)
+	if err != nil {
+		return compare.SkippedRotation, "", err
+	}
+
+	checksum, err := resources.ChecksumArangoPod(spec.GetServerGroupSpec(group), resources.CreatePodFromTemplate(renderedPod))
+	if err != nil {
+		return compare.SkippedRotation, "", err
+	}
+
+	template, err := api.GetArangoMemberPodTemplate(renderedPod, checksum)
+	if err != nil {
+		return compare.SkippedRotation, "", err
+	}
+
+	pod, ok := cache.Pod().V1().GetSimple(m.Pod.GetName())
+	if !ok {
+		pod = nil
+	}
+
+	mode, _, _, reason, err := rotation.IsRotationRequired(planCtx.ACS(), spec, m, group, pod, template, member.Status.Template)
+	if err != nil {
+		return compare.SkippedRotation, "", err
+	}
+
+	return mode, reason, nil
+}
+
+func newPlanDryRunContext(ctx Context, spec api.DeploymentSpec) Context {
+	return planDryRunContext{
+		Context: ctx,
+		spec:    spec,
+	}
+}
+
+// planDryRunContext overrides the spec of the Context and blocks all modifications
+type planDryRunContext struct {
+	Context
+
+	spec api.DeploymentSpec
+}
+
+func (p planDryRunContext) GetSpec() api.DeploymentSpec {
+	return p.spec
+}
+
+func (p planDryRunContext) WithStatusUpdateErr(ctx context.Context, action reconciler.DeploymentStatusUpdateErrFunc) error {
+	return errPlanDryRun
+}
+
+func (p planDryRunContext) WithStatusUpdate(ctx context.Context, action reconciler.DeploymentStatusUpdateFunc) error {
+	return errPlanDryRun
+}
+
+func (p planDryRunContext) WithMemberStatusUpdateErr(ctx context.Context, id string, group api.ServerGroup, action reconciler.DeploymentMemberStatusUpdateErrFunc) error {
+	return errPlanDryRun
+}
+
+func (p planDryRunContext) WithMemberStatusUpdate(ctx context.Context, id string, group api.ServerGroup, action reconciler.DeploymentMemberStatusUpdateFunc) error {
+	return errPlanDryRun
+}
+
+func (p planDryRunContext) UpdateStatus(ctx context.Context, status api.DeploymentStatus) error {
+	return errPlanDryRun
+}
+
+func (p planDryRunContext) UpdateMember(ctx context.Context, member api.MemberStatus) error {
+	return errPlanDryRun
+}
+
+func (p planDryRunContext) SetAgencyMaintenanceMode(ctx context.Context, enabled bool) error {
+	return errPlanDryRun
+}
+
+func (p planDryRunContext) ApplyPatchOnPod(ctx context.Context, pod *core.Pod, patches ...patch.Item) error {
+	return errPlanDryRun
+}
+
+func (p planDryRunContext) ApplyPatch(ctx context.Context, patches ...patch.Item) error {
+	return errPlanDryRun
+}
+
+func (p planDryRunContext) CreateMember(ctx context.Context, group api.ServerGroup, id string, mods ...CreateMemberMod) (string, error) {
+	return "", errPlanDryRun
+}
+
+func (p planDryRunContext) DisableScalingCluster(ctx context.Context) error {
+	return errPlanDryRun
+}
+
+func (p planDryRunContext) EnableScalingCluster(ctx context.Context) error {
+	return errPlanDryRun
+}
+
+func (p planDryRunContext) CreateEvent(evt *k8sutil.Event) {
+}
+
+func (p planDryRunContext) CreateOperatorEngineOpsAlertEvent(message string, args ...interface{}) {
+}
+
+var (
+	errPlanDryRun = errors.Errorf("Modifications are not allowed in the dry-run mode")
+
+	// planDryRunLogger discards the logs of the plan builders evaluated in the dry-run mode
+	planDryRunLogger = logging.NewFactory(zerolog.Nop()).RegisterAndGetLogger("deployment-reconcile-dry-run", logging.Info)
+)
@@ -0,0 +1,97 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/compare"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+func Test_PlanDryRunContext(t *testing.T) {
+	c := &testContext{}
+	c.ArangoDeployment = &api.ArangoDeployment{}
+	c.ArangoDeployment.Spec.Image = util.NewType("current")
+
+	ctx := newPlanDryRunContext(c, api.DeploymentSpec{Image: util.NewType("candidate")})
+
+	require.Equal(t, "candidate", ctx.GetSpec().GetImage())
+
+	require.ErrorIs(t, ctx.WithStatusUpdate(context.Background(), func(s *api.DeploymentStatus) bool {
+		return true
+	}), errPlanDryRun)
+	require.ErrorIs(t, ctx.UpdateStatus(context.Background(), api.DeploymentStatus{}), errPlanDryRun)
+	require.ErrorIs(t, ctx.UpdateMember(context.Background(), api.MemberStatus{}), errPlanDryRun)
+	require.ErrorIs(t, ctx.SetAgencyMaintenanceMode(context.Background(), true), errPlanDryRun)
+	require.ErrorIs(t, ctx.ApplyPatch(context.Background()), errPlanDryRun)
+	require.ErrorIs(t, ctx.ApplyPatchOnPod(context.Background(), nil), errPlanDryRun)
+	require.ErrorIs(t, ctx.DisableScalingCluster(context.Background()), errPlanDryRun)
+	require.ErrorIs(t, ctx.EnableScalingCluster(context.Background()), errPlanDryRun)
+	_, err := ctx.CreateMember(context.Background(), api.ServerGroupDBServers, "")
+	require.ErrorIs(t, err, errPlanDryRun)
+
+	ctx.CreateEvent(&k8sutil.Event{})
+	require.Nil(t, c.RecordedEvent)
+}
+
+func Test_NewDryRunReconciler(t *testing.T) {
+	c := &testContext{}
+	c.ArangoDeployment = &api.ArangoDeployment{}
+
+	d := NewReconciler("ns", "name", c)
+	d.metrics.Rebalancer.SetEnabled(true)
+
+	r := d.newDryRunReconciler(api.DeploymentSpec{})
+	require.True(t, r.dryRun)
+	require.False(t, d.dryRun)
+	require.Equal(t, "ns", r.namespace)
+	require.Equal(t, "name", r.name)
+
+	r.metrics.Rebalancer.AddMoves(5)
+	require.Equal(t, 0, d.metrics.Rebalancer.moves)
+	require.False(t, r.metrics.Rebalancer.enabled)
+
+	require.ErrorIs(t, r.context.UpdateStatus(context.Background(), api.DeploymentStatus{}), errPlanDryRun)
+}
+
+func Test_DryRunMemberRotation_ImageChanged(t *testing.T) {
+	r := newTestReconciler()
+
+	c := &testContext{}
+	c.ArangoDeployment = &api.ArangoDeployment{}
+
+	status := api.DeploymentStatus{
+		CurrentImage: &api.ImageInfo{Image: "arangodb/arangodb:3.12.4"},
+	}
+
+	spec := api.DeploymentSpec{Image: util.NewType("arangodb/arangodb:3.12.5")}
+
+	mode, reason, err := r.dryRunMemberRotation(context.Background(), c.ArangoDeployment, spec, status, api.ServerGroupDBServers, api.MemberStatus{ID: "PRMR-1"}, c)
+	require.NoError(t, err)
+	require.Equal(t, compare.GracefulRotation, mode)
+	require.Equal(t, "Image changed from arangodb/arangodb:3.12.4 to arangodb/arangodb:3.12.5, member needs to be upgraded", reason)
+}
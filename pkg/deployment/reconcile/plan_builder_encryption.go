@@ -31,8 +31,10 @@ import (
 	"github.com/arangodb/kube-arangodb/pkg/deployment/features"
 	"github.com/arangodb/kube-arangodb/pkg/deployment/pod"
 	sharedReconcile "github.com/arangodb/kube-arangodb/pkg/deployment/reconcile/shared"
+	utilConstants "github.com/arangodb/kube-arangodb/pkg/util/constants"
 	"github.com/arangodb/kube-arangodb/pkg/util/globals"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/arangodb/kube-arangodb/pkg/util/kms"
 	"github.com/arangodb/kube-arangodb/pkg/util/strings"
 )
 
@@ -96,7 +98,7 @@ func (r *Reconciler) createEncryptionKey(ctx context.Context, apiObject k8sutil.
 		return nil
 	}
 
-	name, _, err := pod.GetEncryptionKeyFromSecret(secret)
+	name, _, err := pod.GetEncryptionKeyfolderEntryFromSecret(ctx, context.ACS().CurrentClusterCache().Secret().V1().Read(), spec.RocksDB.Encryption, secret)
 	if err != nil {
 		r.log.Err(err).Error("Unable to fetch encryption key")
 		return nil
@@ -197,7 +199,7 @@ func (r *Reconciler) createEncryptionKeyCleanPlan(ctx context.Context, apiObject
 		return nil
 	}
 
-	name, _, err := pod.GetEncryptionKeyFromSecret(secret)
+	name, _, err := pod.GetEncryptionKeyfolderEntryFromSecret(ctx, context.ACS().CurrentClusterCache().Secret().V1().Read(), spec.RocksDB.Encryption, secret)
 	if err != nil {
 		return nil
 	}
@@ -236,6 +238,15 @@ func (r *Reconciler) areEncryptionKeysUpToDate(ctx context.Context, spec api.Dep
 				failed = true
 				continue
 			} else if updateRequired {
+				if spec.RocksDB.Encryption.IsKMS() {
+					// Keys are unwrapped by the init container, so member needs to be restarted to load them
+					if m.Conditions.IsTrue(api.ConditionTypeRestart) {
+						failed = true
+						continue
+					}
+					plan = append(plan, restartMemberConditionAction(group, m.ID, "Encryption keys changed")...)
+					continue
+				}
 				plan = append(plan, actions.NewAction(api.ActionTypeEncryptionKeyRefresh, group, sharedReconcile.WithPredefinedMember(m.ID)))
 				continue
 			}
@@ -281,3 +292,49 @@ func (r *Reconciler) isEncryptionKeyUpToDate(ctx context.Context, status api.Dep
 
 	return false, false
 }
+
+// createEncryptionKeyRewrapPlan rewraps the encryption keys when the KMS key-encryption key is rotated
+func (r *Reconciler) createEncryptionKeyRewrapPlan(ctx context.Context, apiObject k8sutil.APIObject,
+	spec api.DeploymentSpec, status api.DeploymentStatus,
+	context PlanBuilderContext) api.Plan {
+	if skipEncryptionPlan(spec, status) || !spec.RocksDB.Encryption.IsKMS() {
+		return nil
+	}
+
+	if !status.Hashes.Encryption.Propagated {
+		return nil
+	}
+
+	secrets := context.ACS().CurrentClusterCache().Secret().V1()
+
+	provider, err := pod.GetEncryptionKMSProvider(ctx, secrets.Read(), spec.RocksDB.Encryption.KMS)
+	if err != nil {
+		r.log.Err(err).Warn("Unable to create KMS provider")
+		return nil
+	}
+
+	var wrapped [][]byte
+
+	if keyfolder, ok := secrets.GetSimple(pod.GetEncryptionFolderSecretName(context.GetName())); ok {
+		for _, v := range keyfolder.Data {
+			wrapped = append(wrapped, v)
+		}
+	}
+
+	if keyfile, ok := secrets.GetSimple(spec.RocksDB.Encryption.GetKeySecretName()); ok {
+		if v, ok := keyfile.Data[utilConstants.SecretEncryptionWrappedKey]; ok {
+			wrapped = append(wrapped, v)
+		}
+	}
+
+	for _, w := range wrapped {
+		if required, err := kms.RewrapRequired(ctx, provider, string(w)); err != nil {
+			r.log.Err(err).Warn("Unable to check version of the wrapped encryption key")
+			return nil
+		} else if required {
+			return api.Plan{actions.NewClusterAction(api.ActionTypeEncryptionKeyRewrap, "KMS key-encryption key rotated")}
+		}
+	}
+
+	return nil
+}
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -67,12 +67,16 @@ func (d *Reconciler) generatePlan(ctx context.Context, generators ...planGenerat
 
 	if err := d.context.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
 		var b api.BackOff
+		var held bool
 
 		for id := range generators {
 			result := generators[id](ctx)
 
 			b = b.CombineLatest(result.backoff)
 
+			// Held disruptive actions are not saved in the plan
+			result.plan, held = filterMaintenanceWindowHold(result.plan, held)
+
 			if len(result.plan) == 0 || !result.changed {
 				continue
 			}
@@ -65,12 +65,13 @@ func (r *Reconciler) createHighPlan(ctx context.Context, apiObject k8sutil.APIOb
 		ApplyIfEmpty(r.createMemberRecreationConditionsPlan).
 		ApplyIfEmpty(r.createMemberPodSchedulingFailurePlan).
 		ApplyIfEmpty(r.createRotateServerStoragePVCPendingResizeConditionPlan).
-		ApplyIfEmpty(r.createChangeMemberArchPlan).
+		ApplyIfEmpty(r.withMaintenanceWindow(r.createChangeMemberArchPlan)).
 		ApplyIfEmpty(r.createRotateServerStorageResizePlanRuntime).
 		ApplyIfEmpty(r.createTopologyMemberUpdatePlan).
 		ApplyWithBackOff(LicenseCheck, 30*time.Second, r.updateClusterLicense).
 		ApplyIfEmpty(r.createTopologyMemberConditionPlan).
 		ApplyIfEmpty(r.updateMemberConditionTypeMemberVolumeUnschedulableCondition).
+		ApplyIfEmpty(r.updateMemberUnschedulableConditionPlan).
 		ApplyIfEmpty(r.createRebalancerCheckPlanCore).
 		ApplyIfEmpty(r.createMemberFailedRestoreHighPlan).
 		ApplyIfEmpty(r.volumeMemberReplacement).
@@ -79,6 +80,9 @@ func (r *Reconciler) createHighPlan(ctx context.Context, apiObject k8sutil.APIOb
 		ApplyIfEmptyWithBackOff(SyncRBACPermissionsCheck, 30*time.Second, r.createSyncRBACPermissionsPlan).
 		Apply(r.createBackupInProgressConditionPlan).
 		Apply(r.createMaintenanceConditionPlan).
+		Apply(r.createMaintenanceWindowConditionPlan).
+		Apply(r.createAgencyJobsStuckConditionPlan).
+		Apply(r.createShardReplicasSameZoneConditionPlan).
 		Apply(r.cleanupConditions).
 		Apply(r.createHighMemberMaintenanceDisablePlan)
 
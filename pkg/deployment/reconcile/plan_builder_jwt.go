@@ -27,6 +27,7 @@ import (
 	"time"
 
 	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 	"github.com/arangodb/kube-arangodb/pkg/deployment/actions"
@@ -95,6 +96,15 @@ func (r *Reconciler) createJWTKeyUpdate(ctx context.Context, apiObject k8sutil.A
 		return r.addJWTPropagatedPlanAction(status, actions.NewClusterAction(api.ActionTypeJWTSetActive, "Set active key").AddParam(checksum, jwtSha))
 	}
 
+	if spec.Authentication.IsRotationEnabled() {
+		plan, ok := r.createJWTRotationPlan(spec, status, folder, jwtSha, time.Now())
+		if !ok {
+			return nil
+		}
+
+		return r.addJWTPropagatedPlanAction(status, plan...)
+	}
+
 	for key := range folder.Data {
 		if key == utilConstants.ActiveJWTKey || key == utilConstants.SecretKeyToken {
 			continue
@@ -110,6 +120,161 @@ func (r *Reconciler) createJWTKeyUpdate(ctx context.Context, apiObject k8sutil.A
 	return r.addJWTPropagatedPlanAction(status)
 }
 
+type jwtLifecycleKey struct {
+	checksum string
+
+	api.DeploymentStatusHashesJWTKey
+}
+
+// createJWTRotationPlan rotates the JWT keys according to the rotation policy.
+// Returns false if the lifecycle of the keys in the status is not up to date.
+func (r *Reconciler) createJWTRotationPlan(spec api.DeploymentSpec, status api.DeploymentStatus, folder *core.Secret,
+	activeSha string, now time.Time) (api.Plan, bool) {
+	rotation := spec.Authentication.GetRotation()
+	lifecycle := status.Hashes.JWT.Keys
+
+	if _, changed := updateJWTKeysLifecycle(folder.Data, lifecycle, now); changed {
+		r.planLogger.Debug("JWT keys lifecycle is not up to date")
+		return nil, false
+	}
+
+	active, ok := lifecycle.Get(jwtKeyHash(activeSha))
+	if !ok {
+		return nil, false
+	}
+
+	var pending, retired []jwtLifecycleKey
+
+	for key := range folder.Data {
+		if key == utilConstants.ActiveJWTKey || key == utilConstants.SecretKeyToken || key == activeSha {
+			continue
+		}
+
+		k, _ := lifecycle.Get(jwtKeyHash(key))
+
+		if k.IsRetired() {
+			retired = append(retired, jwtLifecycleKey{checksum: key, DeploymentStatusHashesJWTKey: k})
+		} else if !k.IsActivated() {
+			pending = append(pending, jwtLifecycleKey{checksum: key, DeploymentStatusHashesJWTKey: k})
+		}
+	}
+
+	// Oldest retired key first
+	sort.Slice(retired, func(i, j int) bool {
+		if a, b := retired[i].Retired.Time, retired[j].Retired.Time; !a.Equal(b) {
+			return a.Before(b)
+		}
+		return retired[i].checksum < retired[j].checksum
+	})
+
+	// Newest pending key first
+	sort.Slice(pending, func(i, j int) bool {
+		if a, b := pending[i].Created.Time, pending[j].Created.Time; !a.Equal(b) {
+			return a.After(b)
+		}
+		return pending[i].checksum < pending[j].checksum
+	})
+
+	for _, k := range retired {
+		if now.Sub(k.Retired.Time) >= rotation.GetOverlap() {
+			return api.Plan{actions.NewClusterAction(api.ActionTypeJWTClean, "Remove retired key after overlap period").AddParam(checksum, k.checksum)}, true
+		}
+	}
+
+	if len(pending) > 1 {
+		return api.Plan{actions.NewClusterAction(api.ActionTypeJWTClean, "Remove outdated pending key").AddParam(checksum, pending[len(pending)-1].checksum)}, true
+	}
+
+	if len(pending) == 1 {
+		// Key is already propagated to all members
+		return api.Plan{actions.NewClusterAction(api.ActionTypeJWTPromote, "Promote propagated key").AddParam(checksum, pending[0].checksum)}, true
+	}
+
+	keys := len(retired) + 1
+
+	if keys > rotation.GetMaxKeys() && len(retired) > 0 {
+		return api.Plan{actions.NewClusterAction(api.ActionTypeJWTClean, "Remove retired key above the limit").AddParam(checksum, retired[0].checksum)}, true
+	}
+
+	if active.Age(now) < rotation.GetInterval() {
+		return nil, true
+	}
+
+	if keys >= rotation.GetMaxKeys() {
+		if len(retired) == 0 {
+			r.planLogger.Warn("JWT keys limit reached, no rotation will take place")
+			return nil, true
+		}
+
+		return api.Plan{actions.NewClusterAction(api.ActionTypeJWTClean, "Remove retired key to make space for the new key").AddParam(checksum, retired[0].checksum)}, true
+	}
+
+	return api.Plan{actions.NewClusterAction(api.ActionTypeJWTGenerate, "Generate new key")}, true
+}
+
+// updateJWTKeysLifecycle returns the lifecycle of the keys from the JWT folder.
+// Returns true if it differs from the current lifecycle.
+func updateJWTKeysLifecycle(data map[string][]byte, current api.DeploymentStatusHashesJWTKeys, now time.Time) (api.DeploymentStatusHashesJWTKeys, bool) {
+	var activeSha string
+	if activeKeyData, ok := data[utilConstants.ActiveJWTKey]; ok {
+		activeSha = util.TrimSpaceSHA256(activeKeyData)
+	}
+
+	changed := false
+	keys := api.DeploymentStatusHashesJWTKeys{}
+
+	for key := range data {
+		if key == utilConstants.ActiveJWTKey || key == utilConstants.SecretKeyToken {
+			continue
+		}
+
+		hash := jwtKeyHash(key)
+
+		k, ok := current.Get(hash)
+		if !ok {
+			changed = true
+			k = api.DeploymentStatusHashesJWTKey{
+				Created: meta.NewTime(now),
+			}
+
+			if key != activeSha && len(current) == 0 {
+				// History of the keys is unknown, passive keys are considered as retired
+				k.Retired = util.NewType(meta.NewTime(now))
+			}
+		}
+
+		if key == activeSha {
+			if k.Activated == nil {
+				changed = true
+				k.Activated = util.NewType(meta.NewTime(now))
+			}
+			if k.Retired != nil {
+				changed = true
+				k.Retired = nil
+			}
+		} else if k.Activated != nil && k.Retired == nil {
+			changed = true
+			k.Retired = util.NewType(meta.NewTime(now))
+		}
+
+		keys[hash] = k
+	}
+
+	if len(keys) != len(current) {
+		changed = true
+	}
+
+	if len(keys) == 0 {
+		return nil, changed
+	}
+
+	return keys, changed
+}
+
+func jwtKeyHash(key string) string {
+	return fmt.Sprintf("sha256:%s", key)
+}
+
 func (r *Reconciler) createJWTStatusUpdate(ctx context.Context, apiObject k8sutil.APIObject,
 	spec api.DeploymentSpec, status api.DeploymentStatus,
 	context PlanBuilderContext) api.Plan {
@@ -174,6 +339,10 @@ func (r *Reconciler) createJWTStatusUpdateRequired(apiObject k8sutil.APIObject,
 		}
 	}
 
+	if _, changed := updateJWTKeysLifecycle(f.Data, status.Hashes.JWT.Keys, time.Now()); changed {
+		return true
+	}
+
 	if len(f.Data) == 0 {
 		return status.Hashes.JWT.Passive != nil
 	}
@@ -0,0 +1,259 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	utilConstants "github.com/arangodb/kube-arangodb/pkg/util/constants"
+)
+
+type jwtRotationTest struct {
+	t *testing.T
+
+	spec   api.DeploymentSpec
+	status api.DeploymentStatus
+	folder *core.Secret
+
+	now time.Time
+}
+
+func newJWTRotationTest(t *testing.T, rotation api.AuthenticationRotationSpec, keys ...string) *jwtRotationTest {
+	j := &jwtRotationTest{
+		t: t,
+		spec: api.DeploymentSpec{
+			Authentication: api.AuthenticationSpec{
+				JWTSecretName: util.NewType("jwt"),
+				Rotation:      &rotation,
+			},
+		},
+		folder: &core.Secret{
+			Data: map[string][]byte{},
+		},
+		now: time.Now(),
+	}
+
+	for _, k := range keys {
+		j.folder.Data[util.TrimSpaceSHA256([]byte(k))] = []byte(k)
+	}
+
+	j.setActive(keys[0])
+
+	return j
+}
+
+func (j *jwtRotationTest) setActive(key string) {
+	j.folder.Data[utilConstants.ActiveJWTKey] = []byte(key)
+	j.folder.Data[utilConstants.SecretKeyToken] = []byte(key)
+}
+
+func (j *jwtRotationTest) add(key string) string {
+	sha := util.TrimSpaceSHA256([]byte(key))
+	j.folder.Data[sha] = []byte(key)
+	return sha
+}
+
+func (j *jwtRotationTest) activeSha() string {
+	return util.TrimSpaceSHA256(j.folder.Data[utilConstants.ActiveJWTKey])
+}
+
+func (j *jwtRotationTest) syncStatus() {
+	keys, _ := updateJWTKeysLifecycle(j.folder.Data, j.status.Hashes.JWT.Keys, j.now)
+	j.status.Hashes.JWT.Keys = keys
+}
+
+func (j *jwtRotationTest) plan() (api.Plan, bool) {
+	return newTestReconciler().createJWTRotationPlan(j.spec, j.status, j.folder, j.activeSha(), j.now)
+}
+
+func (j *jwtRotationTest) expect(action api.ActionType, key string) {
+	plan, ok := j.plan()
+	require.True(j.t, ok)
+	require.Len(j.t, plan, 1)
+	require.Equal(j.t, action, plan[0].Type)
+	if key != "" {
+		require.Equal(j.t, key, plan[0].Params[checksum])
+	}
+}
+
+func (j *jwtRotationTest) expectEmpty() {
+	plan, ok := j.plan()
+	require.True(j.t, ok)
+	require.Empty(j.t, plan)
+}
+
+func Test_JWTKeysLifecycle(t *testing.T) {
+	now := time.Now()
+
+	a, b := util.TrimSpaceSHA256([]byte("a")), util.TrimSpaceSHA256([]byte("b"))
+
+	data := map[string][]byte{
+		a:                            []byte("a"),
+		b:                            []byte("b"),
+		utilConstants.ActiveJWTKey:   []byte("a"),
+		utilConstants.SecretKeyToken: []byte("a"),
+	}
+
+	t.Run("Unknown history", func(t *testing.T) {
+		keys, changed := updateJWTKeysLifecycle(data, nil, now)
+		require.True(t, changed)
+		require.Len(t, keys, 2)
+
+		require.True(t, keys[jwtKeyHash(a)].IsActivated())
+		require.False(t, keys[jwtKeyHash(a)].IsRetired())
+		require.False(t, keys[jwtKeyHash(b)].IsActivated())
+		require.True(t, keys[jwtKeyHash(b)].IsRetired())
+
+		_, changed = updateJWTKeysLifecycle(data, keys, now.Add(time.Hour))
+		require.False(t, changed)
+	})
+
+	t.Run("Rotation", func(t *testing.T) {
+		keys, changed := updateJWTKeysLifecycle(map[string][]byte{
+			a:                            []byte("a"),
+			utilConstants.ActiveJWTKey:   []byte("a"),
+			utilConstants.SecretKeyToken: []byte("a"),
+		}, nil, now)
+		require.True(t, changed)
+		require.Len(t, keys, 1)
+
+		keys, changed = updateJWTKeysLifecycle(data, keys, now.Add(time.Hour))
+		require.True(t, changed)
+		require.Len(t, keys, 2)
+		require.False(t, keys[jwtKeyHash(b)].IsActivated())
+		require.False(t, keys[jwtKeyHash(b)].IsRetired())
+		require.Equal(t, time.Hour, keys[jwtKeyHash(b)].Age(now.Add(2*time.Hour)))
+
+		keys, changed = updateJWTKeysLifecycle(map[string][]byte{
+			a:                            []byte("a"),
+			b:                            []byte("b"),
+			utilConstants.ActiveJWTKey:   []byte("b"),
+			utilConstants.SecretKeyToken: []byte("b"),
+		}, keys, now.Add(2*time.Hour))
+		require.True(t, changed)
+		require.True(t, keys[jwtKeyHash(a)].IsRetired())
+		require.Equal(t, now.Add(2*time.Hour).Unix(), keys[jwtKeyHash(a)].Retired.Unix())
+		require.True(t, keys[jwtKeyHash(b)].IsActivated())
+
+		keys, changed = updateJWTKeysLifecycle(map[string][]byte{
+			b:                            []byte("b"),
+			utilConstants.ActiveJWTKey:   []byte("b"),
+			utilConstants.SecretKeyToken: []byte("b"),
+		}, keys, now.Add(3*time.Hour))
+		require.True(t, changed)
+		require.Len(t, keys, 1)
+	})
+}
+
+func Test_JWTRotationPlan(t *testing.T) {
+	j := newJWTRotationTest(t, api.AuthenticationRotationSpec{
+		Interval: &meta.Duration{Duration: 90 * 24 * time.Hour},
+		Overlap:  &meta.Duration{Duration: 24 * time.Hour},
+		MaxKeys:  util.NewType(3),
+	}, "initial")
+
+	// Status is not in sync
+	_, ok := j.plan()
+	require.False(t, ok)
+
+	j.syncStatus()
+	j.expectEmpty()
+
+	// Interval passed
+	j.now = j.now.Add(90 * 24 * time.Hour)
+	j.expect(api.ActionTypeJWTGenerate, "")
+
+	// Generated key is promoted once propagated
+	second := j.add("second")
+	j.syncStatus()
+	j.expect(api.ActionTypeJWTPromote, second)
+
+	// Key is activated by the JWTSetActive, old key is kept for the overlap period
+	j.setActive("second")
+	j.syncStatus()
+	j.expectEmpty()
+
+	j.now = j.now.Add(23 * time.Hour)
+	j.expectEmpty()
+
+	j.now = j.now.Add(time.Hour)
+	j.expect(api.ActionTypeJWTClean, util.TrimSpaceSHA256([]byte("initial")))
+}
+
+func Test_JWTRotationPlan_MaxKeys(t *testing.T) {
+	j := newJWTRotationTest(t, api.AuthenticationRotationSpec{
+		Interval: &meta.Duration{Duration: 24 * time.Hour},
+		Overlap:  &meta.Duration{Duration: 12 * time.Hour},
+		MaxKeys:  util.NewType(2),
+	}, "initial")
+	j.syncStatus()
+
+	// Keys rotated manually within the overlap period
+	for _, key := range []string{"second", "third"} {
+		j.now = j.now.Add(time.Hour)
+		j.add(key)
+		j.syncStatus()
+		j.setActive(key)
+		j.syncStatus()
+	}
+
+	// Oldest retired key is removed above the limit
+	j.expect(api.ActionTypeJWTClean, util.TrimSpaceSHA256([]byte("initial")))
+
+	delete(j.folder.Data, util.TrimSpaceSHA256([]byte("initial")))
+	j.syncStatus()
+	j.expectEmpty()
+
+	// Retired key is removed to make space for the new key
+	j.status.Hashes.JWT.Keys[jwtKeyHash(j.activeSha())] = api.DeploymentStatusHashesJWTKey{
+		Created:   meta.NewTime(j.now.Add(-24 * time.Hour)),
+		Activated: util.NewType(meta.NewTime(j.now)),
+	}
+	j.expect(api.ActionTypeJWTClean, util.TrimSpaceSHA256([]byte("second")))
+}
+
+func Test_JWTRotationPlan_PendingKeys(t *testing.T) {
+	j := newJWTRotationTest(t, api.AuthenticationRotationSpec{
+		Interval: &meta.Duration{Duration: 24 * time.Hour},
+	}, "initial")
+	j.syncStatus()
+
+	first := j.add("first")
+	j.syncStatus()
+
+	j.now = j.now.Add(time.Minute)
+	second := j.add("second")
+	j.syncStatus()
+
+	// Only newest pending key is kept
+	j.expect(api.ActionTypeJWTClean, first)
+
+	delete(j.folder.Data, first)
+	j.syncStatus()
+	j.expect(api.ActionTypeJWTPromote, second)
+}
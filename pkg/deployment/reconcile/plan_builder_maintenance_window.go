@@ -0,0 +1,203 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/actions"
+	sharedReconcile "github.com/arangodb/kube-arangodb/pkg/deployment/reconcile/shared"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+const (
+	// maintenanceWindowHoldParam marks the action which keeps the held disruptive action in the plan builder result.
+	// It stops the plan builder chain and it is removed from the plan before it is saved.
+	maintenanceWindowHoldParam = "maintenanceWindowHold"
+	// maintenanceWindowReleaseParam marks the action which releases the hold condition.
+	// It is removed from the plan if any disruptive action has been held during the plan generation.
+	maintenanceWindowReleaseParam = "maintenanceWindowRelease"
+	// maintenanceWindowHeldParam marks the held disruptive action reported in the dry-run mode
+	maintenanceWindowHeldParam = "maintenanceWindowHeld"
+)
+
+// withMaintenanceWindow wraps the plan builder. Plans with disruptive actions are held outside of the maintenance windows.
+// Builders responsible for emergency actions (like failed member recovery) should not be wrapped.
+func (r *Reconciler) withMaintenanceWindow(pb planBuilder) planBuilder {
+	return func(ctx context.Context, apiObject k8sutil.APIObject,
+		spec api.DeploymentSpec, status api.DeploymentStatus,
+		context PlanBuilderContext) api.Plan {
+		plan := pb(ctx, apiObject, spec, status, context)
+
+		held, ok := maintenanceWindowHoldPlan(spec, status, plan, time.Now())
+		if !ok {
+			return plan
+		}
+
+		if r.dryRun {
+			// Held actions are reported in the dry-run mode, hold condition is not changed
+			return maintenanceWindowHeldPlan(plan)
+		}
+
+		if action, ok := plan.Disruptive(); ok {
+			r.planLogger.
+				Str("action", string(action.Type)).
+				Str("member", action.MemberID).
+				Debug("Disruptive action held outside of the maintenance window")
+		}
+
+		return held
+	}
+}
+
+// withMaintenanceWindowSubPlan wraps the sub plan builder in the same way as withMaintenanceWindow
+func (r *Reconciler) withMaintenanceWindowSubPlan(pb planBuilderSubPlan) planBuilderSubPlan {
+	return func(ctx context.Context, apiObject k8sutil.APIObject,
+		spec api.DeploymentSpec, status api.DeploymentStatus,
+		builderCtx PlanBuilderContext, w WithPlanBuilder, plans ...planBuilder) api.Plan {
+		return r.withMaintenanceWindow(func(ctx context.Context, apiObject k8sutil.APIObject,
+			spec api.DeploymentSpec, status api.DeploymentStatus,
+			builderCtx PlanBuilderContext) api.Plan {
+			return pb(ctx, apiObject, spec, status, builderCtx, w, plans...)
+		})(ctx, apiObject, spec, status, builderCtx)
+	}
+}
+
+// maintenanceWindowHoldPlan returns the plan which should be used instead of the given one, if it needs to be held.
+// Returned plan is never empty, it ends with the hold action, so the plan builder chain stops.
+func maintenanceWindowHoldPlan(spec api.DeploymentSpec, status api.DeploymentStatus, plan api.Plan, now time.Time) (api.Plan, bool) {
+	action, ok := plan.Disruptive()
+	if !ok {
+		return nil, false
+	}
+
+	windows := spec.MaintenanceWindows
+
+	if windows.Contains(now) {
+		return nil, false
+	}
+
+	message := fmt.Sprintf("Action %s", action.Type)
+	if action.MemberID != "" {
+		message = fmt.Sprintf("%s on member %s (%s)", message, action.MemberID, action.Group.AsRole())
+	}
+	if next, ok := windows.Next(now); ok {
+		message = fmt.Sprintf("%s is held until the maintenance window opens at %s", message, next.Format(time.RFC3339))
+	} else {
+		message = fmt.Sprintf("%s is held until the maintenance window opens", message)
+	}
+
+	hold := actions.NewAction(api.ActionTypeIdle, action.Group, api.MemberStatus{ID: action.MemberID}, message).
+		AddParam(maintenanceWindowHoldParam, string(action.Type))
+
+	hash := util.SHA256FromString(message)
+
+	if c, ok := status.Conditions.Get(api.ConditionTypeMaintenanceWindowHold); ok && c.IsTrue() && c.Hash == hash {
+		// Condition is up to date
+		return api.Plan{hold}, true
+	}
+
+	return api.Plan{
+		sharedReconcile.UpdateConditionActionV2("Disruptive action held", api.ConditionTypeMaintenanceWindowHold, true, "Outside of the maintenance window", message, hash),
+		hold,
+	}, true
+}
+
+// maintenanceWindowHeldPlan returns the copy of the plan with all actions marked as held
+func maintenanceWindowHeldPlan(plan api.Plan) api.Plan {
+	r := make(api.Plan, len(plan))
+
+	for id, a := range plan {
+		r[id] = a.AddParam(maintenanceWindowHeldParam, "true")
+	}
+
+	return r
+}
+
+// isMaintenanceWindowHoldAction returns true if the action keeps the held disruptive action
+func isMaintenanceWindowHoldAction(a api.Action) bool {
+	_, ok := a.GetParam(maintenanceWindowHoldParam)
+	return ok
+}
+
+// filterMaintenanceWindowHold removes the hold actions from the plan and returns true if any action has been held.
+// If the action has been held (in this or in previously generated plan), the hold release is removed as well.
+func filterMaintenanceWindowHold(plan api.Plan, held bool) (api.Plan, bool) {
+	for _, a := range plan {
+		if isMaintenanceWindowHoldAction(a) || IsPlanDryRunActionHeld(a) {
+			held = true
+		}
+	}
+
+	return plan.Filter(func(a api.Action) bool {
+		if isMaintenanceWindowHoldAction(a) {
+			return false
+		}
+
+		if _, ok := a.GetParam(maintenanceWindowReleaseParam); ok && held {
+			return false
+		}
+
+		return true
+	}), held
+}
+
+// createMaintenanceWindowConditionPlan removes the hold condition once the maintenance window opens
+func (r *Reconciler) createMaintenanceWindowConditionPlan(ctx context.Context, apiObject k8sutil.APIObject,
+	spec api.DeploymentSpec, status api.DeploymentStatus,
+	context PlanBuilderContext) api.Plan {
+	if !status.Conditions.IsTrue(api.ConditionTypeMaintenanceWindowHold) {
+		return nil
+	}
+
+	if !spec.MaintenanceWindows.Contains(time.Now()) {
+		return nil
+	}
+
+	return api.Plan{
+		sharedReconcile.RemoveConditionActionV2("Maintenance window opened", api.ConditionTypeMaintenanceWindowHold),
+	}
+}
+
+// createMaintenanceWindowHoldReleasePlan removes the hold condition once no disruptive action is pending.
+// It is evaluated at the end of the normal plan, so it is reached only if no wrapped builder has been held.
+// Actions held in the high priority plan are dropped from the result by filterMaintenanceWindowHold.
+func (r *Reconciler) createMaintenanceWindowHoldReleasePlan(ctx context.Context, apiObject k8sutil.APIObject,
+	spec api.DeploymentSpec, status api.DeploymentStatus,
+	context PlanBuilderContext) api.Plan {
+	if !status.Conditions.IsTrue(api.ConditionTypeMaintenanceWindowHold) {
+		return nil
+	}
+
+	if !status.HighPriorityPlan.IsEmpty() {
+		// Held actions from the high priority plan might not be evaluated yet
+		return nil
+	}
+
+	return api.Plan{
+		sharedReconcile.RemoveConditionActionV2("No disruptive action pending", api.ConditionTypeMaintenanceWindowHold).
+			AddParam(maintenanceWindowReleaseParam, "true"),
+	}
+}
@@ -0,0 +1,180 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/actions"
+	sharedReconcile "github.com/arangodb/kube-arangodb/pkg/deployment/reconcile/shared"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+func Test_MaintenanceWindowHoldPlan(t *testing.T) {
+	// 2026-01-05 is Monday
+	inWindow := time.Date(2026, 1, 5, 1, 30, 0, 0, time.UTC)
+	outOfWindow := time.Date(2026, 1, 5, 12, 0, 0, 0, time.UTC)
+
+	spec := api.DeploymentSpec{
+		MaintenanceWindows: &api.DeploymentSpecMaintenanceWindows{
+			Windows: []api.DeploymentSpecMaintenanceWindow{
+				{From: "01:00", To: "03:00"},
+			},
+		},
+	}
+
+	rotation := api.Plan{
+		actions.NewAction(api.ActionTypeRotateStartMember, api.ServerGroupDBServers, api.MemberStatus{ID: "prmr"}, "Rotate"),
+		actions.NewAction(api.ActionTypeRotateStopMember, api.ServerGroupDBServers, api.MemberStatus{ID: "prmr"}, "Rotate"),
+	}
+
+	t.Run("Non disruptive plan", func(t *testing.T) {
+		_, held := maintenanceWindowHoldPlan(spec, api.DeploymentStatus{}, api.Plan{
+			actions.NewAction(api.ActionTypeAddMember, api.ServerGroupDBServers, api.MemberStatus{ID: "prmr"}, "Add"),
+		}, outOfWindow)
+		require.False(t, held)
+	})
+
+	t.Run("Windows not defined", func(t *testing.T) {
+		_, held := maintenanceWindowHoldPlan(api.DeploymentSpec{}, api.DeploymentStatus{}, rotation, outOfWindow)
+		require.False(t, held)
+	})
+
+	t.Run("In window", func(t *testing.T) {
+		_, held := maintenanceWindowHoldPlan(spec, api.DeploymentStatus{}, rotation, inWindow)
+		require.False(t, held)
+	})
+
+	t.Run("Out of window", func(t *testing.T) {
+		plan, held := maintenanceWindowHoldPlan(spec, api.DeploymentStatus{}, rotation, outOfWindow)
+		require.True(t, held)
+		require.Len(t, plan, 2)
+		require.Equal(t, api.ActionTypeSetConditionV2, plan[0].Type)
+		require.True(t, isMaintenanceWindowHoldAction(plan[1]))
+		require.Equal(t, "prmr", plan[1].MemberID)
+
+		var status api.DeploymentStatus
+		status.Conditions.UpdateWithHash(api.ConditionTypeMaintenanceWindowHold, true, "", "", plan[0].Params[sharedReconcile.SetConditionActionV2KeyHash])
+
+		plan, held = maintenanceWindowHoldPlan(spec, status, rotation, outOfWindow)
+		require.True(t, held)
+		require.Len(t, plan, 1)
+		require.True(t, isMaintenanceWindowHoldAction(plan[0]))
+	})
+
+	t.Run("Hold stops the builder chain", func(t *testing.T) {
+		r := newTestReconciler()
+
+		now := time.Now().UTC()
+		spec := api.DeploymentSpec{
+			MaintenanceWindows: &api.DeploymentSpecMaintenanceWindows{
+				Windows: []api.DeploymentSpecMaintenanceWindow{
+					{From: now.Add(2 * time.Hour).Format("15:04"), To: now.Add(3 * time.Hour).Format("15:04")},
+				},
+			},
+		}
+
+		var called bool
+		next := func(ctx context.Context, apiObject k8sutil.APIObject, spec api.DeploymentSpec, status api.DeploymentStatus, context PlanBuilderContext) api.Plan {
+			called = true
+			return api.Plan{actions.NewClusterAction(api.ActionTypeIdle)}
+		}
+		held := r.withMaintenanceWindow(func(ctx context.Context, apiObject k8sutil.APIObject, spec api.DeploymentSpec, status api.DeploymentStatus, context PlanBuilderContext) api.Plan {
+			return rotation
+		})
+
+		plan := newPlanAppender(NewWithPlanBuilder(context.Background(), nil, spec, api.DeploymentStatus{}, nil), nil, nil).
+			ApplyIfEmpty(held).
+			ApplyIfEmpty(next).
+			Plan()
+		require.False(t, called)
+
+		plan, isHeld := filterMaintenanceWindowHold(plan, false)
+		require.True(t, isHeld)
+		require.Len(t, plan, 1)
+		require.Equal(t, api.ActionTypeSetConditionV2, plan[0].Type)
+	})
+
+	t.Run("Dry run reports held actions", func(t *testing.T) {
+		r := newTestReconciler()
+		r.dryRun = true
+
+		now := time.Now().UTC()
+		spec := api.DeploymentSpec{
+			MaintenanceWindows: &api.DeploymentSpecMaintenanceWindows{
+				Windows: []api.DeploymentSpecMaintenanceWindow{
+					{From: now.Add(2 * time.Hour).Format("15:04"), To: now.Add(3 * time.Hour).Format("15:04")},
+				},
+			},
+		}
+
+		plan := r.withMaintenanceWindow(func(ctx context.Context, apiObject k8sutil.APIObject, spec api.DeploymentSpec, status api.DeploymentStatus, context PlanBuilderContext) api.Plan {
+			return rotation
+		})(context.Background(), nil, spec, api.DeploymentStatus{}, nil)
+
+		plan, isHeld := filterMaintenanceWindowHold(plan, false)
+		require.True(t, isHeld)
+		require.Len(t, plan, 2)
+		for id := range plan {
+			require.Equal(t, rotation[id].Type, plan[id].Type)
+			require.True(t, IsPlanDryRunActionHeld(plan[id]))
+		}
+	})
+}
+
+func Test_MaintenanceWindowHoldReleasePlan(t *testing.T) {
+	r := newTestReconciler()
+	release := func(status api.DeploymentStatus) api.Plan {
+		return r.createMaintenanceWindowHoldReleasePlan(context.Background(), nil, api.DeploymentSpec{}, status, nil)
+	}
+
+	var status api.DeploymentStatus
+
+	require.Empty(t, release(status))
+
+	status.Conditions.Update(api.ConditionTypeMaintenanceWindowHold, true, "Outside of the maintenance window", "")
+
+	t.Run("Action held", func(t *testing.T) {
+		plan, held := filterMaintenanceWindowHold(release(status), true)
+		require.True(t, held)
+		require.Empty(t, plan)
+	})
+
+	t.Run("High plan pending", func(t *testing.T) {
+		s := *status.DeepCopy()
+		s.HighPriorityPlan = api.Plan{
+			actions.NewAction(api.ActionTypeRotateStartMember, api.ServerGroupDBServers, api.MemberStatus{ID: "prmr"}, "Rotate"),
+		}
+		require.Empty(t, release(s))
+	})
+
+	t.Run("Nothing pending", func(t *testing.T) {
+		plan, held := filterMaintenanceWindowHold(release(status), false)
+		require.False(t, held)
+		require.Len(t, plan, 1)
+		require.Equal(t, api.ActionTypeSetConditionV2, plan[0].Type)
+	})
+}
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2025 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -93,6 +93,23 @@ func (r *Reconciler) createMemberFailedRestoreInternal(_ context.Context, _ k8su
 					continue
 				}
 
+				if spec.Recovery.Get().DBServerFailure.IsEnabled() {
+					failedOver, reason := agencyState.IsServerFailedOver(state.Server(m.ID))
+					if failedOver {
+						// Agency supervision moved all shards out of the failed DBServer.
+						memberLog.Info("Creating shard-aware DBServer replacement plan because member has failed")
+						plan = append(plan,
+							actions.NewAction(api.ActionTypeRemoveMember, group, m),
+							actions.NewAction(api.ActionTypeAddMember, group, sharedReconcile.WithPredefinedMember("")),
+							actions.NewAction(api.ActionTypeWaitForMemberUp, group, sharedReconcile.WithPredefinedMember(api.MemberIDPreviousAction)),
+						)
+
+						continue
+					}
+
+					memberLog.Str("reason", reason).Debug("DBServer is not failed over by the agency supervision")
+				}
+
 				if agencyState.Plan.Collections.IsDBServerLeader(state.Server(m.ID)) {
 					memberLog.Info("Recreating leader DBServer - it cannot be removed gracefully")
 					plan = append(plan, actions.NewAction(api.ActionTypeRecreateMember, group, m))
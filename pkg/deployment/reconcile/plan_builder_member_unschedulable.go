@@ -0,0 +1,133 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency/state"
+	sharedReconcile "github.com/arangodb/kube-arangodb/pkg/deployment/reconcile/shared"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+// updateMemberUnschedulableConditionPlan creates plan to update MemberUnschedulable condition of the members
+// which pods are not scheduled longer than the recovery timeout
+func (r *Reconciler) updateMemberUnschedulableConditionPlan(ctx context.Context, apiObject k8sutil.APIObject,
+	spec api.DeploymentSpec, status api.DeploymentStatus,
+	context PlanBuilderContext) api.Plan {
+	var plan api.Plan
+
+	policy := spec.Recovery.Get().UnschedulableMember
+
+	for _, e := range status.Members.AsList() {
+		var message, hash string
+		if policy.IsEnabled() {
+			message, hash = r.memberUnschedulableMessage(context, spec, policy, e)
+		}
+
+		c, exists := e.Member.Conditions.Get(api.ConditionTypeMemberUnschedulable)
+
+		if message == "" {
+			if exists {
+				plan = append(plan, sharedReconcile.RemoveMemberConditionActionV2("Member Scheduled", api.ConditionTypeMemberUnschedulable, e.Group, e.Member.ID))
+			}
+			continue
+		}
+
+		// Hash does not include the scheduler message, so the condition is updated only when the state of the member changes
+		if exists && c.IsTrue() && c.Hash == hash {
+			continue
+		}
+
+		context.CreateEvent(k8sutil.NewMemberUnschedulableEvent(apiObject, e.Member.ID, e.Group.AsRole(), message))
+
+		plan = append(plan, sharedReconcile.UpdateMemberConditionActionV2("Member Unschedulable", api.ConditionTypeMemberUnschedulable, e.Group, e.Member.ID, true,
+			"Member Unschedulable", message, hash))
+	}
+
+	return plan
+}
+
+// memberUnschedulableMessage returns the explanation why the member is unschedulable and what is going to happen next,
+// together with the hash of the member state, which does not change with the scheduler message.
+// Empty message is returned when the member is not unschedulable.
+func (r *Reconciler) memberUnschedulableMessage(context PlanBuilderContext, spec api.DeploymentSpec, policy *api.ArangoDeploymentRecoveryUnschedulableMemberSpec,
+	member api.DeploymentStatusMemberElement) (string, string) {
+	if member.Member.Phase != api.MemberPhaseCreated || member.Member.Pod.GetName() == "" {
+		return "", ""
+	}
+
+	cache, ok := context.ACS().ClusterCache(member.Member.ClusterID)
+	if !ok {
+		return "", ""
+	}
+
+	pod, ok := cache.Pod().V1().GetSimple(member.Member.Pod.GetName())
+	if !ok || k8sutil.IsPodMarkedForDeletion(pod) || !k8sutil.IsPodNotScheduledFor(pod, policy.GetTimeout()) {
+		return "", ""
+	}
+
+	message := fmt.Sprintf("Pod is not scheduled for more than %s", policy.GetTimeout())
+
+	var reason string
+	if m := k8sutil.GetPodNotScheduledMessage(pod); m != "" {
+		reason = fmt.Sprintf(": %s", m)
+	}
+
+	state := memberUnschedulableVolumeState(context, spec, policy, member)
+
+	return message + reason + state, util.SHA256FromString(message + state)
+}
+
+// memberUnschedulableVolumeState returns the description of the local volume release state
+func memberUnschedulableVolumeState(context PlanBuilderContext, spec api.DeploymentSpec, policy *api.ArangoDeploymentRecoveryUnschedulableMemberSpec,
+	member api.DeploymentStatusMemberElement) string {
+	if !member.Member.Conditions.IsTrue(api.ConditionTypeMemberVolumeUnschedulable) {
+		return ""
+	}
+
+	if !policy.IsReleaseLocalVolumeEnabled() {
+		return ". Local volume is bound to the missing node, release of the volume is disabled"
+	}
+
+	if !isLocalVolumeReleaseSupported(spec, member.Group) {
+		return ". Local volume is bound to the missing node, release of the volume is supported only for DBServers in the Cluster mode"
+	}
+
+	agencyCache, ok := context.GetAgencyCache()
+	if !ok {
+		return ". Local volume is bound to the missing node, release of the volume is postponed until the agency is available"
+	}
+
+	if agencyCache.PlanLeaderServers().Contains(state.Server(member.Member.ID)) {
+		return ". Local volume is bound to the missing node, release of the volume is postponed until the shard leadership is moved to other DBServers"
+	}
+
+	return ". Local volume is bound to the missing node, volume is going to be released and data resynchronized from the replicas"
+}
+
+// isLocalVolumeReleaseSupported returns true if the data of the member can be resynchronized from the replicas
+func isLocalVolumeReleaseSupported(spec api.DeploymentSpec, group api.ServerGroup) bool {
+	return spec.GetMode() == api.DeploymentModeCluster && group == api.ServerGroupDBServers
+}
@@ -0,0 +1,326 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency/state"
+	sharedReconcile "github.com/arangodb/kube-arangodb/pkg/deployment/reconcile/shared"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/kclient"
+	"github.com/arangodb/kube-arangodb/pkg/util/tests"
+)
+
+func Test_UpdateMemberUnschedulableConditionPlan(t *testing.T) {
+	type testCase struct {
+		policy *api.ArangoDeploymentRecoveryUnschedulableMemberSpec
+
+		notScheduledSince   time.Duration
+		volumeUnschedulable bool
+		conditionPresent    bool
+		conditionMessage    string
+		leader              bool
+
+		mode  api.DeploymentMode
+		group api.ServerGroup
+
+		action  api.ActionType
+		message string
+	}
+
+	testCases := map[string]testCase{
+		"Policy disabled": {
+			notScheduledSince: time.Hour,
+		},
+		"Policy disabled - condition cleanup": {
+			notScheduledSince: time.Hour,
+			conditionPresent:  true,
+
+			action: api.ActionTypeSetMemberConditionV2,
+		},
+		"Pod in grace period": {
+			policy: &api.ArangoDeploymentRecoveryUnschedulableMemberSpec{
+				Enabled: util.NewType(true),
+			},
+			notScheduledSince: time.Minute,
+		},
+		"Pod not scheduled": {
+			policy: &api.ArangoDeploymentRecoveryUnschedulableMemberSpec{
+				Enabled: util.NewType(true),
+			},
+			notScheduledSince: time.Hour,
+
+			action:  api.ActionTypeSetMemberConditionV2,
+			message: "Pod is not scheduled for more than 10m0s: 0/3 nodes are available",
+		},
+		"Pod not scheduled - custom timeout": {
+			policy: &api.ArangoDeploymentRecoveryUnschedulableMemberSpec{
+				Enabled: util.NewType(true),
+				Timeout: &meta.Duration{Duration: 2 * time.Hour},
+			},
+			notScheduledSince: time.Hour,
+		},
+		"Local volume - release disabled": {
+			policy: &api.ArangoDeploymentRecoveryUnschedulableMemberSpec{
+				Enabled: util.NewType(true),
+			},
+			notScheduledSince:   time.Hour,
+			volumeUnschedulable: true,
+
+			action:  api.ActionTypeSetMemberConditionV2,
+			message: "Pod is not scheduled for more than 10m0s: 0/3 nodes are available. Local volume is bound to the missing node, release of the volume is disabled",
+		},
+		"Local volume - leader": {
+			policy: &api.ArangoDeploymentRecoveryUnschedulableMemberSpec{
+				Enabled:            util.NewType(true),
+				ReleaseLocalVolume: util.NewType(true),
+			},
+			notScheduledSince:   time.Hour,
+			volumeUnschedulable: true,
+			leader:              true,
+
+			action:  api.ActionTypeSetMemberConditionV2,
+			message: "Pod is not scheduled for more than 10m0s: 0/3 nodes are available. Local volume is bound to the missing node, release of the volume is postponed until the shard leadership is moved to other DBServers",
+		},
+		"Local volume - release": {
+			policy: &api.ArangoDeploymentRecoveryUnschedulableMemberSpec{
+				Enabled:            util.NewType(true),
+				ReleaseLocalVolume: util.NewType(true),
+			},
+			notScheduledSince:   time.Hour,
+			volumeUnschedulable: true,
+
+			action:  api.ActionTypeSetMemberConditionV2,
+			message: "Pod is not scheduled for more than 10m0s: 0/3 nodes are available. Local volume is bound to the missing node, volume is going to be released and data resynchronized from the replicas",
+		},
+		"Local volume - single": {
+			policy: &api.ArangoDeploymentRecoveryUnschedulableMemberSpec{
+				Enabled:            util.NewType(true),
+				ReleaseLocalVolume: util.NewType(true),
+			},
+			notScheduledSince:   time.Hour,
+			volumeUnschedulable: true,
+			mode:                api.DeploymentModeSingle,
+			group:               api.ServerGroupSingle,
+
+			action:  api.ActionTypeSetMemberConditionV2,
+			message: "Pod is not scheduled for more than 10m0s: 0/3 nodes are available. Local volume is bound to the missing node, release of the volume is supported only for DBServers in the Cluster mode",
+		},
+		"Local volume - agent": {
+			policy: &api.ArangoDeploymentRecoveryUnschedulableMemberSpec{
+				Enabled:            util.NewType(true),
+				ReleaseLocalVolume: util.NewType(true),
+			},
+			notScheduledSince:   time.Hour,
+			volumeUnschedulable: true,
+			group:               api.ServerGroupAgents,
+
+			action:  api.ActionTypeSetMemberConditionV2,
+			message: "Pod is not scheduled for more than 10m0s: 0/3 nodes are available. Local volume is bound to the missing node, release of the volume is supported only for DBServers in the Cluster mode",
+		},
+		"Condition up to date - scheduler message changed": {
+			policy: &api.ArangoDeploymentRecoveryUnschedulableMemberSpec{
+				Enabled: util.NewType(true),
+			},
+			notScheduledSince: time.Hour,
+			conditionPresent:  true,
+			conditionMessage:  "Pod is not scheduled for more than 10m0s",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := kclient.NewFakeClient()
+
+			_, err := client.Kubernetes().CoreV1().Pods(tests.FakeNamespace).Create(context.Background(), &core.Pod{
+				ObjectMeta: meta.ObjectMeta{
+					Name:      "dbserver",
+					Namespace: tests.FakeNamespace,
+				},
+				Status: core.PodStatus{
+					Phase: core.PodPending,
+					Conditions: []core.PodCondition{
+						{
+							Type:               core.PodScheduled,
+							Status:             core.ConditionFalse,
+							LastTransitionTime: meta.NewTime(time.Now().Add(-tc.notScheduledSince)),
+							Message:            "0/3 nodes are available",
+						},
+					},
+				},
+			}, meta.CreateOptions{})
+			require.NoError(t, err)
+
+			member := api.MemberStatus{
+				ID:    "id",
+				Phase: api.MemberPhaseCreated,
+				Pod: &api.MemberPodStatus{
+					Name: "dbserver",
+				},
+			}
+			if tc.volumeUnschedulable {
+				member.Conditions.Update(api.ConditionTypeMemberVolumeUnschedulable, true, "", "")
+			}
+			if tc.conditionPresent {
+				var hash string
+				if tc.conditionMessage != "" {
+					hash = util.SHA256FromString(tc.conditionMessage)
+				}
+				member.Conditions.UpdateWithHash(api.ConditionTypeMemberUnschedulable, true, "", "", hash)
+			}
+
+			if tc.mode == "" {
+				tc.mode = api.DeploymentModeCluster
+			}
+
+			if tc.group == api.ServerGroupUnknown {
+				tc.group = api.ServerGroupDBServers
+			}
+
+			var shards state.Servers
+			if tc.leader {
+				shards = state.Servers{"id", "follower"}
+			} else {
+				shards = state.Servers{"follower", "id"}
+			}
+
+			c := agencyContext(shards)
+			c.Inspector = tests.NewInspector(t, client)
+			c.ArangoDeployment = &api.ArangoDeployment{}
+
+			var status api.DeploymentStatus
+			require.NoError(t, status.Members.Add(member, tc.group))
+
+			r := newTestReconciler()
+			plan := r.updateMemberUnschedulableConditionPlan(context.Background(), c.ArangoDeployment, api.DeploymentSpec{
+				Mode: util.NewType(tc.mode),
+				Recovery: &api.ArangoDeploymentRecoverySpec{
+					UnschedulableMember: tc.policy,
+				},
+			}, status, c)
+
+			if tc.action == "" {
+				require.Empty(t, plan)
+				return
+			}
+
+			require.Len(t, plan, 1)
+			require.Equal(t, tc.action, plan[0].Type)
+
+			if tc.message == "" {
+				v, _ := plan[0].GetParam(sharedReconcile.SetConditionActionV2KeyType)
+				require.Equal(t, sharedReconcile.SetConditionActionV2KeyTypeRemove, v)
+				return
+			}
+
+			v, _ := plan[0].GetParam(sharedReconcile.SetConditionActionV2KeyMessage)
+			require.Equal(t, tc.message, v)
+			require.NotNil(t, c.RecordedEvent)
+		})
+	}
+}
+
+func Test_VolumeMemberReplacement_Policy(t *testing.T) {
+	type testCase struct {
+		mode  api.DeploymentMode
+		group api.ServerGroup
+
+		release bool
+	}
+
+	testCases := map[string]testCase{
+		"Cluster - DBServer": {
+			mode:  api.DeploymentModeCluster,
+			group: api.ServerGroupDBServers,
+
+			release: true,
+		},
+		"Cluster - Agent": {
+			mode:  api.DeploymentModeCluster,
+			group: api.ServerGroupAgents,
+		},
+		"Single": {
+			mode:  api.DeploymentModeSingle,
+			group: api.ServerGroupSingle,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := kclient.NewFakeClient()
+
+			_, err := client.Kubernetes().CoreV1().PersistentVolumeClaims(tests.FakeNamespace).Create(context.Background(), &core.PersistentVolumeClaim{
+				ObjectMeta: meta.ObjectMeta{
+					Name:      "data",
+					Namespace: tests.FakeNamespace,
+				},
+				Status: core.PersistentVolumeClaimStatus{
+					Phase: core.ClaimBound,
+				},
+			}, meta.CreateOptions{})
+			require.NoError(t, err)
+
+			member := api.MemberStatus{
+				ID:    "id",
+				Phase: api.MemberPhaseCreated,
+				PersistentVolumeClaim: &api.MemberPersistentVolumeClaimStatus{
+					Name: "data",
+				},
+			}
+			member.Conditions.Update(api.ConditionTypeMemberVolumeUnschedulable, true, "", "")
+			member.Conditions.Update(api.ConditionTypeMemberUnschedulable, true, "", "")
+
+			c := agencyContext(state.Servers{"follower", "id"})
+			c.Inspector = tests.NewInspector(t, client)
+			c.ArangoDeployment = &api.ArangoDeployment{}
+
+			var status api.DeploymentStatus
+			require.NoError(t, status.Members.Add(member, tc.group))
+
+			r := newTestReconciler()
+			plan := r.volumeMemberReplacement(context.Background(), c.ArangoDeployment, api.DeploymentSpec{
+				Mode: util.NewType(tc.mode),
+				Recovery: &api.ArangoDeploymentRecoverySpec{
+					UnschedulableMember: &api.ArangoDeploymentRecoveryUnschedulableMemberSpec{
+						Enabled:            util.NewType(true),
+						ReleaseLocalVolume: util.NewType(true),
+					},
+				},
+			}, status, c)
+
+			if !tc.release {
+				require.Empty(t, plan)
+				return
+			}
+
+			require.Len(t, plan, 1)
+			require.Equal(t, api.ActionTypeRemoveMemberPVC, plan[0].Type)
+		})
+	}
+}
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2023 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -50,6 +50,7 @@ func (r *Reconciler) createNormalPlan(ctx context.Context, apiObject k8sutil.API
 		// Check for failed members
 		ApplyIfEmpty(r.createMemberFailedRestoreNormalPlan).
 		ApplyIfEmpty(r.createRebuildOutSyncedPlan).
+		ApplyIfEmpty(r.createAgencyJobsRecoveryPlan).
 		// Check for scale up/down
 		ApplyIfEmpty(r.createScaleMemberPlan).
 		// Update status
@@ -59,29 +60,33 @@ func (r *Reconciler) createNormalPlan(ctx context.Context, apiObject k8sutil.API
 		// Check for cleaned out dbserver in created state
 		ApplyIfEmpty(r.createRemoveCleanedDBServersPlan).
 		// Check for members to be removed
-		ApplyIfEmpty(r.createReplaceMemberPlan).
+		ApplyIfEmpty(r.withMaintenanceWindow(r.createReplaceMemberPlan)).
 		// Check for the need to rotate one or more members
-		ApplyIfEmpty(r.createMarkToRemovePlan).
+		ApplyIfEmpty(r.withMaintenanceWindow(r.createMarkToRemovePlan)).
 		ApplyIfEmpty(r.createMemberMaintenanceManagementPlan).
-		ApplyIfEmpty(r.createRotateOrUpgradePlan).
+		ApplyIfEmpty(r.withMaintenanceWindow(r.createRotateOrUpgradePlan)).
 		// Disable maintenance if upgrade process was done. Upgrade task throw IDLE Action if upgrade is pending
 		ApplyIfEmpty(r.createMaintenanceManagementPlan).
 		// Add keys
 		ApplySubPlanIfEmpty(r.createEncryptionKeyStatusPropagatedFieldUpdate, r.createEncryptionKey).
-		ApplyIfEmpty(r.createJWTKeyUpdate).
-		ApplySubPlanIfEmpty(r.createTLSStatusPropagatedFieldUpdate, r.createCARenewalPlan).
-		ApplySubPlanIfEmpty(r.createTLSStatusPropagatedFieldUpdate, r.createCAAppendPlan).
-		ApplyIfEmpty(r.createKeyfileRenewalPlan).
-		ApplyIfEmpty(r.createRotateServerStorageResizePlanRotate).
-		ApplySubPlanIfEmpty(r.createTLSStatusPropagatedFieldUpdate, r.createRotateTLSServerSNIPlan).
+		ApplyIfEmpty(r.withMaintenanceWindow(r.createJWTKeyUpdate)).
+		ApplySubPlanIfEmpty(r.withMaintenanceWindowSubPlan(r.createTLSStatusPropagatedFieldUpdate), r.createCARenewalPlan).
+		ApplySubPlanIfEmpty(r.withMaintenanceWindowSubPlan(r.createTLSStatusPropagatedFieldUpdate), r.createCAAppendPlan).
+		ApplyIfEmpty(r.withMaintenanceWindow(r.createKeyfileRenewalPlan)).
+		ApplyIfEmpty(r.withMaintenanceWindow(r.createRotateServerStorageResizePlanRotate)).
+		ApplySubPlanIfEmpty(r.withMaintenanceWindowSubPlan(r.createTLSStatusPropagatedFieldUpdate), r.createRotateTLSServerSNIPlan).
 		ApplyIfEmpty(r.createRestorePlan).
 		ApplySubPlanIfEmpty(r.createEncryptionKeyStatusPropagatedFieldUpdate, r.createEncryptionKeyCleanPlan).
+		ApplyIfEmpty(r.createEncryptionKeyRewrapPlan).
 		ApplySubPlanIfEmpty(r.createTLSStatusPropagatedFieldUpdate, r.createCACleanPlan).
 		ApplyIfEmpty(r.createClusterOperationPlan).
+		ApplyIfEmpty(r.createArangoTaskPlan).
 		ApplyIfEmpty(r.createRebalancerGeneratePlanCore).
 		// Final
 		ApplyIfEmpty(r.createTLSStatusPropagated).
-		ApplyIfEmpty(r.createBootstrapPlan))
+		ApplyIfEmpty(r.createBootstrapPlan).
+		// Release the maintenance window hold once nothing disruptive is pending
+		ApplyIfEmpty(r.createMaintenanceWindowHoldReleasePlan))
 
 	return q.Plan(), q.BackOff(), true
 }
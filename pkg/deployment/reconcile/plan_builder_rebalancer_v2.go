@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2024 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -35,7 +35,8 @@ func (r *Reconciler) createRebalancerV2GeneratePlan(spec api.DeploymentSpec, sta
 	if !spec.Rebalancer.IsEnabled() {
 		r.metrics.Rebalancer.SetEnabled(false)
 
-		if status.Rebalancer != nil {
+		// Moves started by the ArangoTask are kept until they are finished
+		if status.Rebalancer != nil && !status.Rebalancer.IsMoveInProgress() {
 			return api.Plan{
 				api.NewAction(api.ActionTypeRebalancerCleanV2, api.ServerGroupUnknown, ""),
 			}
@@ -62,6 +63,10 @@ func (r *Reconciler) createRebalancerV2GeneratePlan(spec api.DeploymentSpec, sta
 			}
 		}
 
+		if !isRebalancerAllowed(spec, time.Now()) {
+			return nil
+		}
+
 		return api.Plan{
 			api.NewAction(api.ActionTypeRebalancerGenerateV2, api.ServerGroupUnknown, ""),
 		}
@@ -70,6 +75,10 @@ func (r *Reconciler) createRebalancerV2GeneratePlan(spec api.DeploymentSpec, sta
 	}
 
 	if status.Rebalancer == nil {
+		if !isRebalancerAllowed(spec, time.Now()) {
+			return nil
+		}
+
 		return api.Plan{
 			api.NewAction(api.ActionTypeRebalancerGenerateV2, api.ServerGroupUnknown, ""),
 		}
@@ -78,6 +87,12 @@ func (r *Reconciler) createRebalancerV2GeneratePlan(spec api.DeploymentSpec, sta
 	return nil
 }
 
+// isRebalancerAllowed returns true if moves can be generated at the given time.
+// Plan in the dry-run mode is generated also outside the rebalancer windows.
+func isRebalancerAllowed(spec api.DeploymentSpec, t time.Time) bool {
+	return spec.Rebalancer.IsDryRun() || spec.Rebalancer.GetWindows().Contains(t)
+}
+
 func (r *Reconciler) createRebalancerV2CheckPlan(spec api.DeploymentSpec, status api.DeploymentStatus) api.Plan {
 	if spec.Mode.Get() != api.DeploymentModeCluster {
 		return nil
@@ -0,0 +1,130 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func Test_RebalancerAllowed(t *testing.T) {
+	// 2026-01-05 is Monday
+	inWindow := time.Date(2026, 1, 5, 1, 30, 0, 0, time.UTC)
+	outOfWindow := time.Date(2026, 1, 5, 12, 0, 0, 0, time.UTC)
+
+	spec := api.DeploymentSpec{
+		Rebalancer: &api.ArangoDeploymentRebalancerSpec{
+			Windows: &api.DeploymentSpecMaintenanceWindows{
+				Windows: []api.DeploymentSpecMaintenanceWindow{
+					{From: "01:00", To: "03:00"},
+				},
+			},
+		},
+	}
+
+	require.True(t, isRebalancerAllowed(api.DeploymentSpec{Rebalancer: &api.ArangoDeploymentRebalancerSpec{}}, outOfWindow))
+	require.True(t, isRebalancerAllowed(spec, inWindow))
+	require.False(t, isRebalancerAllowed(spec, outOfWindow))
+
+	spec.Rebalancer.DryRun = util.NewType(true)
+	require.True(t, isRebalancerAllowed(spec, outOfWindow))
+}
+
+func Test_RebalancerBudget(t *testing.T) {
+	actions := RebalanceActions{
+		{Shard: "s1", Bytes: 40},
+		{Shard: "s2", Bytes: 80},
+		{Shard: "s3", Bytes: 20},
+	}
+
+	shards := func(a RebalanceActions) []string {
+		var r []string
+		for _, z := range a {
+			r = append(r, z.Shard)
+		}
+		return r
+	}
+
+	budget := func(perRun, perHour int64) *api.ArangoDeploymentRebalancerBudgetSpec {
+		var b api.ArangoDeploymentRebalancerBudgetSpec
+		if perRun > 0 {
+			b.PerRun = resource.NewQuantity(perRun, resource.DecimalSI)
+		}
+		if perHour > 0 {
+			b.PerHour = resource.NewQuantity(perHour, resource.DecimalSI)
+		}
+		return &b
+	}
+
+	t.Run("Without budget", func(t *testing.T) {
+		require.Equal(t, []string{"s1", "s2", "s3"}, shards(applyRebalancerBudget(nil, 1000, actions)))
+	})
+
+	t.Run("Per run", func(t *testing.T) {
+		require.Equal(t, []string{"s1", "s3"}, shards(applyRebalancerBudget(budget(100, 0), 0, actions)))
+	})
+
+	t.Run("Per hour", func(t *testing.T) {
+		require.Equal(t, []string{"s1", "s3"}, shards(applyRebalancerBudget(budget(0, 200), 100, actions)))
+		require.Equal(t, []string{"s3"}, shards(applyRebalancerBudget(budget(100, 200), 170, actions)))
+		require.Empty(t, applyRebalancerBudget(budget(100, 200), 200, actions))
+	})
+
+	t.Run("Shard bigger than budget", func(t *testing.T) {
+		big := RebalanceActions{{Shard: "s1", Bytes: 500}, {Shard: "s2", Bytes: 400}}
+
+		require.Equal(t, []string{"s1"}, shards(applyRebalancerBudget(budget(100, 0), 0, big)))
+		require.Empty(t, applyRebalancerBudget(budget(100, 1000), 50, big))
+	})
+}
+
+func Test_RebalancerClean(t *testing.T) {
+	r := newTestReconciler()
+
+	spec := api.DeploymentSpec{Mode: api.NewMode(api.DeploymentModeCluster)}
+
+	t.Run("Status cleaned", func(t *testing.T) {
+		plan := r.createRebalancerV2GeneratePlan(spec, api.DeploymentStatus{
+			Rebalancer: &api.ArangoDeploymentRebalancerStatus{},
+		})
+		require.Len(t, plan, 1)
+		require.Equal(t, api.ActionTypeRebalancerCleanV2, plan[0].Type)
+	})
+
+	t.Run("Moves in progress are kept", func(t *testing.T) {
+		plan := r.createRebalancerV2GeneratePlan(spec, api.DeploymentStatus{
+			Rebalancer: &api.ArangoDeploymentRebalancerStatus{MoveJobs: []string{"1"}},
+		})
+		require.Empty(t, plan)
+
+		plan = r.createRebalancerV2CheckPlan(spec, api.DeploymentStatus{
+			Rebalancer: &api.ArangoDeploymentRebalancerStatus{MoveJobs: []string{"1"}},
+		})
+		require.Len(t, plan, 1)
+		require.Equal(t, api.ActionTypeRebalancerCheckV2, plan[0].Type)
+	})
+}
@@ -0,0 +1,115 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"sort"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/actions"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/features"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+// createArangoTaskPlan injects the actions of the oldest not finished ArangoTask assigned to the deployment
+func (r *Reconciler) createArangoTaskPlan(ctx context.Context, apiObject k8sutil.APIObject,
+	spec api.DeploymentSpec, status api.DeploymentStatus,
+	context PlanBuilderContext) api.Plan {
+	inspector, err := context.ACS().CurrentClusterCache().ArangoTask().V1()
+	if err != nil {
+		// ArangoTask CRD is not available
+		return nil
+	}
+
+	tasks := inspector.Filter(func(task *api.ArangoTask) bool {
+		return task.Spec.Deployment == apiObject.GetName() && !task.Status.State.IsFinished()
+	})
+
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	sort.Slice(tasks, func(i, j int) bool {
+		if a, b := tasks[i].GetCreationTimestamp(), tasks[j].GetCreationTimestamp(); !a.Equal(&b) {
+			return a.Before(&b)
+		}
+
+		return tasks[i].GetName() < tasks[j].GetName()
+	})
+
+	task := tasks[0]
+
+	if task.Status.State == api.ArangoTaskRunningState {
+		// Normal plan is empty, so the actions of the running task are not present anymore
+		r.log.Str("task", task.GetName()).Warn("ArangoTask actions are missing in the plan")
+		return api.Plan{createTaskFinishAction(task, api.ArangoTaskFailedState, "Task actions have been removed from the plan")}
+	}
+
+	if !status.Members.AllMembersReady(spec.Mode.Get(), spec.Sync.IsEnabled(), features.IsGatewayEnabled(spec)) {
+		// Wait for the deployment to be ready before the task is started
+		return nil
+	}
+
+	plan, err := createArangoTaskActions(spec, status, task)
+	if err != nil {
+		r.log.Err(err).Str("task", task.GetName()).Warn("Unable to create ArangoTask plan")
+		return api.Plan{createTaskFinishAction(task, api.ArangoTaskFailedState, err.Error())}
+	}
+
+	r.log.Str("task", task.GetName()).Str("type", string(task.Spec.Type)).Info("Injecting ArangoTask actions into the plan")
+
+	return plan
+}
+
+// createArangoTaskActions validates the task and returns the plan wrapped with TaskStart and TaskFinish actions
+func createArangoTaskActions(spec api.DeploymentSpec, status api.DeploymentStatus, task *api.ArangoTask) (api.Plan, error) {
+	if err := task.Spec.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "Invalid task spec")
+	}
+
+	builder, ok := getTaskPlanBuilder(task.Spec.Type)
+	if !ok {
+		return nil, errors.Errorf("Task type %s is not supported", task.Spec.Type)
+	}
+
+	plan, err := builder(spec, status, task)
+	if err != nil {
+		return nil, err
+	}
+
+	return plan.SetTaskID(task.GetUID()).Wrap(
+		actions.NewClusterAction(api.ActionTypeTaskStart, "Start ArangoTask").SetTaskID(task.GetUID()),
+		createTaskFinishAction(task, api.ArangoTaskSuccessState, ""),
+	), nil
+}
+
+func createTaskFinishAction(task *api.ArangoTask, state api.ArangoTaskState, message string) api.Action {
+	a := actions.NewClusterAction(api.ActionTypeTaskFinish, "Finish ArangoTask").SetTaskID(task.GetUID())
+
+	a = a.AddParam(actionTaskFinishStateKey, string(state))
+	if message != "" {
+		a = a.AddParam(actionTaskFinishMessageKey, message)
+	}
+
+	return a
+}
@@ -0,0 +1,177 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+)
+
+func newTestArangoTask(t *testing.T, taskType api.ArangoTaskType, details interface{}) *api.ArangoTask {
+	task := &api.ArangoTask{
+		ObjectMeta: meta.ObjectMeta{
+			Name: "task",
+			UID:  types.UID("task-uid"),
+		},
+		Spec: api.ArangoTaskSpec{
+			Type:       taskType,
+			Deployment: "deployment",
+		},
+	}
+
+	if details != nil {
+		require.NoError(t, task.Spec.Details.Set(details))
+	}
+
+	return task
+}
+
+func Test_ArangoTask_CreateActions(t *testing.T) {
+	spec := api.DeploymentSpec{
+		Mode: api.NewMode(api.DeploymentModeCluster),
+	}
+	status := api.DeploymentStatus{
+		Members: api.DeploymentStatusMembers{
+			DBServers: api.MemberStatusList{
+				{ID: "PRMR-1"},
+				{ID: "PRMR-2"},
+			},
+			Coordinators: api.MemberStatusList{
+				{ID: "CRDN-1"},
+			},
+		},
+	}
+
+	t.Run("Compact all DBServers", func(t *testing.T) {
+		plan, err := createArangoTaskActions(spec, status, newTestArangoTask(t, api.ArangoTaskCompactType, nil))
+		require.NoError(t, err)
+
+		require.Len(t, plan, 4)
+		require.Equal(t, api.ActionTypeTaskStart, plan[0].Type)
+		require.Equal(t, api.ActionTypeCompactMember, plan[1].Type)
+		require.Equal(t, "PRMR-1", plan[1].MemberID)
+		require.Equal(t, api.ActionTypeCompactMember, plan[2].Type)
+		require.Equal(t, "PRMR-2", plan[2].MemberID)
+		require.Equal(t, api.ActionTypeTaskFinish, plan[3].Type)
+
+		state, ok := plan[3].GetParam(actionTaskFinishStateKey)
+		require.True(t, ok)
+		require.Equal(t, string(api.ArangoTaskSuccessState), state)
+
+		for _, a := range plan {
+			require.Equal(t, types.UID("task-uid"), a.TaskID)
+		}
+	})
+
+	t.Run("Compact selected member", func(t *testing.T) {
+		plan, err := createArangoTaskActions(spec, status, newTestArangoTask(t, api.ArangoTaskCompactType, api.ArangoTaskMemberDetails{ID: "PRMR-2"}))
+		require.NoError(t, err)
+
+		require.Len(t, plan, 3)
+		require.Equal(t, api.ActionTypeCompactMember, plan[1].Type)
+		require.Equal(t, "PRMR-2", plan[1].MemberID)
+	})
+
+	t.Run("Rebalance", func(t *testing.T) {
+		plan, err := createArangoTaskActions(spec, status, newTestArangoTask(t, api.ArangoTaskRebalanceType, nil))
+		require.NoError(t, err)
+
+		require.Len(t, plan, 4)
+		require.Equal(t, api.ActionTypeRebalancerGenerateV2, plan[1].Type)
+		require.Equal(t, api.ActionTypeRebalancerWaitV2, plan[2].Type)
+	})
+
+	t.Run("Rebalance in single mode", func(t *testing.T) {
+		_, err := createArangoTaskActions(api.DeploymentSpec{Mode: api.NewMode(api.DeploymentModeSingle)}, status, newTestArangoTask(t, api.ArangoTaskRebalanceType, nil))
+		require.Error(t, err)
+	})
+
+	t.Run("Resign leadership on Coordinator", func(t *testing.T) {
+		_, err := createArangoTaskActions(spec, status, newTestArangoTask(t, api.ArangoTaskResignLeadershipType, api.ArangoTaskMemberDetails{ID: "CRDN-1"}))
+		require.EqualError(t, err, "Task resign-leadership is not supported on the member CRDN-1 in group coordinator")
+	})
+
+	t.Run("Resign leadership on missing member", func(t *testing.T) {
+		_, err := createArangoTaskActions(spec, status, newTestArangoTask(t, api.ArangoTaskResignLeadershipType, api.ArangoTaskMemberDetails{ID: "PRMR-3"}))
+		require.EqualError(t, err, "Member PRMR-3 not found")
+	})
+
+	t.Run("CleanOut last DBServer", func(t *testing.T) {
+		single := status.DeepCopy()
+		single.Members.DBServers = single.Members.DBServers[:1]
+
+		_, err := createArangoTaskActions(spec, *single, newTestArangoTask(t, api.ArangoTaskCleanOutMemberType, api.ArangoTaskMemberDetails{ID: "PRMR-1"}))
+		require.EqualError(t, err, "Unable to clean out the last DBServer")
+	})
+
+	t.Run("CleanOut member", func(t *testing.T) {
+		plan, err := createArangoTaskActions(spec, status, newTestArangoTask(t, api.ArangoTaskCleanOutMemberType, api.ArangoTaskMemberDetails{ID: "PRMR-1"}))
+		require.NoError(t, err)
+
+		require.Equal(t, api.ActionTypeTaskStart, plan[0].Type)
+		require.Equal(t, api.ActionTypeTaskFinish, plan[len(plan)-1].Type)
+		for _, a := range plan[1 : len(plan)-1] {
+			require.Equal(t, "PRMR-1", a.MemberID)
+		}
+	})
+
+	t.Run("Invalid spec", func(t *testing.T) {
+		_, err := createArangoTaskActions(spec, status, newTestArangoTask(t, "unknown", nil))
+		require.Error(t, err)
+	})
+}
+
+func Test_ArangoTask_FinishAction(t *testing.T) {
+	a := createTaskFinishAction(newTestArangoTask(t, api.ArangoTaskCompactType, nil), api.ArangoTaskFailedState, "failure")
+
+	require.Equal(t, api.ActionTypeTaskFinish, a.Type)
+	require.Equal(t, types.UID("task-uid"), a.TaskID)
+	state, ok := a.GetParam(actionTaskFinishStateKey)
+	require.True(t, ok)
+	require.Equal(t, string(api.ArangoTaskFailedState), state)
+
+	message, ok := a.GetParam(actionTaskFinishMessageKey)
+	require.True(t, ok)
+	require.Equal(t, "failure", message)
+}
+
+func Test_ArangoTask_Progress(t *testing.T) {
+	task := newTestArangoTask(t, api.ArangoTaskRebalanceType, nil)
+
+	progress := arangoTaskProgress{}
+
+	progress.add(api.NewAction(api.ActionTypeRebalancerGenerateV2, api.ServerGroupUnknown, ""))
+	require.Empty(t, progress)
+
+	plan, err := createArangoTaskActions(api.DeploymentSpec{Mode: api.NewMode(api.DeploymentModeCluster)}, api.DeploymentStatus{}, task)
+	require.NoError(t, err)
+
+	for _, a := range plan {
+		progress.add(a)
+	}
+
+	require.Equal(t, arangoTaskProgress{task.GetUID(): 2}, progress)
+}
@@ -1153,6 +1153,124 @@ func TestCreatePlan(t *testing.T) {
 			},
 			ExpectedLog: "Creating member replacement plan because member has failed",
 		},
+		{
+			Name: "DBServer in failed state - shard-aware replacement",
+			context: &testContext{
+				ArangoDeployment: deploymentTemplate.DeepCopy(),
+				AgencyState: state.State{
+					Plan: state.Plan{
+						Collections: state.PlanCollections{
+							"db": state.PlanDBCollections{
+								"col": state.PlanCollection{
+									Shards: state.Shards{
+										"s1": state.Servers{"2", "3"},
+									},
+								},
+							},
+						},
+					},
+					Current: state.Current{
+						Collections: state.CurrentCollections{
+							"db": state.CurrentDBCollections{
+								"col": state.CurrentDBCollection{
+									"s1": state.CurrentDBShard{
+										Servers: state.Servers{"2", "3"},
+									},
+								},
+							},
+						},
+					},
+					Supervision: state.Supervision{
+						Health: state.ServerMap[state.SupervisionHealthServer]{
+							"id": {Status: state.SupervisionHealthServerStatusFailed},
+						},
+					},
+					Target: state.Target{
+						JobFinished: state.Jobs{
+							"1": {Type: state.JobTypeFailedServer, Server: "id"},
+						},
+					},
+				},
+			},
+			Helper: func(ad *api.ArangoDeployment) {
+				ad.Spec.DBServers = api.ServerGroupSpec{
+					Count: util.NewType[int](3),
+				}
+				ad.Spec.DBServers.AllowMemberRecreation = util.NewType(true)
+				ad.Spec.Recovery = &api.ArangoDeploymentRecoverySpec{
+					DBServerFailure: &api.ArangoDeploymentRecoveryDBServerFailureSpec{
+						Enabled: util.NewType(true),
+					},
+				}
+				ad.Status.Members.DBServers[0].Phase = api.MemberPhaseFailed
+				ad.Status.Members.DBServers[0].ID = "id"
+			},
+			ExpectedPlan: []api.Action{
+				actions.NewAction(api.ActionTypeRemoveMember, api.ServerGroupDBServers, sharedReconcile.WithPredefinedMember("id")),
+				actions.NewAction(api.ActionTypeAddMember, api.ServerGroupDBServers, sharedReconcile.WithPredefinedMember("")),
+				actions.NewAction(api.ActionTypeWaitForMemberUp, api.ServerGroupDBServers,
+					sharedReconcile.WithPredefinedMember(api.MemberIDPreviousAction)),
+			},
+			ExpectedLog: "Creating shard-aware DBServer replacement plan because member has failed",
+		},
+		{
+			Name: "DBServer in failed state - shard-aware replacement waits for failover",
+			context: &testContext{
+				ArangoDeployment: deploymentTemplate.DeepCopy(),
+				AgencyState: state.State{
+					Plan: state.Plan{
+						Collections: state.PlanCollections{
+							"db": state.PlanDBCollections{
+								"col": state.PlanCollection{
+									Shards: state.Shards{
+										"s1": state.Servers{"2", "id"},
+									},
+								},
+							},
+						},
+					},
+					Current: state.Current{
+						Collections: state.CurrentCollections{
+							"db": state.CurrentDBCollections{
+								"col": state.CurrentDBCollection{
+									"s1": state.CurrentDBShard{
+										Servers: state.Servers{"2", "id"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Helper: func(ad *api.ArangoDeployment) {
+				ad.Status.CurrentImage = &api.ImageInfo{}
+				ad.Spec.Authentication.JWTSecretName = util.NewType[string](api.JWTSecretNameDisabled)
+				for i := range ad.Status.Members.Agents {
+					ad.Status.Members.Agents[i].Phase = api.MemberPhaseCreated
+				}
+				for i := range ad.Status.Members.Coordinators {
+					ad.Status.Members.Coordinators[i].Phase = api.MemberPhaseCreated
+				}
+				for i := range ad.Status.Members.DBServers {
+					ad.Status.Members.DBServers[i].Phase = api.MemberPhaseCreated
+				}
+				ad.Spec.DBServers = api.ServerGroupSpec{
+					Count: util.NewType[int](3),
+				}
+				ad.Spec.DBServers.AllowMemberRecreation = util.NewType(true)
+				ad.Spec.Recovery = &api.ArangoDeploymentRecoverySpec{
+					DBServerFailure: &api.ArangoDeploymentRecoveryDBServerFailureSpec{
+						Enabled: util.NewType(true),
+					},
+				}
+				ad.Status.Members.DBServers[0].Phase = api.MemberPhaseFailed
+				ad.Status.Members.DBServers[0].ID = "id"
+			},
+			ExpectedHighPlan: []api.Action{
+				actions.NewAction(api.ActionTypeRecreateMember, api.ServerGroupDBServers, sharedReconcile.WithPredefinedMember("id")),
+			},
+			ExpectedLog: "Recreating DBServer - it cannot be removed gracefully",
+		},
 		{
 			Name: "DBServer in failed state - remove",
 			context: &testContext{
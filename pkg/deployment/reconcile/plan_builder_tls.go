@@ -184,7 +184,7 @@ func (r *Reconciler) createCAAppendPlan(ctx context.Context, apiObject k8sutil.A
 		return nil
 	}
 
-	ca, _, err := k8sutil.GetKeyCertFromSecret(caSecret, resources.CACertName, resources.CAKeyName)
+	ca, err := resources.GetTLSCACertificates(spec.TLS, caSecret)
 	if err != nil {
 		r.planLogger.Err(err).Str("secret", spec.TLS.GetCASecretName()).Warn("CA Secret does not contains Cert")
 		return nil
@@ -225,7 +225,8 @@ func (r *Reconciler) createCAAppendPlan(ctx context.Context, apiObject k8sutil.A
 func (r *Reconciler) createCARenewalPlan(ctx context.Context, apiObject k8sutil.APIObject,
 	spec api.DeploymentSpec, status api.DeploymentStatus,
 	context PlanBuilderContext) api.Plan {
-	if !spec.TLS.IsSecure() {
+	if !spec.TLS.IsSecure() || spec.TLS.IsCertManager() {
+		// CA of cert-manager is renewed by the issuer
 		return nil
 	}
 
@@ -270,7 +271,7 @@ func (r *Reconciler) createCACleanPlan(ctx context.Context, apiObject k8sutil.AP
 		return nil
 	}
 
-	ca, _, err := k8sutil.GetKeyCertFromSecret(caSecret, resources.CACertName, resources.CAKeyName)
+	ca, err := resources.GetTLSCACertificates(spec.TLS, caSecret)
 	if err != nil {
 		r.planLogger.Err(err).Str("secret", spec.TLS.GetCASecretName()).Warn("CA Secret does not contains Cert")
 		return nil
@@ -530,6 +531,19 @@ func (r *Reconciler) keyfileRenewalRequired(ctx context.Context, apiObject k8sut
 		return false, false
 	}
 
+	if tlsSpec.IsCertManager() {
+		// Certificates are renewed by cert-manager, keyfile needs to be replaced once a new certificate is issued
+		if r.keyfileIssuedCertificateChanged(cachedStatus, memberName) {
+			return true, true
+		}
+
+		if mode == api.TLSRotateModeInPlace {
+			return r.keyfilePropagationRequired(ctx, apiObject, cachedStatus, context, group, member), false
+		}
+
+		return false, false
+	}
+
 	caSecret, exists := cachedStatus.Secret().V1().GetSimple(tlsSpec.GetCASecretName())
 	if !exists {
 		r.planLogger.Str("secret", tlsSpec.GetCASecretName()).Warn("CA Secret does not exists")
@@ -607,47 +621,81 @@ func (r *Reconciler) keyfileRenewalRequired(ctx context.Context, apiObject k8sut
 
 	// Ensure secret is propagated only on 3.7.0+ enterprise and inplace mode
 	if mode == api.TLSRotateModeInPlace {
-		switch group.Type() {
-		case api.ServerGroupTypeArangoD:
-			conn, err := context.GetMembersState().GetMemberClient(member.ID)
-			if err != nil {
-				r.planLogger.Err(err).Warn("Unable to get client")
-				return false, false
-			}
+		return r.keyfilePropagationRequired(ctx, apiObject, cachedStatus, context, group, member), false
+	}
 
-			s, exists := cachedStatus.Secret().V1().GetSimple(k8sutil.CreateTLSKeyfileSecretName(apiObject.GetName(), group.AsRole(), member.ID))
-			if !exists {
-				r.planLogger.Warn("Keyfile secret is missing")
-				return false, false
-			}
+	return false, false
+}
 
-			c := client.NewClient(conn.Connection())
-			tls, err := c.GetTLS(ctx)
-			if err != nil {
-				r.planLogger.Err(err).Warn("Unable to get tls details")
-				return false, false
-			}
+// keyfilePropagationRequired checks if the keyfile from the secret is used by the member
+func (r *Reconciler) keyfilePropagationRequired(ctx context.Context, apiObject k8sutil.APIObject,
+	cachedStatus inspectorInterface.Inspector, context PlanBuilderContext,
+	group api.ServerGroup, member api.MemberStatus) bool {
+	switch group.Type() {
+	case api.ServerGroupTypeArangoD:
+		conn, err := context.GetMembersState().GetMemberClient(member.ID)
+		if err != nil {
+			r.planLogger.Err(err).Warn("Unable to get client")
+			return false
+		}
 
-			keyfile, ok := s.Data[utilConstants.SecretTLSKeyfile]
-			if !ok {
-				r.planLogger.Warn("Keyfile secret is invalid")
-				return false, false
-			}
+		s, exists := cachedStatus.Secret().V1().GetSimple(k8sutil.CreateTLSKeyfileSecretName(apiObject.GetName(), group.AsRole(), member.ID))
+		if !exists {
+			r.planLogger.Warn("Keyfile secret is missing")
+			return false
+		}
 
-			keyfileSha := util.SHA256(keyfile)
+		c := client.NewClient(conn.Connection())
+		tls, err := c.GetTLS(ctx)
+		if err != nil {
+			r.planLogger.Err(err).Warn("Unable to get tls details")
+			return false
+		}
 
-			if tls.Result.KeyFile.GetSHA().Checksum() != keyfileSha {
-				r.planLogger.Str("current", tls.Result.KeyFile.GetSHA().Checksum()).Str("desired", keyfileSha).Debug("Unable to get tls details")
-				return true, false
-			}
-		case api.ServerGroupTypeArangoSync, api.ServerGroupTypeGateway:
-			// The gateway reloads a rotated certificate in place via Envoy SDS, so there is no
-			// in-place keyfile propagation to verify here.
-			break
-		default:
-			assertion.InvalidGroupKey.Assert(true, "Unable to check TLS Key Renewal for an unknown group: %s", group.AsRole())
+		keyfile, ok := s.Data[utilConstants.SecretTLSKeyfile]
+		if !ok {
+			r.planLogger.Warn("Keyfile secret is invalid")
+			return false
 		}
+
+		keyfileSha := util.SHA256(keyfile)
+
+		if tls.Result.KeyFile.GetSHA().Checksum() != keyfileSha {
+			r.planLogger.Str("current", tls.Result.KeyFile.GetSHA().Checksum()).Str("desired", keyfileSha).Debug("Unable to get tls details")
+			return true
+		}
+	case api.ServerGroupTypeArangoSync, api.ServerGroupTypeGateway:
+		// The gateway reloads a rotated certificate in place via Envoy SDS, so there is no
+		// in-place keyfile propagation to verify here.
+		break
+	default:
+		assertion.InvalidGroupKey.Assert(true, "Unable to check TLS Key Renewal for an unknown group: %s", group.AsRole())
 	}
 
-	return false, false
+	return false
+}
+
+// keyfileIssuedCertificateChanged checks if the keyfile differs from the certificate issued by cert-manager
+func (r *Reconciler) keyfileIssuedCertificateChanged(cachedStatus inspectorInterface.Inspector, memberName string) bool {
+	issued, exists := cachedStatus.Secret().V1().GetSimple(k8sutil.AppendTLSCertificateSecretPostfix(memberName))
+	if !exists {
+		return false
+	}
+
+	expected, ok := resources.GetTLSCertificateKeyfile(issued)
+	if !ok {
+		return false
+	}
+
+	s, exists := cachedStatus.Secret().V1().GetSimple(k8sutil.AppendTLSKeyfileSecretPostfix(memberName))
+	if !exists {
+		return false
+	}
+
+	if string(s.Data[utilConstants.SecretTLSKeyfile]) != expected {
+		r.planLogger.Str("member", memberName).Info("Issued certificate changed")
+		return true
+	}
+
+	return false
 }
@@ -0,0 +1,103 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency/state"
+	sharedReconcile "github.com/arangodb/kube-arangodb/pkg/deployment/reconcile/shared"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/topology"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+const shardReplicasSameZoneMessageLimit = 10
+
+// createShardReplicasSameZoneConditionPlan creates plan to update ShardReplicasSameZone condition
+// when replicas of the shards share the topology zone while other zones are available
+func (r *Reconciler) createShardReplicasSameZoneConditionPlan(ctx context.Context, apiObject k8sutil.APIObject,
+	spec api.DeploymentSpec, status api.DeploymentStatus,
+	context PlanBuilderContext) api.Plan {
+	c, exists := status.Conditions.Get(api.ConditionTypeShardReplicasSameZone)
+
+	if spec.GetMode() != api.DeploymentModeCluster || !status.Topology.Enabled() {
+		r.metrics.Topology.SetEnabled(false)
+
+		if exists {
+			return api.Plan{sharedReconcile.RemoveConditionActionV2("Topology Disabled", api.ConditionTypeShardReplicasSameZone)}
+		}
+		return nil
+	}
+
+	cache, ok := context.GetAgencyCache()
+	if !ok {
+		return nil
+	}
+
+	violations := cache.GetShardZoneViolations(topology.GetDBServerZones(status), status.Topology.Size)
+
+	r.metrics.Topology.SetEnabled(true)
+	r.metrics.Topology.SetShardsSameZone(len(violations))
+
+	if len(violations) == 0 {
+		if exists {
+			return api.Plan{sharedReconcile.RemoveConditionActionV2("Shard Replicas Spread", api.ConditionTypeShardReplicasSameZone)}
+		}
+		return nil
+	}
+
+	message := shardReplicasSameZoneMessage(violations)
+	hash := util.SHA256FromString(message)
+
+	if exists && c.IsTrue() && c.Hash == hash {
+		return nil
+	}
+
+	return api.Plan{sharedReconcile.UpdateConditionActionV2("Shard Replicas In Same Zone", api.ConditionTypeShardReplicasSameZone, true,
+		"Shard Replicas In Same Zone", message, hash)}
+}
+
+// shardReplicasSameZoneMessage returns the condition message with the list of the shards which replicas share the zone
+func shardReplicasSameZoneMessage(violations []state.ShardZoneViolation) string {
+	descriptions := make([]string, 0, len(violations))
+	for _, v := range violations {
+		if len(descriptions) == shardReplicasSameZoneMessageLimit {
+			break
+		}
+
+		servers := make([]string, len(v.Servers))
+		for i, s := range v.Servers {
+			servers[i] = string(s)
+		}
+
+		descriptions = append(descriptions, fmt.Sprintf("%s/%s/%s (zone %d: %s)", v.Database, v.Collection, v.Shard, v.Zone, strings.Join(servers, ", ")))
+	}
+
+	if len(violations) > shardReplicasSameZoneMessageLimit {
+		return fmt.Sprintf("Replicas of the shards share the zone: %s and %d more", strings.Join(descriptions, ", "), len(violations)-shardReplicasSameZoneMessageLimit)
+	}
+
+	return fmt.Sprintf("Replicas of the shards share the zone: %s", strings.Join(descriptions, ", "))
+}
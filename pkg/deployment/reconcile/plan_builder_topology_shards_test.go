@@ -0,0 +1,128 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	sharedReconcile "github.com/arangodb/kube-arangodb/pkg/deployment/reconcile/shared"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func Test_CreateShardReplicasSameZoneConditionPlan(t *testing.T) {
+	const message = "Replicas of the shards share the zone: db/same/s2 (zone 0: A, B)"
+
+	type testCase struct {
+		topology  bool
+		condition *api.Condition
+
+		remove  bool
+		message string
+		metric  int
+	}
+
+	testCases := map[string]testCase{
+		"Topology disabled": {},
+		"Topology disabled - condition cleanup": {
+			condition: &api.Condition{Type: api.ConditionTypeShardReplicasSameZone},
+
+			remove: true,
+		},
+		"Shards in the same zone": {
+			topology: true,
+
+			message: message,
+			metric:  1,
+		},
+		"Shards in the same zone - condition up to date": {
+			topology: true,
+			condition: &api.Condition{
+				Type:   api.ConditionTypeShardReplicasSameZone,
+				Status: "True",
+				Hash:   util.SHA256FromString(message),
+			},
+
+			metric: 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c := &testContext{
+				ArangoDeployment: &api.ArangoDeployment{},
+			}
+			require.NoError(t, json.Unmarshal([]byte(`{
+  "DBServers": {"A": "none", "B": "none", "C": "none"},
+  "Collections": {
+    "db": {
+      "1": {"name": "spread", "shards": {"s1": ["A", "C"]}},
+      "2": {"name": "same", "shards": {"s2": ["A", "B"]}}
+    }
+  }
+}`), &c.AgencyState.Plan))
+
+			var status api.DeploymentStatus
+			if tc.topology {
+				status.Topology = &api.TopologyStatus{ID: "topology", Size: 2}
+			}
+			if tc.condition != nil {
+				status.Conditions = api.ConditionList{*tc.condition}
+			}
+
+			for id, zone := range map[string]int{"A": 0, "B": 0, "C": 1} {
+				require.NoError(t, status.Members.Add(api.MemberStatus{
+					ID:       id,
+					Topology: &api.TopologyMemberStatus{ID: "topology", Zone: zone},
+				}, api.ServerGroupDBServers))
+			}
+
+			r := newTestReconciler()
+			plan := r.createShardReplicasSameZoneConditionPlan(context.Background(), c.ArangoDeployment, api.DeploymentSpec{
+				Mode: api.NewMode(api.DeploymentModeCluster),
+			}, status, c)
+
+			require.Equal(t, tc.topology, r.metrics.Topology.enabled)
+			require.Equal(t, tc.metric, r.metrics.Topology.shardsSameZone)
+
+			if !tc.remove && tc.message == "" {
+				require.Empty(t, plan)
+				return
+			}
+
+			require.Len(t, plan, 1)
+			require.Equal(t, api.ActionTypeSetConditionV2, plan[0].Type)
+
+			if tc.remove {
+				v, _ := plan[0].GetParam(sharedReconcile.SetConditionActionV2KeyType)
+				require.Equal(t, sharedReconcile.SetConditionActionV2KeyTypeRemove, v)
+				return
+			}
+
+			v, _ := plan[0].GetParam(sharedReconcile.SetConditionActionV2KeyMessage)
+			require.Equal(t, tc.message, v)
+		})
+	}
+}
@@ -43,7 +43,9 @@ const (
 func (r *Reconciler) volumeMemberReplacement(ctx context.Context, apiObject k8sutil.APIObject,
 	spec api.DeploymentSpec, status api.DeploymentStatus,
 	context PlanBuilderContext) api.Plan {
-	if !features.LocalVolumeReplacementCheck().Enabled() {
+	releaseByPolicy := spec.Recovery.Get().UnschedulableMember.IsReleaseLocalVolumeEnabled()
+
+	if !features.LocalVolumeReplacementCheck().Enabled() && !releaseByPolicy {
 		return nil
 	}
 
@@ -64,6 +66,18 @@ func (r *Reconciler) volumeMemberReplacement(ctx context.Context, apiObject k8su
 			continue
 		}
 
+		if !features.LocalVolumeReplacementCheck().Enabled() {
+			if !isLocalVolumeReleaseSupported(spec, member.Group) {
+				// Recovery policy releases only the volumes of DBServers, which data is resynchronized from the replicas
+				continue
+			}
+
+			if !member.Member.Conditions.IsTrue(api.ConditionTypeMemberUnschedulable) {
+				// Recovery policy releases the volume only after the member is unschedulable longer than timeout
+				continue
+			}
+		}
+
 		if servers.Contains(state.Server(member.Member.ID)) {
 			continue
 		}
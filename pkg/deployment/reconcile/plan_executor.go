@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2025 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -186,12 +186,29 @@ func (d *Reconciler) executePlanStatus(ctx context.Context, pg planner) (bool, b
 		return false, false, nil
 	}
 
-	newPlan, callAgain, callInLoop, err := d.executePlan(ctx, plan, pg)
+	if !GetPlanExecutionControl(d.context.GetAPIObject()).Allowed(plan[0]) {
+		// Plan execution is paused
+		return false, false, nil
+	}
+
+	var history api.PlanHistory
+	progress := arangoTaskProgress{}
+
+	newPlan, callAgain, callInLoop, err := d.executePlan(ctx, plan, pg, &history, progress)
+
+	d.updateArangoTaskProgress(ctx, progress)
 
 	// Refresh current status
 	loopStatus = d.context.GetStatus()
 
-	if pg.Set(&loopStatus, newPlan) {
+	changed := pg.Set(&loopStatus, newPlan)
+
+	if len(history) > 0 {
+		loopStatus.PlanHistory = loopStatus.PlanHistory.Append(api.DefaultPlanHistoryLimit, history...)
+		changed = true
+	}
+
+	if changed {
 		d.planLogger.Debug("Updating plan")
 		if err := d.context.UpdateStatus(ctx, loopStatus); err != nil {
 			d.planLogger.Err(err).Debug("Failed to update CR status")
@@ -206,7 +223,7 @@ func (d *Reconciler) executePlanStatus(ctx context.Context, pg planner) (bool, b
 	return callAgain, callInLoop, nil
 }
 
-func (d *Reconciler) executePlan(ctx context.Context, statusPlan api.Plan, pg planner) (newPlan api.Plan, callAgain, callInLoop bool, err error) {
+func (d *Reconciler) executePlan(ctx context.Context, statusPlan api.Plan, pg planner, history *api.PlanHistory, progress arangoTaskProgress) (newPlan api.Plan, callAgain, callInLoop bool, err error) {
 	plan := statusPlan.DeepCopy()
 
 	for {
@@ -217,6 +234,11 @@ func (d *Reconciler) executePlan(ctx context.Context, statusPlan api.Plan, pg pl
 		// Take first action
 		planAction := plan[0]
 
+		if !GetPlanExecutionControl(d.context.GetAPIObject()).Allowed(planAction) {
+			// Plan execution is paused, stop after the stepped action
+			return plan, false, false, nil
+		}
+
 		action, actionContext := d.createAction(planAction)
 
 		if !planAction.IsStarted() {
@@ -236,6 +258,8 @@ func (d *Reconciler) executePlan(ctx context.Context, statusPlan api.Plan, pg pl
 				planAction.Type.String(), pg.Type()).Set(0.0)
 
 			actionsFailedMetrics.WithLabelValues(d.context.GetName(), planAction.Type.String(), pg.Type()).Inc()
+			*history = append(*history, api.NewPlanHistoryEntry(planAction, pg.Type(), api.PlanHistoryResultFailed, err, meta.Now()))
+			d.failArangoTask(ctx, planAction, err)
 			return nil, false, false, errors.WithStack(err)
 		}
 
@@ -245,6 +269,8 @@ func (d *Reconciler) executePlan(ctx context.Context, statusPlan api.Plan, pg pl
 				planAction.Type.String(), pg.Type()).Set(0.0)
 
 			actionsFailedMetrics.WithLabelValues(d.context.GetName(), planAction.Type.String(), pg.Type()).Inc()
+			*history = append(*history, api.NewPlanHistoryEntry(planAction, pg.Type(), api.PlanHistoryResultAborted, nil, meta.Now()))
+			d.failArangoTask(ctx, planAction, nil)
 			return nil, true, false, nil
 		}
 
@@ -257,6 +283,10 @@ func (d *Reconciler) executePlan(ctx context.Context, statusPlan api.Plan, pg pl
 			}
 
 			actionsSucceededMetrics.WithLabelValues(d.context.GetName(), planAction.Type.String(), pg.Type()).Inc()
+			if planAction.Type.IsPlanHistoryRecorded() {
+				*history = append(*history, api.NewPlanHistoryEntry(planAction, pg.Type(), api.PlanHistoryResultSuccess, nil, meta.Now()))
+			}
+			progress.add(planAction)
 			if len(plan) > 1 {
 				plan = plan[1:]
 				if plan[0].MemberID == api.MemberIDPreviousAction {
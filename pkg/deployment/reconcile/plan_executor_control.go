@@ -0,0 +1,172 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"fmt"
+	"strings"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// PlanExecutionControl defines the plan execution controls set via the ArangoDeployment annotations
+type PlanExecutionControl struct {
+	// Paused defines if the plan execution is paused
+	Paused bool
+	// Step keeps the ID of the action which is allowed to be executed while plan is paused
+	Step string
+	// Skip keeps the ID of the action which needs to be removed from the plan
+	Skip string
+	// SkipForce allows to remove the action which has been already started
+	SkipForce bool
+}
+
+// GetPlanExecutionControl returns the plan execution controls of the object
+func GetPlanExecutionControl(obj meta.Object) PlanExecutionControl {
+	var r PlanExecutionControl
+
+	if obj == nil {
+		return r
+	}
+
+	annotations := obj.GetAnnotations()
+
+	r.Paused = annotations[deployment.ArangoDeploymentPlanPauseAnnotation] == "true"
+	r.Step = annotations[deployment.ArangoDeploymentPlanStepAnnotation]
+	r.Skip = annotations[deployment.ArangoDeploymentPlanSkipAnnotation]
+	r.SkipForce = annotations[deployment.ArangoDeploymentPlanSkipForceAnnotation] == "true"
+
+	return r
+}
+
+// Allowed returns true if the action can be executed
+func (p PlanExecutionControl) Allowed(action api.Action) bool {
+	if !p.Paused {
+		return true
+	}
+
+	return p.Step != "" && p.Step == action.ID
+}
+
+// PlanPendingActions returns the first action of each non-empty plan
+func PlanPendingActions(status api.DeploymentStatus) map[string]api.Action {
+	r := map[string]api.Action{}
+
+	for _, pg := range []planner{plannerHigh{}, plannerResources{}, plannerNormal{}} {
+		if plan := pg.Get(&status); len(plan) > 0 {
+			r[pg.Type()] = plan[0]
+		}
+	}
+
+	return r
+}
+
+// PlanFirstPendingAction returns the first pending action, in order of the plan execution
+func PlanFirstPendingAction(status api.DeploymentStatus) (api.Action, bool) {
+	for _, pg := range []planner{plannerHigh{}, plannerResources{}, plannerNormal{}} {
+		if plan := pg.Get(&status); len(plan) > 0 {
+			return plan[0], true
+		}
+	}
+
+	return api.Action{}, false
+}
+
+// PlanContainsAction returns true if action with the given ID is present in any plan
+func PlanContainsAction(status api.DeploymentStatus, id string) bool {
+	_, ok := PlanGetAction(status, id)
+	return ok
+}
+
+// PlanGetAction returns the action with the given ID from any plan
+func PlanGetAction(status api.DeploymentStatus, id string) (api.Action, bool) {
+	for _, pg := range []planner{plannerHigh{}, plannerResources{}, plannerNormal{}} {
+		for _, a := range pg.Get(&status) {
+			if a.ID == id {
+				return a, true
+			}
+		}
+	}
+
+	return api.Action{}, false
+}
+
+// PlanRemoveAction removes the action with the given ID from all plans and records it as skipped in the plan history. Returns removed action.
+// Action which has been already started is removed only if force is set, as it may have left the member in the intermediate state.
+func PlanRemoveAction(status *api.DeploymentStatus, id string, force bool) (api.Action, bool, error) {
+	for _, pg := range []planner{plannerHigh{}, plannerResources{}, plannerNormal{}} {
+		plan := pg.Get(status)
+
+		for idx, a := range plan {
+			if a.ID != id {
+				continue
+			}
+
+			if a.StartTime != nil && !force {
+				return a, false, errors.Errorf("Action %s is already started", id)
+			}
+
+			newPlan := append(plan[:idx:idx], plan[idx+1:]...)
+			if len(newPlan) > 0 && newPlan[0].MemberID == api.MemberIDPreviousAction {
+				newPlan[0].MemberID = a.MemberID
+			}
+
+			pg.Set(status, newPlan)
+
+			status.PlanHistory = status.PlanHistory.Append(api.DefaultPlanHistoryLimit, api.NewPlanHistoryEntry(a, pg.Type(), api.PlanHistoryResultSkipped, nil, meta.Now()))
+
+			return a, true, nil
+		}
+	}
+
+	return api.Action{}, false, nil
+}
+
+// PlanPausedMessage returns the message of the PlanPaused condition
+func PlanPausedMessage(status api.DeploymentStatus) string {
+	pending := PlanPendingActions(status)
+
+	if len(pending) == 0 {
+		return "Plan execution paused, no pending actions"
+	}
+
+	parts := make([]string, 0, len(pending))
+
+	for _, pg := range []planner{plannerHigh{}, plannerResources{}, plannerNormal{}} {
+		a, ok := pending[pg.Type()]
+		if !ok {
+			continue
+		}
+
+		part := fmt.Sprintf("%s: %s (%s)", pg.Type(), a.Type, a.ID)
+		if a.MemberID != "" {
+			part = fmt.Sprintf("%s on member %s (%s)", part, a.MemberID, a.Group.AsRole())
+		}
+
+		parts = append(parts, part)
+	}
+
+	return fmt.Sprintf("Plan execution paused, pending actions: %s", strings.Join(parts, ", "))
+}
@@ -0,0 +1,123 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func Test_PlanExecutionControl(t *testing.T) {
+	action := api.Action{ID: "a1", Type: api.ActionTypeRotateMember}
+
+	require.True(t, GetPlanExecutionControl(nil).Allowed(action))
+	require.True(t, GetPlanExecutionControl(&api.ArangoDeployment{}).Allowed(action))
+
+	obj := &api.ArangoDeployment{
+		ObjectMeta: meta.ObjectMeta{
+			Annotations: map[string]string{
+				deployment.ArangoDeploymentPlanPauseAnnotation: "true",
+			},
+		},
+	}
+
+	control := GetPlanExecutionControl(obj)
+	require.True(t, control.Paused)
+	require.False(t, control.Allowed(action))
+
+	obj.Annotations[deployment.ArangoDeploymentPlanStepAnnotation] = "a1"
+	control = GetPlanExecutionControl(obj)
+	require.True(t, control.Allowed(action))
+	require.False(t, control.Allowed(api.Action{ID: "a2"}))
+}
+
+func Test_PlanRemoveAction(t *testing.T) {
+	status := api.DeploymentStatus{
+		HighPriorityPlan: api.Plan{
+			{ID: "h1", Type: api.ActionTypeSetConditionV2},
+		},
+		Plan: api.Plan{
+			{ID: "n1", Type: api.ActionTypeRotateStartMember, MemberID: "prmr", Group: api.ServerGroupDBServers},
+			{ID: "n2", Type: api.ActionTypeRotateStopMember, MemberID: api.MemberIDPreviousAction, Group: api.ServerGroupDBServers},
+		},
+	}
+
+	first, ok := PlanFirstPendingAction(status)
+	require.True(t, ok)
+	require.Equal(t, "h1", first.ID)
+
+	require.Equal(t, "Plan execution paused, pending actions: high: SetConditionV2 (h1), normal: RotateStartMember (n1) on member prmr (dbserver)", PlanPausedMessage(status))
+
+	require.True(t, PlanContainsAction(status, "n1"))
+	require.False(t, PlanContainsAction(status, "unknown"))
+
+	_, ok, err := PlanRemoveAction(&status, "unknown", false)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	removed, ok, err := PlanRemoveAction(&status, "n1", false)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, api.ActionTypeRotateStartMember, removed.Type)
+	require.Len(t, status.Plan, 1)
+	require.Equal(t, "n2", status.Plan[0].ID)
+	require.Equal(t, "prmr", status.Plan[0].MemberID)
+	require.Len(t, status.PlanHistory, 1)
+	require.Equal(t, "n1", status.PlanHistory[0].ID)
+	require.Equal(t, "normal", status.PlanHistory[0].Priority)
+	require.Equal(t, api.PlanHistoryResultSkipped, status.PlanHistory[0].Result)
+
+	_, ok, err = PlanRemoveAction(&status, "h1", false)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Empty(t, status.HighPriorityPlan)
+
+	require.False(t, PlanContainsAction(status, "n1"))
+}
+
+func Test_PlanRemoveAction_Started(t *testing.T) {
+	status := api.DeploymentStatus{
+		Plan: api.Plan{
+			{ID: "n1", Type: api.ActionTypeRotateStartMember, MemberID: "prmr", Group: api.ServerGroupDBServers, StartTime: util.NewType(meta.Now())},
+		},
+	}
+
+	removed, ok, err := PlanRemoveAction(&status, "n1", false)
+	require.EqualError(t, err, "Action n1 is already started")
+	require.False(t, ok)
+	require.Equal(t, "n1", removed.ID)
+	require.Len(t, status.Plan, 1)
+	require.Empty(t, status.PlanHistory)
+
+	removed, ok, err = PlanRemoveAction(&status, "n1", true)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "n1", removed.ID)
+	require.Empty(t, status.Plan)
+	require.Len(t, status.PlanHistory, 1)
+	require.Equal(t, api.PlanHistoryResultSkipped, status.PlanHistory[0].Result)
+}
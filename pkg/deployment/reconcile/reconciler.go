@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2024 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -42,6 +42,9 @@ type Reconciler struct {
 	context         Context
 
 	metrics Metrics
+
+	// dryRun is set on the reconciler used to generate the plan in the dry-run mode
+	dryRun bool
 }
 
 // NewReconciler creates a new reconciler with given context.
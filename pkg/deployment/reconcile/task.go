@@ -0,0 +1,78 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"fmt"
+	"sync"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// taskPlanBuilder creates the plan which executes the ArangoTask on the deployment
+type taskPlanBuilder func(spec api.DeploymentSpec, status api.DeploymentStatus, task *api.ArangoTask) (api.Plan, error)
+
+var (
+	definedTaskPlanBuilders     = map[api.ArangoTaskType]taskPlanBuilder{}
+	definedTaskPlanBuildersLock sync.Mutex
+)
+
+func registerTaskPlanBuilder(t api.ArangoTaskType, f taskPlanBuilder) {
+	definedTaskPlanBuildersLock.Lock()
+	defer definedTaskPlanBuildersLock.Unlock()
+
+	_, ok := definedTaskPlanBuilders[t]
+	if ok {
+		panic(fmt.Sprintf("Task plan builder already defined %s", t))
+	}
+
+	definedTaskPlanBuilders[t] = f
+}
+
+func getTaskPlanBuilder(t api.ArangoTaskType) (taskPlanBuilder, bool) {
+	definedTaskPlanBuildersLock.Lock()
+	defer definedTaskPlanBuildersLock.Unlock()
+
+	f, ok := definedTaskPlanBuilders[t]
+	return f, ok
+}
+
+// getTaskMember returns the member selected in the task details
+func getTaskMember(status api.DeploymentStatus, task *api.ArangoTask, groups ...api.ServerGroup) (api.MemberStatus, api.ServerGroup, error) {
+	details, err := task.Spec.GetMemberDetails()
+	if err != nil {
+		return api.MemberStatus{}, api.ServerGroupUnknown, err
+	}
+
+	m, g, ok := status.Members.ElementByID(details.ID)
+	if !ok {
+		return api.MemberStatus{}, api.ServerGroupUnknown, errors.Errorf("Member %s not found", details.ID)
+	}
+
+	for _, group := range groups {
+		if group == g {
+			return m, g, nil
+		}
+	}
+
+	return api.MemberStatus{}, api.ServerGroupUnknown, errors.Errorf("Task %s is not supported on the member %s in group %s", task.Spec.Type, m.ID, g.AsRole())
+}
@@ -0,0 +1,37 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/actions"
+)
+
+func init() {
+	registerTaskPlanBuilder(api.ArangoTaskCollectDebugInfoType, createCollectDebugInfoTaskPlan)
+}
+
+// createCollectDebugInfoTaskPlan collects the debug information into the task status
+func createCollectDebugInfoTaskPlan(spec api.DeploymentSpec, status api.DeploymentStatus, task *api.ArangoTask) (api.Plan, error) {
+	return api.Plan{
+		actions.NewClusterAction(api.ActionTypeDebugInfoCollect, "Debug info requested by ArangoTask"),
+	}, nil
+}
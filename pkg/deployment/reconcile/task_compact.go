@@ -0,0 +1,57 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/actions"
+)
+
+func init() {
+	registerTaskPlanBuilder(api.ArangoTaskCompactType, createCompactTaskPlan)
+}
+
+// createCompactTaskPlan runs the Compact on the selected member or on all members which support it
+func createCompactTaskPlan(spec api.DeploymentSpec, status api.DeploymentStatus, task *api.ArangoTask) (api.Plan, error) {
+	details, err := task.Spec.GetMemberDetails()
+	if err != nil {
+		return nil, err
+	}
+
+	if details.ID != "" {
+		m, g, err := getTaskMember(status, task, api.ServerGroupDBServers, api.ServerGroupSingle)
+		if err != nil {
+			return nil, err
+		}
+
+		return api.Plan{
+			actions.NewAction(api.ActionTypeCompactMember, g, m, "Compact requested by ArangoTask"),
+		}, nil
+	}
+
+	var plan api.Plan
+
+	for _, e := range status.Members.AsListInGroups(api.ServerGroupDBServers, api.ServerGroupSingle) {
+		plan = append(plan, actions.NewAction(api.ActionTypeCompactMember, e.Group, e.Member, "Compact requested by ArangoTask"))
+	}
+
+	return plan, nil
+}
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2024 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -22,6 +22,8 @@ package resilience
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
@@ -32,15 +34,20 @@ import (
 const (
 	recentTerminationsSinceGracePeriod = time.Minute * 10
 	recentTerminationThreshold         = 5
+
+	dataAtRiskCollectionsLimit = 10
 )
 
 // CheckMemberFailure performs a check for members that should be in failed state because:
 // - They are frequently restarted
-// - They cannot be scheduled for a long time (TODO)
+// - They are not ready or cannot be scheduled for a long time (DBServers, only with spec.recovery.dbServerFailure enabled)
 func (r *Resilience) CheckMemberFailure(ctx context.Context) error {
 	status := r.context.GetStatus()
 	updateStatusNeeded := false
 
+	failurePolicy := r.context.GetSpec().Recovery.Get().DBServerFailure
+	var dataAtRisk []string
+
 	for _, e := range status.Members.AsList() {
 		m := e.Member
 		group := e.Group
@@ -83,6 +90,41 @@ func (r *Resilience) CheckMemberFailure(ctx context.Context) error {
 				}
 			}
 		}
+
+		if group == api.ServerGroupDBServers && failurePolicy.IsEnabled() {
+			if !m.Phase.IsFailed() && m.IsNotReadySince(time.Now().Add(-failurePolicy.GetTimeout())) {
+				if status.Plan.Filter(func(a api.Action) bool { return a.MemberID == m.ID }).IsEmpty() {
+					failureAcceptable, reason := r.isMemberFailureAcceptable(group, m)
+					if failureAcceptable {
+						log.Info("Member is not ready for %s, marking is failed", failurePolicy.GetTimeout())
+						m.Phase = api.MemberPhaseFailed
+						status.Members.Update(m, group)
+						updateStatusNeeded = true
+					} else {
+						log.Warn("Member is not ready for %s, but it is not safe to mark it a failed because: %s", failurePolicy.GetTimeout(), reason)
+					}
+				}
+			}
+
+			if !m.Phase.IsFailed() && !m.IsNotReadySince(time.Now().Add(-failurePolicy.GetTimeout())) {
+				continue
+			}
+
+			if agencyState, ok := r.context.GetAgencyCache(); ok {
+				if collections := agencyState.GetServerFailureImpact(state.Server(m.ID)).UnprotectedCollections(); len(collections) > 0 {
+					dataAtRisk = append(dataAtRisk, dataAtRiskMessage(m.ID, collections))
+				}
+			}
+		}
+	}
+
+	if failurePolicy.IsEnabled() && len(dataAtRisk) > 0 {
+		if status.Conditions.Update(api.ConditionTypeDBServerDataAtRisk, true, "Shards without replicas",
+			strings.Join(dataAtRisk, "; ")) {
+			updateStatusNeeded = true
+		}
+	} else if status.Conditions.Remove(api.ConditionTypeDBServerDataAtRisk) {
+		updateStatusNeeded = true
 	}
 
 	if updateStatusNeeded {
@@ -117,6 +159,16 @@ func (r *Resilience) isMemberFailureAcceptable(group api.ServerGroup, m api.Memb
 			return false, "AgencyHealth is not present"
 		}
 
+		if r.context.GetSpec().Recovery.Get().DBServerFailure.IsEnabled() {
+			// Shard-aware policy - DBServer can be replaced once all shards have been failed over by the agency supervision
+			if impact := agencyState.GetServerFailureImpact(state.Server(m.ID)); !impact.Safe() {
+				return false, fmt.Sprintf("DBServer has %d shards without replicas and %d shards without in-sync replicas",
+					len(impact.Unprotected), len(impact.NotInSync))
+			}
+
+			return agencyState.IsServerFailedOver(state.Server(m.ID))
+		}
+
 		if agencyState.Plan.Collections.IsDBServerPresent(state.Server(m.ID)) {
 			return false, "DBServer still in Plan"
 		}
@@ -142,3 +194,13 @@ func (r *Resilience) isMemberFailureAcceptable(group api.ServerGroup, m api.Memb
 		return false, "TODO"
 	}
 }
+
+// dataAtRiskMessage returns the message with the collections which are going to be lost with the member
+func dataAtRiskMessage(id string, collections []string) string {
+	if len(collections) > dataAtRiskCollectionsLimit {
+		return fmt.Sprintf("DBServer %s keeps the only copy of shards of collections: %s and %d more", id,
+			strings.Join(collections[:dataAtRiskCollectionsLimit], ", "), len(collections)-dataAtRiskCollectionsLimit)
+	}
+
+	return fmt.Sprintf("DBServer %s keeps the only copy of shards of collections: %s", id, strings.Join(collections, ", "))
+}
@@ -0,0 +1,353 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package resources
+
+import (
+	"context"
+	"net"
+	"sort"
+	"sync"
+
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/logging"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/crypto"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/certmanager"
+	inspectorInterface "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/generic"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/kerrors"
+	ktls "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/tls"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/tools"
+)
+
+// GetTLSCertificateKeyfile returns the keyfile built from the Secret issued by cert-manager.
+// Returns false when the certificate is not issued yet.
+func GetTLSCertificateKeyfile(secret *core.Secret) (string, bool) {
+	if secret == nil {
+		return "", false
+	}
+
+	cert, key := secret.Data[core.TLSCertKey], secret.Data[core.TLSPrivateKeyKey]
+	if len(cert) == 0 || len(key) == 0 {
+		return "", false
+	}
+
+	return ktls.AsKeyfile(string(cert), string(key)), true
+}
+
+// renderTLSCertManagerCertificate renders the cert-manager Certificate of the member.
+func renderTLSCertManagerCertificate(deploymentName string, names ktls.KeyfileInput, spec api.TLSSpec, group api.ServerGroup,
+	member *api.ArangoMember) *certmanager.Certificate {
+	var cert certmanager.Certificate
+
+	cert.SetName(k8sutil.AppendTLSCertificatePostfix(member.GetName()))
+	cert.SetLabels(k8sutil.LabelsForMember(deploymentName, group.AsRole(), member.Spec.ID))
+	cert.SetOwnerReferences([]meta.OwnerReference{member.AsOwner()})
+
+	for _, name := range names.AltNames {
+		if name == "" {
+			continue
+		}
+
+		if net.ParseIP(name) != nil {
+			cert.Spec.IPAddresses = append(cert.Spec.IPAddresses, name)
+		} else {
+			cert.Spec.DNSNames = append(cert.Spec.DNSNames, name)
+		}
+	}
+
+	for _, serverNames := range spec.GetSNI().Mapping {
+		cert.Spec.DNSNames = append(cert.Spec.DNSNames, serverNames...)
+	}
+
+	cert.Spec.DNSNames = util.UniqueList(cert.Spec.DNSNames)
+	sort.Strings(cert.Spec.DNSNames)
+	cert.Spec.IPAddresses = util.UniqueList(cert.Spec.IPAddresses)
+	cert.Spec.EmailAddresses = names.Email
+
+	cm := spec.CertManager
+
+	cert.Spec.SecretName = k8sutil.AppendTLSCertificateSecretPostfix(member.GetName())
+	cert.Spec.SecretTemplate = &certmanager.SecretTemplate{
+		Labels: k8sutil.LabelsForMember(deploymentName, group.AsRole(), member.Spec.ID),
+	}
+	cert.Spec.IssuerRef = certmanager.IssuerReference{
+		Name:  cm.GetIssuerRef().Name,
+		Kind:  string(cm.GetIssuerRef().GetKind()),
+		Group: cm.GetIssuerRef().GetGroup(),
+	}
+	cert.Spec.Duration = &meta.Duration{Duration: spec.GetTTL().AsDuration()}
+	if renewBefore := cm.GetRenewBefore(); renewBefore != "" {
+		cert.Spec.RenewBefore = &meta.Duration{Duration: renewBefore.AsDuration()}
+	}
+	cert.Spec.Usages = []string{"server auth", "client auth", "digital signature", "key encipherment"}
+	cert.Spec.PrivateKey = &certmanager.PrivateKeySpec{
+		RotationPolicy: "Always",
+		Algorithm:      "ECDSA",
+		Size:           256,
+	}
+
+	return &cert
+}
+
+// ensureTLSCertManagerCertificate ensures the cert-manager Certificate of the member is up to date and
+// creates the keyfile Secret from the issued certificate. Returns true when the keyfile Secret was created.
+func (r *Resources) ensureTLSCertManagerCertificate(ctx context.Context, log logging.Logger, cachedStatus inspectorInterface.Inspector,
+	secrets generic.ModClient[*core.Secret], names ktls.KeyfileInput, spec api.TLSSpec, group api.ServerGroup,
+	member *api.ArangoMember, keyfileSecretName string) (bool, error) {
+	expected := renderTLSCertManagerCertificate(r.context.GetAPIObject().GetName(), names, spec, group, member)
+	log = log.Str("certificate", expected.GetName())
+
+	hash, err := util.SHA256FromJSON(expected)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	// Certificate is fetched only when its spec changes or it is not issued yet, as it is not part of the inspector
+	if !r.certManagerCertificates.InSync(expected.GetName(), hash) {
+		certificates := certmanager.NewClient(cachedStatus.Client().Dynamic(), cachedStatus.Namespace())
+
+		if inSync, err := ensureTLSCertManagerCertificateSpec(ctx, log, certificates, member, expected); err != nil || !inSync {
+			return false, err
+		}
+
+		r.certManagerCertificates.Set(expected.GetName(), hash)
+	}
+
+	issued, exists := cachedStatus.Secret().V1().GetSimple(expected.Spec.SecretName)
+	if !exists {
+		log.Debug("Certificate is not issued yet")
+		r.certManagerCertificates.Invalidate(expected.GetName())
+		return false, nil
+	}
+
+	keyfile, ok := GetTLSCertificateKeyfile(issued)
+	if !ok {
+		log.Debug("Certificate is not issued yet")
+		r.certManagerCertificates.Invalidate(expected.GetName())
+		return false, nil
+	}
+
+	// The issued Secret is not removed by cert-manager together with the Certificate
+	if owner := member.AsOwner(); !tools.IsOwner(owner, issued) {
+		q := issued.DeepCopy()
+		q.OwnerReferences = append(q.OwnerReferences, owner)
+		err = globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+			_, err := secrets.Update(ctxChild, q, meta.UpdateOptions{})
+			return err
+		})
+		if err != nil {
+			return false, errors.Wrapf(err, "Unable to set owner of the Secret %s", issued.GetName())
+		}
+	}
+
+	if _, exists := cachedStatus.Secret().V1().GetSimple(keyfileSecretName); exists {
+		return false, nil
+	}
+
+	owner := member.AsOwner()
+	err = globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+		_, err := k8sutil.CreateTLSKeyfileSecret(ctxChild, secrets, keyfileSecretName, keyfile, &owner)
+		return err
+	})
+	if err != nil {
+		if kerrors.IsAlreadyExists(err) {
+			return false, nil
+		}
+		return false, errors.WithStack(err)
+	}
+
+	log.Str("secret", keyfileSecretName).Debug("Created server Secret from the issued certificate")
+	return true, nil
+}
+
+// ensureTLSCertManagerCertificateSpec creates or updates the cert-manager Certificate of the member.
+// Returns true when the Certificate is already up to date.
+func ensureTLSCertManagerCertificateSpec(ctx context.Context, log logging.Logger, certificates certmanager.Client,
+	member *api.ArangoMember, expected *certmanager.Certificate) (bool, error) {
+	current, err := util.WithKubernetesContextTimeoutP2A1(ctx, certificates.Get, expected.GetName())
+	if err != nil {
+		if !kerrors.IsNotFound(err) {
+			return false, errors.Wrapf(err, "Unable to get Certificate %s", expected.GetName())
+		}
+
+		err = globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+			_, err := certificates.Create(ctxChild, expected)
+			return err
+		})
+		if err != nil && !kerrors.IsAlreadyExists(err) {
+			return false, errors.Wrapf(err, "Unable to create Certificate %s", expected.GetName())
+		}
+
+		log.Debug("Created Certificate")
+		return false, nil
+	}
+
+	if !tools.IsOwner(member.AsOwner(), current) {
+		log.Warn("Certificate is not owned by the member, skipping update")
+		return true, nil
+	}
+
+	if equality.Semantic.DeepEqual(current.Spec, expected.Spec) {
+		return true, nil
+	}
+
+	current.Spec = expected.Spec
+	err = globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+		_, err := certificates.Update(ctxChild, current)
+		return err
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "Unable to update Certificate %s", expected.GetName())
+	}
+
+	log.Info("Updated Certificate")
+	return false, nil
+}
+
+// certManagerCertificatesCache keeps the hashes of the cert-manager Certificates which are known to be up to date
+type certManagerCertificatesCache struct {
+	lock   sync.Mutex
+	hashes map[string]string
+}
+
+// InSync returns true if the Certificate with the given spec hash is known to be up to date
+func (c *certManagerCertificatesCache) InSync(name, hash string) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.hashes[name] == hash
+}
+
+// Set marks the Certificate with the given spec hash as up to date
+func (c *certManagerCertificatesCache) Set(name, hash string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.hashes == nil {
+		c.hashes = map[string]string{}
+	}
+
+	c.hashes[name] = hash
+}
+
+// Invalidate forces the Certificate to be fetched in the next iteration
+func (c *certManagerCertificatesCache) Invalidate(name string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	delete(c.hashes, name)
+}
+
+// getTLSCertManagerCABundle returns the CA bundle built from the CA certificates of the Secrets issued for the members.
+func getTLSCertManagerCABundle(cachedStatus inspectorInterface.Inspector, memberNames []string) ([]byte, error) {
+	var bundle crypto.Certificates
+	known := map[string]bool{}
+
+	for _, name := range memberNames {
+		issued, exists := cachedStatus.Secret().V1().GetSimple(k8sutil.AppendTLSCertificateSecretPostfix(name))
+		if !exists {
+			continue
+		}
+
+		data, ok := issued.Data[certmanager.SecretCACertificate]
+		if !ok || len(data) == 0 {
+			continue
+		}
+
+		certs, err := crypto.ParseCertificates(data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Unable to parse CA of the Secret %s", issued.GetName())
+		}
+
+		for _, cert := range certs {
+			sha := util.SHA256(cert.Raw)
+			if known[sha] {
+				continue
+			}
+
+			known[sha] = true
+			bundle = append(bundle, cert)
+		}
+	}
+
+	if len(bundle) == 0 {
+		return nil, nil
+	}
+
+	sort.Slice(bundle, func(i, j int) bool {
+		return bundle[i].NotBefore.Before(bundle[j].NotBefore)
+	})
+
+	return bundle.ToPem()
+}
+
+// ensureTLSCertManagerCASecret keeps the CA Secret in sync with the CA certificates of the issuer.
+// Secrets which are not owned by the deployment are provided by the user and are not modified.
+func (r *Resources) ensureTLSCertManagerCASecret(ctx context.Context, cachedStatus inspectorInterface.Inspector,
+	secrets generic.ModClient[*core.Secret], spec api.TLSSpec, memberNames []string) error {
+	bundle, err := getTLSCertManagerCABundle(cachedStatus, memberNames)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if len(bundle) == 0 {
+		// Issuer does not provide the CA
+		return nil
+	}
+
+	caSecret, exists := cachedStatus.Secret().V1().GetSimple(spec.GetCASecretName())
+	if !exists {
+		return r.createSecretWithKey(ctx, secrets, spec.GetCASecretName(), CACertName, bundle)
+	}
+
+	if !tools.IsOwner(r.context.GetAPIObject().AsOwner(), caSecret) {
+		return nil
+	}
+
+	if _, hasKey := caSecret.Data[CAKeyName]; !hasKey && string(caSecret.Data[CACertName]) == string(bundle) {
+		return nil
+	}
+
+	q := caSecret.DeepCopy()
+	q.Data = map[string][]byte{
+		CACertName: bundle,
+	}
+
+	r.log.Str("section", "tls").Str("secret", spec.GetCASecretName()).Info("Updating CA bundle from the issued certificates")
+
+	err = globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+		_, err := secrets.Update(ctxChild, q, meta.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.Reconcile()
+}
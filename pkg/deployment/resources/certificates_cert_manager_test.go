@@ -0,0 +1,223 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package resources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/certmanager"
+	ktls "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/tls"
+)
+
+type testCertificates struct {
+	certificates map[string]*certmanager.Certificate
+
+	gets, creates, updates int
+}
+
+func (t *testCertificates) Get(ctx context.Context, name string) (*certmanager.Certificate, error) {
+	t.gets++
+
+	if c, ok := t.certificates[name]; ok {
+		return testCertificateCopy(c)
+	}
+
+	return nil, apiErrors.NewNotFound(certmanager.CertificateGVR().GroupResource(), name)
+}
+
+func (t *testCertificates) Create(ctx context.Context, cert *certmanager.Certificate) (*certmanager.Certificate, error) {
+	t.creates++
+
+	if t.certificates == nil {
+		t.certificates = map[string]*certmanager.Certificate{}
+	}
+
+	t.certificates[cert.GetName()] = cert
+	return testCertificateCopy(cert)
+}
+
+func (t *testCertificates) Update(ctx context.Context, cert *certmanager.Certificate) (*certmanager.Certificate, error) {
+	t.updates++
+
+	t.certificates[cert.GetName()] = cert
+	return testCertificateCopy(cert)
+}
+
+func testCertificateCopy(in *certmanager.Certificate) (*certmanager.Certificate, error) {
+	obj, err := in.ToUnstructured()
+	if err != nil {
+		return nil, err
+	}
+
+	return certmanager.FromUnstructured(obj)
+}
+
+func Test_RenderTLSCertManagerCertificate(t *testing.T) {
+	member := &api.ArangoMember{
+		ObjectMeta: meta.ObjectMeta{
+			Name:      "example-prmr-abcdef",
+			Namespace: "default",
+			UID:       types.UID("uid"),
+		},
+		Spec: api.ArangoMemberSpec{
+			ID: "PRMR-abcdef",
+		},
+	}
+
+	spec := api.TLSSpec{
+		CASecretName: util.NewType("example-ca"),
+		TTL:          api.NewDuration("720h"),
+		SNI: &api.TLSSNISpec{
+			Mapping: map[string][]string{
+				"sni": {"db.example.com"},
+			},
+		},
+		CertManager: &api.TLSCertManagerSpec{
+			IssuerRef: api.TLSCertManagerIssuerRef{
+				Name: "corporate",
+				Kind: util.NewType(api.TLSCertManagerIssuerKindClusterIssuer),
+			},
+			RenewBefore: api.NewDuration("240h"),
+		},
+	}
+
+	names := ktls.KeyfileInput{
+		AltNames: []string{"example.default.svc", "example-prmr-abcdef.default.svc", "10.0.0.1", "example-prmr-abcdef", "example.default.svc"},
+		Email:    []string{"admin@example.com"},
+	}
+
+	cert := renderTLSCertManagerCertificate("example", names, spec, api.ServerGroupDBServers, member)
+
+	require.Equal(t, "example-prmr-abcdef-tls", cert.GetName())
+	require.Len(t, cert.GetOwnerReferences(), 1)
+	require.Equal(t, member.GetUID(), cert.GetOwnerReferences()[0].UID)
+
+	require.Equal(t, "example-prmr-abcdef-tls-certificate", cert.Spec.SecretName)
+	require.Equal(t, []string{"db.example.com", "example-prmr-abcdef", "example-prmr-abcdef.default.svc", "example.default.svc"}, cert.Spec.DNSNames)
+	require.Equal(t, []string{"10.0.0.1"}, cert.Spec.IPAddresses)
+	require.Equal(t, []string{"admin@example.com"}, cert.Spec.EmailAddresses)
+
+	require.Equal(t, "corporate", cert.Spec.IssuerRef.Name)
+	require.Equal(t, "ClusterIssuer", cert.Spec.IssuerRef.Kind)
+	require.Equal(t, "cert-manager.io", cert.Spec.IssuerRef.Group)
+
+	require.NotNil(t, cert.Spec.Duration)
+	require.Equal(t, 720*time.Hour, cert.Spec.Duration.Duration)
+	require.NotNil(t, cert.Spec.RenewBefore)
+	require.Equal(t, 240*time.Hour, cert.Spec.RenewBefore.Duration)
+
+	spec.CertManager.RenewBefore = nil
+	require.Nil(t, renderTLSCertManagerCertificate("example", names, spec, api.ServerGroupDBServers, member).Spec.RenewBefore)
+}
+
+func Test_GetTLSCertificateKeyfile(t *testing.T) {
+	_, ok := GetTLSCertificateKeyfile(nil)
+	require.False(t, ok)
+
+	_, ok = GetTLSCertificateKeyfile(&core.Secret{
+		Data: map[string][]byte{
+			core.TLSCertKey: []byte("cert"),
+		},
+	})
+	require.False(t, ok)
+
+	keyfile, ok := GetTLSCertificateKeyfile(&core.Secret{
+		Data: map[string][]byte{
+			core.TLSCertKey:       []byte("cert\n"),
+			core.TLSPrivateKeyKey: []byte("key\n"),
+		},
+	})
+	require.True(t, ok)
+	require.Equal(t, "cert\nkey", keyfile)
+}
+
+func Test_EnsureTLSCertManagerCertificateSpec(t *testing.T) {
+	member := &api.ArangoMember{
+		ObjectMeta: meta.ObjectMeta{
+			Name:      "example-prmr-abcdef",
+			Namespace: "default",
+			UID:       types.UID("uid"),
+		},
+		Spec: api.ArangoMemberSpec{
+			ID: "PRMR-abcdef",
+		},
+	}
+
+	spec := api.TLSSpec{
+		CertManager: &api.TLSCertManagerSpec{
+			IssuerRef: api.TLSCertManagerIssuerRef{
+				Name: "corporate",
+			},
+		},
+	}
+
+	names := ktls.KeyfileInput{
+		AltNames: []string{"example.default.svc"},
+	}
+
+	certificates := &testCertificates{}
+	expected := renderTLSCertManagerCertificate("example", names, spec, api.ServerGroupDBServers, member)
+
+	inSync, err := ensureTLSCertManagerCertificateSpec(context.Background(), logger, certificates, member, expected)
+	require.NoError(t, err)
+	require.False(t, inSync)
+	require.Equal(t, 1, certificates.creates)
+
+	inSync, err = ensureTLSCertManagerCertificateSpec(context.Background(), logger, certificates, member, expected)
+	require.NoError(t, err)
+	require.True(t, inSync)
+	require.Equal(t, 0, certificates.updates)
+
+	names.AltNames = append(names.AltNames, "db.example.com")
+	expected = renderTLSCertManagerCertificate("example", names, spec, api.ServerGroupDBServers, member)
+
+	inSync, err = ensureTLSCertManagerCertificateSpec(context.Background(), logger, certificates, member, expected)
+	require.NoError(t, err)
+	require.False(t, inSync)
+	require.Equal(t, 1, certificates.updates)
+	require.Equal(t, []string{"db.example.com", "example.default.svc"}, certificates.certificates[expected.GetName()].Spec.DNSNames)
+
+	require.Equal(t, 3, certificates.gets)
+}
+
+func Test_CertManagerCertificatesCache(t *testing.T) {
+	var c certManagerCertificatesCache
+
+	require.False(t, c.InSync("cert", "a"))
+
+	c.Set("cert", "a")
+	require.True(t, c.InSync("cert", "a"))
+	require.False(t, c.InSync("cert", "b"))
+	require.False(t, c.InSync("other", "a"))
+
+	c.Invalidate("cert")
+	require.False(t, c.InSync("cert", "a"))
+}
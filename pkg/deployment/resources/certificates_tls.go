@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2024 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -30,6 +30,7 @@ import (
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 	"github.com/arangodb/kube-arangodb/pkg/logging"
 	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/crypto"
 	"github.com/arangodb/kube-arangodb/pkg/util/errors"
 	"github.com/arangodb/kube-arangodb/pkg/util/globals"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
@@ -39,6 +40,17 @@ import (
 	ktls "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/tls"
 )
 
+// GetTLSCACertificates returns the CA certificates from the CA secret.
+// With cert-manager the secret holds only the CA bundle, without the private key.
+func GetTLSCACertificates(spec api.TLSSpec, secret *core.Secret) (crypto.Certificates, error) {
+	if spec.IsCertManager() {
+		return k8sutil.GetCertsFromSecret(secret, CACertName)
+	}
+
+	ca, _, err := k8sutil.GetKeyCertFromSecret(secret, CACertName, CAKeyName)
+	return ca, err
+}
+
 // createTLSCACertificate creates a CA certificate and stores it in a secret with name
 // specified in the given spec.
 func (r *Resources) createTLSCACertificate(ctx context.Context, secrets generic.ModClient[*core.Secret], spec api.TLSSpec,
@@ -507,6 +507,50 @@ func (r *Resources) renderGatewayConfig(cachedStatus inspectorInterface.Inspecto
 					}
 					dest.Path = util.NewType(target.Path)
 					dest.Timeout = target.Timeout.DeepCopy()
+					if limits := target.Limits; limits != nil {
+						dest.Limits = &gateway.ConfigDestinationLimits{
+							MaxConnections:     util.NewTypeOrNil(limits.MaxConnections),
+							MaxPendingRequests: util.NewTypeOrNil(limits.MaxPendingRequests),
+							MaxRequests:        util.NewTypeOrNil(limits.MaxRequests),
+						}
+						if rate := limits.RateLimit; rate != nil {
+							dest.Limits.RateLimit = &gateway.ConfigDestinationRateLimit{
+								RequestsPerSecond: rate.RequestsPerSecond,
+								Burst:             util.NewType(rate.Burst),
+								PerUser:           rate.Key == networkingApi.ArangoRouteSpecDestinationLimitsKeyUser,
+							}
+						}
+					}
+					if backends := target.Backends; len(backends) > 0 {
+						dest.Weight = util.NewType(target.Weight)
+						for _, backend := range backends {
+							if !backend.Ready || len(backend.Destinations) == 0 {
+								// Backends which are not ready do not receive the traffic
+								continue
+							}
+
+							b := gateway.ConfigDestinationBackend{
+								Name:   backend.Name,
+								Weight: backend.Weight,
+							}
+
+							for _, destination := range backend.Destinations {
+								b.Targets = append(b.Targets, gateway.ConfigDestinationTargetEndpoint{
+									Host: destination.Host,
+									Port: destination.Port,
+								})
+							}
+
+							if match := backend.Match; match != nil {
+								b.Match = &gateway.ConfigDestinationBackendMatch{
+									Headers: renderGatewayBackendMatchRules(match.Headers),
+									Cookies: renderGatewayBackendMatchRules(match.Cookies),
+								}
+							}
+
+							dest.Backends = append(dest.Backends, b)
+						}
+					}
 					dest.AuthExtension = &gateway.ConfigAuthZExtension{
 						AuthZExtension: map[string]string{
 							pbImplEnvoyAuthV3Shared.AuthConfigAuthRequiredKey: util.BoolSwitch[string](target.Authentication.Type.Get() == networkingApi.ArangoRouteSpecAuthenticationTypeRequired, pbImplEnvoyAuthV3Shared.AuthConfigKeywordTrue, pbImplEnvoyAuthV3Shared.AuthConfigKeywordFalse),
@@ -581,3 +625,16 @@ func (r *Resources) renderGatewayConfig(cachedStatus inspectorInterface.Inspecto
 
 	return &inventory, cfg, nil
 }
+
+func renderGatewayBackendMatchRules(in networkingApi.ArangoRouteStatusTargetBackendMatchRules) []gateway.ConfigDestinationBackendMatchRule {
+	if len(in) == 0 {
+		return nil
+	}
+
+	return util.FormatList(in, func(a networkingApi.ArangoRouteStatusTargetBackendMatchRule) gateway.ConfigDestinationBackendMatchRule {
+		return gateway.ConfigDestinationBackendMatchRule{
+			Name:  a.Name,
+			Value: util.NewTypeOrNil(a.Value),
+		}
+	})
+}
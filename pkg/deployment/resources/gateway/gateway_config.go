@@ -22,6 +22,7 @@ package gateway
 
 import (
 	"fmt"
+	"math"
 	"sort"
 	"time"
 
@@ -31,12 +32,15 @@ import (
 	pbEnvoyEndpointV3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	pbEnvoyListenerV3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	pbEnvoyRouteV3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	pbEnvoyCommonRateLimitV3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
 	httpFilterAuthzApi "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_authz/v3"
+	httpFilterLocalRateLimitApi "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
 	routerAPI "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/router/v3"
 	tlsInspectorApi "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/listener/tls_inspector/v3"
 	httpConnectionManagerAPI "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	upstreamHttpApi "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
 	discoveryApi "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -68,6 +72,13 @@ func (c Config) Validate() error {
 		shared.ValidateOptionalInterfacePath("integrationSidecar", c.IntegrationSidecar),
 		shared.PrefixResourceErrors("destinations", c.Destinations.Validate()),
 		shared.PrefixResourceErrors("sni", c.SNI.Validate()),
+		shared.PrefixResourceErrorFunc("integrationSidecar", func() error {
+			// User header is trusted only when it is set by the integration sidecar
+			if c.IntegrationSidecar == nil && c.hasPerUserRateLimit() {
+				return errors.Errorf("IntegrationSidecar is required for the per-user rate limits")
+			}
+			return nil
+		}),
 	)
 }
 
@@ -224,15 +235,12 @@ func (c Config) RenderClusters() ([]*pbEnvoyClusterV3.Cluster, error) {
 
 	for k, v := range c.Destinations {
 		name := fmt.Sprintf("cluster_%s", util.SHA256FromString(k))
-		c, err := v.RenderCluster(name)
+		c, err := v.RenderClusters(name)
 		if err != nil {
 			return nil, err
 		}
 
-		if c == nil {
-			continue
-		}
-		clusters = append(clusters, c)
+		clusters = append(clusters, c...)
 	}
 
 	sort.Slice(clusters, func(i, j int) bool {
@@ -253,12 +261,12 @@ func (c Config) RenderRoutes() ([]*pbEnvoyRouteV3.Route, error) {
 
 	for k, v := range c.Destinations {
 		name := fmt.Sprintf("cluster_%s", util.SHA256FromString(k))
-		c, err := v.RenderRoute(name, k)
+		c, err := v.RenderRoutes(name, k)
 		if err != nil {
 			return nil, err
 		}
 
-		routes = append(routes, c)
+		routes = append(routes, c...)
 	}
 
 	sort.SliceStable(routes, func(i, j int) bool {
@@ -335,6 +343,99 @@ func (c Config) hasWebSocketUpgrade() bool {
 	return false
 }
 
+// hasRateLimit reports whether any destination defines a request rate limit. The local rate limit
+// filter is only added to the filter chain when it is required by at least one route.
+func (c Config) hasRateLimit() bool {
+	if c.DefaultDestination.hasRateLimit() {
+		return true
+	}
+
+	for _, d := range c.Destinations {
+		if d.hasRateLimit() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasPerUserRateLimit reports whether any destination defines a request rate limit keyed by the user.
+func (c Config) hasPerUserRateLimit() bool {
+	if c.DefaultDestination.Limits.GetRateLimit().IsPerUser() {
+		return true
+	}
+
+	for _, d := range c.Destinations {
+		if d.Limits.GetRateLimit().IsPerUser() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c Config) RenderLocalRateLimitFilter() (*httpConnectionManagerAPI.HttpFilter, error) {
+	// Token buckets of the destinations are defined once, so they are shared by all routes of the destination
+	descriptors := c.DefaultDestination.Limits.GetRateLimit().RenderDescriptors("default")
+
+	var users uint32
+
+	if c.DefaultDestination.Limits.GetRateLimit().IsPerUser() {
+		users += ConfigDestinationRateLimitMaxUsers
+	}
+
+	for _, k := range util.SortKeys(c.Destinations) {
+		r := c.Destinations[k].Limits.GetRateLimit()
+
+		descriptors = append(descriptors, r.RenderDescriptors(fmt.Sprintf("cluster_%s", util.SHA256FromString(k)))...)
+
+		if r.IsPerUser() {
+			users += ConfigDestinationRateLimitMaxUsers
+		}
+	}
+
+	l := &httpFilterLocalRateLimitApi.LocalRateLimit{
+		StatPrefix: "http_local_rate_limiter",
+		// Requests without the destination descriptor are not limited
+		TokenBucket: &typev3.TokenBucket{
+			MaxTokens:     math.MaxUint32,
+			TokensPerFill: wrapperspb.UInt32(math.MaxUint32),
+			FillInterval:  durationpb.New(time.Second),
+		},
+		FilterEnabled: &pbEnvoyCoreV3.RuntimeFractionalPercent{
+			DefaultValue: &typev3.FractionalPercent{
+				Numerator:   100,
+				Denominator: typev3.FractionalPercent_HUNDRED,
+			},
+		},
+		FilterEnforced: &pbEnvoyCoreV3.RuntimeFractionalPercent{
+			DefaultValue: &typev3.FractionalPercent{
+				Numerator:   100,
+				Denominator: typev3.FractionalPercent_HUNDRED,
+			},
+		},
+		Descriptors:                     descriptors,
+		AlwaysConsumeDefaultTokenBucket: wrapperspb.Bool(false),
+		EnableXRatelimitHeaders:         pbEnvoyCommonRateLimitV3.XRateLimitHeadersRFCVersion_DRAFT_VERSION_03,
+	}
+
+	if users > 0 {
+		l.MaxDynamicDescriptors = wrapperspb.UInt32(users)
+	}
+
+	e, err := anypb.New(l)
+	if err != nil {
+		return nil, err
+	}
+
+	return &httpConnectionManagerAPI.HttpFilter{
+		Name: utilConstants.EnvoyLocalRateLimitFilterName,
+		ConfigType: &httpConnectionManagerAPI.HttpFilter_TypedConfig{
+			TypedConfig: e,
+		},
+	}, nil
+}
+
 func (c Config) RenderFilters() ([]*pbEnvoyListenerV3.Filter, error) {
 	httpFilterConfigType, err := anypb.New(&routerAPI.Router{})
 	if err != nil {
@@ -356,6 +457,15 @@ func (c Config) RenderFilters() ([]*pbEnvoyListenerV3.Filter, error) {
 		httpFilters = append(httpFilters, q)
 	}
 
+	// Rate limit is evaluated after the authentication, so the user header is already set
+	if c.hasRateLimit() {
+		q, err := c.RenderLocalRateLimitFilter()
+		if err != nil {
+			return nil, err
+		}
+		httpFilters = append(httpFilters, q)
+	}
+
 	httpConnectionManager := &httpConnectionManagerAPI.HttpConnectionManager{
 		StatPrefix:                 "ingress_http",
 		CodecType:                  httpConnectionManagerAPI.HttpConnectionManager_AUTO,
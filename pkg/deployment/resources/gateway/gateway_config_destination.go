@@ -89,6 +89,12 @@ type ConfigDestination struct {
 	File ConfigDestinationFileInterface `json:"file,omitempty"`
 
 	Redirect *ConfigDestinationRedirect `json:"redirect,omitempty"`
+
+	Limits *ConfigDestinationLimits `json:"limits,omitempty"`
+
+	Weight *uint32 `json:"weight,omitempty"`
+
+	Backends ConfigDestinationBackends `json:"backends,omitempty"`
 }
 
 func (c *ConfigDestination) Validate() error {
@@ -130,6 +136,8 @@ func (c *ConfigDestination) Validate() error {
 			shared.PrefixResourceError("pathType", shared.ValidateOptionalInterface(c.Match)),
 			shared.PrefixResourceError("authExtension", c.AuthExtension.Validate()),
 			shared.PrefixResourceError("upgradeConfigs", c.UpgradeConfigs.Validate()),
+			shared.PrefixResourceError("limits", c.Limits.Validate()),
+			shared.PrefixResourceError("backends", c.Backends.Validate()),
 			shared.PrefixResourceErrorFunc("timeout", func() error {
 				if t := c.GetTimeout(); t < utilConstants.MinEnvoyUpstreamTimeout {
 					return errors.Errorf("Timeout lower than %s not allowed", utilConstants.MinEnvoyUpstreamTimeout.String())
@@ -197,6 +205,10 @@ func (c *ConfigDestination) RenderRoute(name, prefix string) (*pbEnvoyRouteV3.Ro
 		return nil, err
 	}
 
+	if action := r.GetRoute(); action != nil {
+		action.RateLimits = c.Limits.GetRateLimit().RenderRateLimits(name)
+	}
+
 	return r, nil
 }
 
@@ -293,16 +305,17 @@ func (c *ConfigDestination) appendRouteAction(route *pbEnvoyRouteV3.Route, name
 		return errors.Errorf("Unable to render redirection action")
 	}
 
+	action := &pbEnvoyRouteV3.RouteAction{
+		UpgradeConfigs: c.getUpgradeConfigs().render(),
+		PrefixRewrite:  c.GetPath(),
+		Timeout:        durationpb.New(c.GetTimeout()),
+		IdleTimeout:    durationpb.New(c.GetTimeout()),
+	}
+
+	c.applyClusterSpecifier(action, name)
+
 	route.Action = &pbEnvoyRouteV3.Route_Route{
-		Route: &pbEnvoyRouteV3.RouteAction{
-			ClusterSpecifier: &pbEnvoyRouteV3.RouteAction_Cluster{
-				Cluster: name,
-			},
-			UpgradeConfigs: c.getUpgradeConfigs().render(),
-			PrefixRewrite:  c.GetPath(),
-			Timeout:        durationpb.New(c.GetTimeout()),
-			IdleTimeout:    durationpb.New(c.GetTimeout()),
-		},
+		Route: action,
 	}
 	return nil
 }
@@ -326,6 +339,11 @@ func (c ConfigDestination) hasWebSocketUpgrade() bool {
 	return false
 }
 
+// hasRateLimit reports whether the destination defines a request rate limit.
+func (c ConfigDestination) hasRateLimit() bool {
+	return c.Limits.GetRateLimit() != nil
+}
+
 func (c *ConfigDestination) RenderCluster(name string) (*pbEnvoyClusterV3.Cluster, error) {
 	if c.Type.Get() == ConfigDestinationTypeStatic {
 		return nil, nil
@@ -349,7 +367,8 @@ func (c *ConfigDestination) RenderCluster(name string) (*pbEnvoyClusterV3.Cluste
 				},
 			},
 		},
-		HealthChecks: c.HealthChecks.Render(),
+		HealthChecks:    c.HealthChecks.Render(),
+		CircuitBreakers: c.Limits.RenderCircuitBreakers(),
 		TypedExtensionProtocolOptions: map[string]*anypb.Any{
 			"envoy.extensions.upstreams.http.v3.HttpProtocolOptions": hpo,
 		},
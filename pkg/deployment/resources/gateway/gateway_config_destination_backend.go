@@ -0,0 +1,286 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package gateway
+
+import (
+	"fmt"
+	"regexp"
+
+	pbEnvoyClusterV3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	pbEnvoyRouteV3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	pbEnvoyMatcherV3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	shared "github.com/arangodb/kube-arangodb/pkg/apis/shared"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// ConfigDestinationWeightDefault defines the weight of the destination if not specified
+const ConfigDestinationWeightDefault uint32 = 100
+
+type ConfigDestinationBackends []ConfigDestinationBackend
+
+func (c ConfigDestinationBackends) Validate() error {
+	return shared.ValidateList(c, func(backend ConfigDestinationBackend) error {
+		return backend.Validate()
+	}, func(in []ConfigDestinationBackend) error {
+		names := map[string]bool{}
+
+		for _, backend := range in {
+			if names[backend.Name] {
+				return errors.Errorf("Backend name `%s` is not unique", backend.Name)
+			}
+			names[backend.Name] = true
+		}
+
+		return nil
+	})
+}
+
+type ConfigDestinationBackend struct {
+	Name string `json:"name"`
+
+	Targets ConfigDestinationTargets `json:"targets,omitempty"`
+
+	Weight uint32 `json:"weight,omitempty"`
+
+	Match *ConfigDestinationBackendMatch `json:"match,omitempty"`
+}
+
+// ClusterName returns the name of the backend cluster
+func (c ConfigDestinationBackend) ClusterName(name string) string {
+	return fmt.Sprintf("%s_%s", name, c.Name)
+}
+
+func (c ConfigDestinationBackend) Validate() error {
+	return shared.WithErrors(
+		shared.PrefixResourceError("name", shared.ValidateResourceName(c.Name)),
+		shared.PrefixResourceError("targets", c.Targets.Validate()),
+		shared.PrefixResourceError("match", c.Match.Validate()),
+	)
+}
+
+type ConfigDestinationBackendMatch struct {
+	Headers []ConfigDestinationBackendMatchRule `json:"headers,omitempty"`
+
+	Cookies []ConfigDestinationBackendMatchRule `json:"cookies,omitempty"`
+}
+
+func (c *ConfigDestinationBackendMatch) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	if len(c.Headers) == 0 && len(c.Cookies) == 0 {
+		return errors.Errorf("At least one header or cookie rule is required")
+	}
+
+	return shared.WithErrors(
+		shared.PrefixResourceError("headers", shared.ValidateInterfaceList(c.Headers)),
+		shared.PrefixResourceError("cookies", shared.ValidateInterfaceList(c.Cookies)),
+	)
+}
+
+// Render returns the header matchers, all of them need to match the request
+func (c *ConfigDestinationBackendMatch) Render() []*pbEnvoyRouteV3.HeaderMatcher {
+	if c == nil {
+		return nil
+	}
+
+	var r []*pbEnvoyRouteV3.HeaderMatcher
+
+	for _, h := range c.Headers {
+		if h.Value == nil {
+			r = append(r, &pbEnvoyRouteV3.HeaderMatcher{
+				Name: h.Name,
+				HeaderMatchSpecifier: &pbEnvoyRouteV3.HeaderMatcher_PresentMatch{
+					PresentMatch: true,
+				},
+			})
+			continue
+		}
+
+		r = append(r, &pbEnvoyRouteV3.HeaderMatcher{
+			Name: h.Name,
+			HeaderMatchSpecifier: &pbEnvoyRouteV3.HeaderMatcher_StringMatch{
+				StringMatch: &pbEnvoyMatcherV3.StringMatcher{
+					MatchPattern: &pbEnvoyMatcherV3.StringMatcher_Exact{
+						Exact: *h.Value,
+					},
+				},
+			},
+		})
+	}
+
+	for _, h := range c.Cookies {
+		// Regex needs to match the whole Cookie header
+		value := ".*"
+		if h.Value != nil {
+			value = fmt.Sprintf("%s(;.*)?", regexp.QuoteMeta(*h.Value))
+		}
+
+		r = append(r, &pbEnvoyRouteV3.HeaderMatcher{
+			Name: "cookie",
+			HeaderMatchSpecifier: &pbEnvoyRouteV3.HeaderMatcher_StringMatch{
+				StringMatch: &pbEnvoyMatcherV3.StringMatcher{
+					MatchPattern: &pbEnvoyMatcherV3.StringMatcher_SafeRegex{
+						SafeRegex: &pbEnvoyMatcherV3.RegexMatcher{
+							Regex: fmt.Sprintf(`(.*;\s*)?%s=%s`, regexp.QuoteMeta(h.Name), value),
+						},
+					},
+				},
+			},
+		})
+	}
+
+	return r
+}
+
+type ConfigDestinationBackendMatchRule struct {
+	Name string `json:"name"`
+
+	Value *string `json:"value,omitempty"`
+}
+
+func (c ConfigDestinationBackendMatchRule) Validate() error {
+	return shared.PrefixResourceErrorFunc("name", func() error {
+		if c.Name == "" {
+			return errors.Errorf("Empty string not allowed")
+		}
+		return nil
+	})
+}
+
+func (c *ConfigDestination) GetWeight() uint32 {
+	if c == nil || c.Weight == nil {
+		return ConfigDestinationWeightDefault
+	}
+
+	return *c.Weight
+}
+
+// RenderRoutes renders the routes of the destination. Routes of the backends with the matching rules are returned first,
+// so they take precedence over the destination route
+func (c *ConfigDestination) RenderRoutes(name, prefix string) ([]*pbEnvoyRouteV3.Route, error) {
+	var routes []*pbEnvoyRouteV3.Route
+
+	for _, backend := range c.Backends {
+		if backend.Match == nil {
+			continue
+		}
+
+		r, err := c.RenderRoute(name, prefix)
+		if err != nil {
+			return nil, err
+		}
+
+		r.Match.Headers = backend.Match.Render()
+
+		if action := r.GetRoute(); action != nil {
+			action.ClusterSpecifier = &pbEnvoyRouteV3.RouteAction_Cluster{
+				Cluster: backend.ClusterName(name),
+			}
+		}
+
+		routes = append(routes, r)
+	}
+
+	r, err := c.RenderRoute(name, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(routes, r), nil
+}
+
+// RenderClusters renders the clusters of the destination and its backends
+func (c *ConfigDestination) RenderClusters(name string) ([]*pbEnvoyClusterV3.Cluster, error) {
+	cluster, err := c.RenderCluster(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if cluster == nil {
+		return nil, nil
+	}
+
+	clusters := []*pbEnvoyClusterV3.Cluster{
+		cluster,
+	}
+
+	for _, backend := range c.Backends {
+		// Backend shares the connection settings with the destination
+		d := *c
+		d.Targets = backend.Targets
+		d.Backends = nil
+
+		cluster, err := d.RenderCluster(backend.ClusterName(name))
+		if err != nil {
+			return nil, err
+		}
+
+		clusters = append(clusters, cluster)
+	}
+
+	return clusters, nil
+}
+
+// applyClusterSpecifier sets the route cluster. Traffic is split between the destination and the backends according to the weights
+func (c *ConfigDestination) applyClusterSpecifier(action *pbEnvoyRouteV3.RouteAction, name string) {
+	var weighted []*pbEnvoyRouteV3.WeightedCluster_ClusterWeight
+
+	if len(c.Backends) > 0 {
+		if w := c.GetWeight(); w > 0 {
+			weighted = append(weighted, &pbEnvoyRouteV3.WeightedCluster_ClusterWeight{
+				Name:   name,
+				Weight: wrapperspb.UInt32(w),
+			})
+		}
+
+		for _, backend := range c.Backends {
+			if backend.Weight == 0 {
+				continue
+			}
+
+			weighted = append(weighted, &pbEnvoyRouteV3.WeightedCluster_ClusterWeight{
+				Name:   backend.ClusterName(name),
+				Weight: wrapperspb.UInt32(backend.Weight),
+			})
+		}
+	}
+
+	switch len(weighted) {
+	case 0:
+		action.ClusterSpecifier = &pbEnvoyRouteV3.RouteAction_Cluster{
+			Cluster: name,
+		}
+	case 1:
+		action.ClusterSpecifier = &pbEnvoyRouteV3.RouteAction_Cluster{
+			Cluster: weighted[0].GetName(),
+		}
+	default:
+		action.ClusterSpecifier = &pbEnvoyRouteV3.RouteAction_WeightedClusters{
+			WeightedClusters: &pbEnvoyRouteV3.WeightedCluster{
+				Clusters: weighted,
+			},
+		}
+	}
+}
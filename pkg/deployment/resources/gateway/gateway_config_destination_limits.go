@@ -0,0 +1,285 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package gateway
+
+import (
+	"time"
+
+	pbEnvoyClusterV3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	pbEnvoyCoreV3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	pbEnvoyRouteV3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	pbEnvoyCommonRateLimitV3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	pbImplEnvoyAuthV3Shared "github.com/arangodb/kube-arangodb/integrations/envoy/auth/v3/shared"
+	shared "github.com/arangodb/kube-arangodb/pkg/apis/shared"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+const (
+	// ConfigDestinationRateLimitDestinationKey is the descriptor key used to select the rate limit buckets of the destination
+	ConfigDestinationRateLimitDestinationKey = "destination"
+
+	// ConfigDestinationRateLimitUserKey is the descriptor key used for the per-user rate limit buckets
+	ConfigDestinationRateLimitUserKey = "user"
+
+	// ConfigDestinationRateLimitAnonymousKey is the descriptor key used for the requests without the authenticated user
+	ConfigDestinationRateLimitAnonymousKey = "anonymous"
+
+	// ConfigDestinationRateLimitMaxUsers limits the number of the per-user token buckets kept by the gateway for each destination
+	ConfigDestinationRateLimitMaxUsers = 1024
+)
+
+type ConfigDestinationLimits struct {
+	RateLimit *ConfigDestinationRateLimit `json:"rateLimit,omitempty"`
+
+	MaxConnections *uint32 `json:"maxConnections,omitempty"`
+
+	MaxPendingRequests *uint32 `json:"maxPendingRequests,omitempty"`
+
+	MaxRequests *uint32 `json:"maxRequests,omitempty"`
+}
+
+func (c *ConfigDestinationLimits) GetRateLimit() *ConfigDestinationRateLimit {
+	if c == nil {
+		return nil
+	}
+
+	return c.RateLimit
+}
+
+func (c *ConfigDestinationLimits) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	return shared.WithErrors(
+		shared.PrefixResourceError("rateLimit", c.RateLimit.Validate()),
+		shared.PrefixResourceError("maxConnections", validateOptionalNonZero(c.MaxConnections)),
+		shared.PrefixResourceError("maxPendingRequests", validateOptionalNonZero(c.MaxPendingRequests)),
+		shared.PrefixResourceError("maxRequests", validateOptionalNonZero(c.MaxRequests)),
+	)
+}
+
+// RenderCircuitBreakers renders the upstream connection caps, returns nil if none are defined
+func (c *ConfigDestinationLimits) RenderCircuitBreakers() *pbEnvoyClusterV3.CircuitBreakers {
+	if c == nil || (c.MaxConnections == nil && c.MaxPendingRequests == nil && c.MaxRequests == nil) {
+		return nil
+	}
+
+	var t pbEnvoyClusterV3.CircuitBreakers_Thresholds
+
+	t.Priority = pbEnvoyCoreV3.RoutingPriority_DEFAULT
+
+	if v := c.MaxConnections; v != nil {
+		t.MaxConnections = wrapperspb.UInt32(*v)
+	}
+
+	if v := c.MaxPendingRequests; v != nil {
+		t.MaxPendingRequests = wrapperspb.UInt32(*v)
+	}
+
+	if v := c.MaxRequests; v != nil {
+		t.MaxRequests = wrapperspb.UInt32(*v)
+	}
+
+	return &pbEnvoyClusterV3.CircuitBreakers{
+		Thresholds: []*pbEnvoyClusterV3.CircuitBreakers_Thresholds{
+			&t,
+		},
+	}
+}
+
+type ConfigDestinationRateLimit struct {
+	RequestsPerSecond uint32 `json:"requestsPerSecond"`
+
+	Burst *uint32 `json:"burst,omitempty"`
+
+	PerUser bool `json:"perUser,omitempty"`
+}
+
+func (c *ConfigDestinationRateLimit) GetBurst() uint32 {
+	if c == nil {
+		return 0
+	}
+
+	return util.OptionalType(c.Burst, c.RequestsPerSecond)
+}
+
+func (c *ConfigDestinationRateLimit) IsPerUser() bool {
+	return c != nil && c.PerUser
+}
+
+func (c *ConfigDestinationRateLimit) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	return shared.WithErrors(
+		shared.PrefixResourceErrorFunc("requestsPerSecond", func() error {
+			if c.RequestsPerSecond == 0 {
+				return errors.Errorf("RequestsPerSecond must be greater than 0")
+			}
+			return nil
+		}),
+		shared.PrefixResourceErrorFunc("burst", func() error {
+			if c.GetBurst() < c.RequestsPerSecond {
+				return errors.Errorf("Burst must not be lower than RequestsPerSecond")
+			}
+			return nil
+		}),
+	)
+}
+
+// RenderDescriptors renders the token buckets of the destination. Buckets are defined once in the rate limit filter
+// and are shared by all routes of the destination, which select them with the descriptors from RenderRateLimits.
+func (c *ConfigDestinationRateLimit) RenderDescriptors(name string) []*pbEnvoyCommonRateLimitV3.LocalRateLimitDescriptor {
+	if c == nil {
+		return nil
+	}
+
+	if !c.PerUser {
+		return []*pbEnvoyCommonRateLimitV3.LocalRateLimitDescriptor{
+			{
+				Entries: []*pbEnvoyCommonRateLimitV3.RateLimitDescriptor_Entry{
+					{
+						Key:   ConfigDestinationRateLimitDestinationKey,
+						Value: name,
+					},
+				},
+				TokenBucket: c.renderTokenBucket(),
+			},
+		}
+	}
+
+	// Each authenticated user gets a dedicated bucket, requests without the user header share the anonymous bucket
+	return []*pbEnvoyCommonRateLimitV3.LocalRateLimitDescriptor{
+		{
+			Entries: []*pbEnvoyCommonRateLimitV3.RateLimitDescriptor_Entry{
+				{
+					Key:   ConfigDestinationRateLimitDestinationKey,
+					Value: name,
+				},
+				{
+					Key: ConfigDestinationRateLimitUserKey,
+				},
+			},
+			TokenBucket: c.renderTokenBucket(),
+		},
+		{
+			Entries: []*pbEnvoyCommonRateLimitV3.RateLimitDescriptor_Entry{
+				{
+					Key:   ConfigDestinationRateLimitDestinationKey,
+					Value: name,
+				},
+				{
+					Key:   ConfigDestinationRateLimitAnonymousKey,
+					Value: "true",
+				},
+			},
+			TokenBucket: c.renderTokenBucket(),
+		},
+	}
+}
+
+// RenderRateLimits renders the route descriptors which select the token buckets of the destination
+func (c *ConfigDestinationRateLimit) RenderRateLimits(name string) []*pbEnvoyRouteV3.RateLimit {
+	if c == nil {
+		return nil
+	}
+
+	destination := &pbEnvoyRouteV3.RateLimit_Action{
+		ActionSpecifier: &pbEnvoyRouteV3.RateLimit_Action_GenericKey_{
+			GenericKey: &pbEnvoyRouteV3.RateLimit_Action_GenericKey{
+				DescriptorKey:   ConfigDestinationRateLimitDestinationKey,
+				DescriptorValue: name,
+			},
+		},
+	}
+
+	if !c.PerUser {
+		return []*pbEnvoyRouteV3.RateLimit{
+			{
+				Actions: []*pbEnvoyRouteV3.RateLimit_Action{
+					destination,
+				},
+			},
+		}
+	}
+
+	return []*pbEnvoyRouteV3.RateLimit{
+		{
+			Actions: []*pbEnvoyRouteV3.RateLimit_Action{
+				destination,
+				{
+					ActionSpecifier: &pbEnvoyRouteV3.RateLimit_Action_RequestHeaders_{
+						RequestHeaders: &pbEnvoyRouteV3.RateLimit_Action_RequestHeaders{
+							HeaderName:    pbImplEnvoyAuthV3Shared.AuthUsernameHeader,
+							DescriptorKey: ConfigDestinationRateLimitUserKey,
+							SkipIfAbsent:  true,
+						},
+					},
+				},
+			},
+		},
+		{
+			Actions: []*pbEnvoyRouteV3.RateLimit_Action{
+				destination,
+				{
+					ActionSpecifier: &pbEnvoyRouteV3.RateLimit_Action_HeaderValueMatch_{
+						HeaderValueMatch: &pbEnvoyRouteV3.RateLimit_Action_HeaderValueMatch{
+							DescriptorKey:   ConfigDestinationRateLimitAnonymousKey,
+							DescriptorValue: "true",
+							ExpectMatch:     wrapperspb.Bool(false),
+							Headers: []*pbEnvoyRouteV3.HeaderMatcher{
+								{
+									Name: pbImplEnvoyAuthV3Shared.AuthUsernameHeader,
+									HeaderMatchSpecifier: &pbEnvoyRouteV3.HeaderMatcher_PresentMatch{
+										PresentMatch: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (c *ConfigDestinationRateLimit) renderTokenBucket() *typev3.TokenBucket {
+	return &typev3.TokenBucket{
+		MaxTokens:     c.GetBurst(),
+		TokensPerFill: wrapperspb.UInt32(c.RequestsPerSecond),
+		FillInterval:  durationpb.New(time.Second),
+	}
+}
+
+func validateOptionalNonZero(v *uint32) error {
+	if v != nil && *v == 0 {
+		return errors.Errorf("Value must be greater than 0")
+	}
+
+	return nil
+}
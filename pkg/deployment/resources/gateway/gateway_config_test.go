@@ -23,13 +23,19 @@ package gateway
 import (
 	"fmt"
 	goHttp "net/http"
+	"regexp"
 	"testing"
 
 	pbEnvoyBootstrapV3 "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v3"
+	pbEnvoyClusterV3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	pbEnvoyRouteV3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	httpFilterLocalRateLimitApi "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
 	httpConnectionManagerAPI "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	"github.com/stretchr/testify/require"
 
+	pbImplEnvoyAuthV3Shared "github.com/arangodb/kube-arangodb/integrations/envoy/auth/v3/shared"
 	"github.com/arangodb/kube-arangodb/pkg/util"
+	utilConstants "github.com/arangodb/kube-arangodb/pkg/util/constants"
 	"github.com/arangodb/kube-arangodb/pkg/util/tests/tgrpc"
 )
 
@@ -559,3 +565,459 @@ func Test_GatewayConfig(t *testing.T) {
 		}
 	})
 }
+
+func Test_GatewayConfig_Limits(t *testing.T) {
+	dest := func(limits *ConfigDestinationLimits) ConfigDestination {
+		return ConfigDestination{
+			Targets: []ConfigDestinationTarget{
+				ConfigDestinationTargetEndpoint{
+					Host: "127.0.0.1",
+					Port: 12346,
+				},
+			},
+			Limits: limits,
+		}
+	}
+
+	hcm := func(t *testing.T, b *pbEnvoyBootstrapV3.Bootstrap) *httpConnectionManagerAPI.HttpConnectionManager {
+		require.NotNil(t, b)
+		require.NotNil(t, b.StaticResources)
+		require.Len(t, b.StaticResources.Listeners, 1)
+		require.NotNil(t, b.StaticResources.Listeners[0].DefaultFilterChain)
+		require.Len(t, b.StaticResources.Listeners[0].DefaultFilterChain.Filters, 1)
+		var o httpConnectionManagerAPI.HttpConnectionManager
+		tgrpc.GRPCAnyCastAs(t, b.StaticResources.Listeners[0].DefaultFilterChain.Filters[0].GetTypedConfig(), &o)
+		return &o
+	}
+
+	route := func(t *testing.T, o *httpConnectionManagerAPI.HttpConnectionManager, prefix string) *pbEnvoyRouteV3.Route {
+		for _, r := range o.GetRouteConfig().GetVirtualHosts()[0].GetRoutes() {
+			if r.GetMatch().GetPrefix() == prefix {
+				return r
+			}
+		}
+		require.Failf(t, "Route not found", "Prefix: %s", prefix)
+		return nil
+	}
+
+	t.Run("Without limits", func(t *testing.T) {
+		renderAndPrintGatewayConfig(t, Config{
+			DefaultDestination: dest(nil),
+			Destinations: ConfigDestinations{
+				"/test/": dest(nil),
+			},
+		}, func(t *testing.T, b *pbEnvoyBootstrapV3.Bootstrap) {
+			o := hcm(t, b)
+			require.Len(t, o.HttpFilters, 1)
+			for _, c := range b.StaticResources.Clusters {
+				require.Nil(t, c.CircuitBreakers)
+			}
+			require.Nil(t, route(t, o, "/test/").TypedPerFilterConfig)
+		})
+	})
+
+	filter := func(t *testing.T, o *httpConnectionManagerAPI.HttpConnectionManager) *httpFilterLocalRateLimitApi.LocalRateLimit {
+		for _, f := range o.HttpFilters {
+			if f.GetName() == utilConstants.EnvoyLocalRateLimitFilterName {
+				var l httpFilterLocalRateLimitApi.LocalRateLimit
+				tgrpc.GRPCAnyCastAs(t, f.GetTypedConfig(), &l)
+				require.NoError(t, l.ValidateAll())
+				return &l
+			}
+		}
+		require.Fail(t, "Rate limit filter not found")
+		return nil
+	}
+
+	name := fmt.Sprintf("cluster_%s", util.SHA256FromString("/test/"))
+
+	t.Run("Rate limit", func(t *testing.T) {
+		renderAndPrintGatewayConfig(t, Config{
+			DefaultDestination: dest(nil),
+			Destinations: ConfigDestinations{
+				"/test/": dest(&ConfigDestinationLimits{
+					RateLimit: &ConfigDestinationRateLimit{
+						RequestsPerSecond: 10,
+						Burst:             util.NewType[uint32](20),
+					},
+				}),
+			},
+		}, func(t *testing.T, b *pbEnvoyBootstrapV3.Bootstrap) {
+			o := hcm(t, b)
+			require.Len(t, o.HttpFilters, 2)
+			require.Equal(t, utilConstants.EnvoyLocalRateLimitFilterName, o.HttpFilters[0].GetName())
+
+			l := filter(t, o)
+			require.False(t, l.GetAlwaysConsumeDefaultTokenBucket().GetValue())
+			require.Nil(t, l.GetMaxDynamicDescriptors())
+			require.Len(t, l.GetDescriptors(), 1)
+			require.Len(t, l.GetDescriptors()[0].GetEntries(), 1)
+			require.Equal(t, ConfigDestinationRateLimitDestinationKey, l.GetDescriptors()[0].GetEntries()[0].GetKey())
+			require.Equal(t, name, l.GetDescriptors()[0].GetEntries()[0].GetValue())
+			require.EqualValues(t, 20, l.GetDescriptors()[0].GetTokenBucket().GetMaxTokens())
+			require.EqualValues(t, 10, l.GetDescriptors()[0].GetTokenBucket().GetTokensPerFill().GetValue())
+
+			r := route(t, o, "/test/")
+			require.Nil(t, r.TypedPerFilterConfig)
+			require.Len(t, r.GetRoute().GetRateLimits(), 1)
+			require.Len(t, r.GetRoute().GetRateLimits()[0].GetActions(), 1)
+			require.Equal(t, ConfigDestinationRateLimitDestinationKey, r.GetRoute().GetRateLimits()[0].GetActions()[0].GetGenericKey().GetDescriptorKey())
+			require.Equal(t, name, r.GetRoute().GetRateLimits()[0].GetActions()[0].GetGenericKey().GetDescriptorValue())
+
+			require.Empty(t, route(t, o, "/").GetRoute().GetRateLimits())
+		})
+	})
+
+	t.Run("Rate limit per user", func(t *testing.T) {
+		renderAndPrintGatewayConfig(t, Config{
+			DefaultDestination: dest(nil),
+			IntegrationSidecar: &ConfigDestinationTargetEndpoint{
+				Host: "127.0.0.1",
+				Port: 9092,
+			},
+			Destinations: ConfigDestinations{
+				"/test/": dest(&ConfigDestinationLimits{
+					RateLimit: &ConfigDestinationRateLimit{
+						RequestsPerSecond: 5,
+						PerUser:           true,
+					},
+				}),
+			},
+		}, func(t *testing.T, b *pbEnvoyBootstrapV3.Bootstrap) {
+			o := hcm(t, b)
+			require.Len(t, o.HttpFilters, 3)
+			require.Equal(t, utilConstants.EnvoyIntegrationSidecarFilterName, o.HttpFilters[0].GetName())
+			require.Equal(t, utilConstants.EnvoyLocalRateLimitFilterName, o.HttpFilters[1].GetName())
+
+			l := filter(t, o)
+			require.EqualValues(t, ConfigDestinationRateLimitMaxUsers, l.GetMaxDynamicDescriptors().GetValue())
+			require.Len(t, l.GetDescriptors(), 2)
+
+			user := l.GetDescriptors()[0]
+			require.Len(t, user.GetEntries(), 2)
+			require.Equal(t, name, user.GetEntries()[0].GetValue())
+			require.Equal(t, ConfigDestinationRateLimitUserKey, user.GetEntries()[1].GetKey())
+			require.Empty(t, user.GetEntries()[1].GetValue())
+			require.EqualValues(t, 5, user.GetTokenBucket().GetMaxTokens())
+
+			anonymous := l.GetDescriptors()[1]
+			require.Len(t, anonymous.GetEntries(), 2)
+			require.Equal(t, ConfigDestinationRateLimitAnonymousKey, anonymous.GetEntries()[1].GetKey())
+			require.EqualValues(t, 5, anonymous.GetTokenBucket().GetMaxTokens())
+
+			rl := route(t, o, "/test/").GetRoute().GetRateLimits()
+			require.Len(t, rl, 2)
+			require.Equal(t, pbImplEnvoyAuthV3Shared.AuthUsernameHeader, rl[0].GetActions()[1].GetRequestHeaders().GetHeaderName())
+			require.True(t, rl[0].GetActions()[1].GetRequestHeaders().GetSkipIfAbsent())
+			require.False(t, rl[1].GetActions()[1].GetHeaderValueMatch().GetExpectMatch().GetValue())
+			require.Equal(t, pbImplEnvoyAuthV3Shared.AuthUsernameHeader, rl[1].GetActions()[1].GetHeaderValueMatch().GetHeaders()[0].GetName())
+		})
+	})
+
+	t.Run("Rate limit shared with backends", func(t *testing.T) {
+		d := dest(&ConfigDestinationLimits{
+			RateLimit: &ConfigDestinationRateLimit{
+				RequestsPerSecond: 10,
+			},
+		})
+		d.Backends = ConfigDestinationBackends{
+			{
+				Name: "canary",
+				Targets: ConfigDestinationTargets{
+					ConfigDestinationTargetEndpoint{
+						Host: "127.0.0.2",
+						Port: 12346,
+					},
+				},
+				Match: &ConfigDestinationBackendMatch{
+					Headers: []ConfigDestinationBackendMatchRule{
+						{Name: "x-canary"},
+					},
+				},
+			},
+		}
+
+		renderAndPrintGatewayConfig(t, Config{
+			DefaultDestination: dest(nil),
+			Destinations: ConfigDestinations{
+				"/test/": d,
+			},
+		}, func(t *testing.T, b *pbEnvoyBootstrapV3.Bootstrap) {
+			o := hcm(t, b)
+
+			// Single bucket for the destination
+			l := filter(t, o)
+			require.Len(t, l.GetDescriptors(), 1)
+			require.Equal(t, name, l.GetDescriptors()[0].GetEntries()[0].GetValue())
+
+			var routes []*pbEnvoyRouteV3.Route
+			for _, r := range o.GetRouteConfig().GetVirtualHosts()[0].GetRoutes() {
+				if r.GetMatch().GetPrefix() == "/test/" {
+					routes = append(routes, r)
+				}
+			}
+			require.Len(t, routes, 2)
+
+			// Canary and main routes select the same bucket
+			for _, r := range routes {
+				require.Nil(t, r.TypedPerFilterConfig)
+				require.Len(t, r.GetRoute().GetRateLimits(), 1)
+				require.Equal(t, name, r.GetRoute().GetRateLimits()[0].GetActions()[0].GetGenericKey().GetDescriptorValue())
+			}
+			require.NotEmpty(t, routes[0].GetMatch().GetHeaders())
+			require.Equal(t, d.Backends[0].ClusterName(name), routes[0].GetRoute().GetCluster())
+		})
+	})
+
+	t.Run("Connection limits", func(t *testing.T) {
+		renderAndPrintGatewayConfig(t, Config{
+			DefaultDestination: dest(nil),
+			Destinations: ConfigDestinations{
+				"/test/": dest(&ConfigDestinationLimits{
+					MaxConnections: util.NewType[uint32](16),
+					MaxRequests:    util.NewType[uint32](32),
+				}),
+			},
+		}, func(t *testing.T, b *pbEnvoyBootstrapV3.Bootstrap) {
+			o := hcm(t, b)
+			require.Len(t, o.HttpFilters, 1)
+
+			var found bool
+			for _, c := range b.StaticResources.Clusters {
+				if c.Name == "default" {
+					require.Nil(t, c.CircuitBreakers)
+					continue
+				}
+				found = true
+				require.NotNil(t, c.CircuitBreakers)
+				require.Len(t, c.CircuitBreakers.Thresholds, 1)
+				require.EqualValues(t, 16, c.CircuitBreakers.Thresholds[0].GetMaxConnections().GetValue())
+				require.Nil(t, c.CircuitBreakers.Thresholds[0].GetMaxPendingRequests())
+				require.EqualValues(t, 32, c.CircuitBreakers.Thresholds[0].GetMaxRequests().GetValue())
+			}
+			require.True(t, found)
+		})
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		require.EqualError(t, Config{
+			DefaultDestination: dest(nil),
+			Destinations: ConfigDestinations{
+				"/test/": dest(&ConfigDestinationLimits{
+					RateLimit: &ConfigDestinationRateLimit{
+						RequestsPerSecond: 10,
+						Burst:             util.NewType[uint32](5),
+					},
+					MaxConnections: util.NewType[uint32](0),
+				}),
+			},
+		}.Validate(), "Received 1 errors: destinations.`/test/`: Received 1 errors: limits: Received 2 errors: rateLimit: Received 1 errors: burst: Burst must not be lower than RequestsPerSecond, maxConnections: Value must be greater than 0")
+	})
+
+	t.Run("Per user without integration sidecar", func(t *testing.T) {
+		require.EqualError(t, Config{
+			DefaultDestination: dest(nil),
+			Destinations: ConfigDestinations{
+				"/test/": dest(&ConfigDestinationLimits{
+					RateLimit: &ConfigDestinationRateLimit{
+						RequestsPerSecond: 10,
+						PerUser:           true,
+					},
+				}),
+			},
+		}.Validate(), "Received 1 errors: integrationSidecar: IntegrationSidecar is required for the per-user rate limits")
+	})
+}
+
+func Test_GatewayConfig_Backends(t *testing.T) {
+	target := func(port int32) ConfigDestinationTargets {
+		return ConfigDestinationTargets{
+			ConfigDestinationTargetEndpoint{
+				Host: "127.0.0.1",
+				Port: port,
+			},
+		}
+	}
+
+	routes := func(t *testing.T, b *pbEnvoyBootstrapV3.Bootstrap) []*pbEnvoyRouteV3.Route {
+		require.NotNil(t, b)
+		require.NotNil(t, b.StaticResources)
+		require.Len(t, b.StaticResources.Listeners, 1)
+		var o httpConnectionManagerAPI.HttpConnectionManager
+		tgrpc.GRPCAnyCastAs(t, b.StaticResources.Listeners[0].DefaultFilterChain.Filters[0].GetTypedConfig(), &o)
+		require.NoError(t, o.ValidateAll())
+		require.Len(t, o.GetRouteConfig().GetVirtualHosts(), 1)
+		return o.GetRouteConfig().GetVirtualHosts()[0].GetRoutes()
+	}
+
+	clusters := func(t *testing.T, b *pbEnvoyBootstrapV3.Bootstrap) []string {
+		return util.FormatList(b.StaticResources.Clusters, func(a *pbEnvoyClusterV3.Cluster) string {
+			return a.GetName()
+		})
+	}
+
+	name := fmt.Sprintf("cluster_%s", util.SHA256FromString("/test/"))
+
+	t.Run("Weighted", func(t *testing.T) {
+		renderAndPrintGatewayConfig(t, Config{
+			DefaultDestination: ConfigDestination{
+				Targets: target(12345),
+			},
+			Destinations: ConfigDestinations{
+				"/test/": ConfigDestination{
+					Targets: target(12346),
+					Weight:  util.NewType[uint32](90),
+					Backends: ConfigDestinationBackends{
+						{
+							Name:    "canary",
+							Targets: target(12347),
+							Weight:  10,
+						},
+						{
+							Name:    "disabled",
+							Targets: target(12348),
+						},
+					},
+				},
+			},
+		}, func(t *testing.T, b *pbEnvoyBootstrapV3.Bootstrap) {
+			require.ElementsMatch(t, []string{"default", name, name + "_canary", name + "_disabled"}, clusters(t, b))
+
+			r := routes(t, b)
+			require.Len(t, r, 2)
+			require.Equal(t, "/test/", r[0].GetMatch().GetPrefix())
+			require.Empty(t, r[0].GetMatch().GetHeaders())
+
+			w := r[0].GetRoute().GetWeightedClusters()
+			require.NotNil(t, w)
+			require.Len(t, w.GetClusters(), 2)
+			require.Equal(t, name, w.GetClusters()[0].GetName())
+			require.EqualValues(t, 90, w.GetClusters()[0].GetWeight().GetValue())
+			require.Equal(t, name+"_canary", w.GetClusters()[1].GetName())
+			require.EqualValues(t, 10, w.GetClusters()[1].GetWeight().GetValue())
+		})
+	})
+
+	t.Run("Match", func(t *testing.T) {
+		renderAndPrintGatewayConfig(t, Config{
+			DefaultDestination: ConfigDestination{
+				Targets: target(12345),
+			},
+			Destinations: ConfigDestinations{
+				"/test/": ConfigDestination{
+					Targets: target(12346),
+					Backends: ConfigDestinationBackends{
+						{
+							Name:    "header",
+							Targets: target(12347),
+							Match: &ConfigDestinationBackendMatch{
+								Headers: []ConfigDestinationBackendMatchRule{
+									{
+										Name:  "x-canary",
+										Value: util.NewType("true"),
+									},
+									{
+										Name: "x-debug",
+									},
+								},
+							},
+						},
+						{
+							Name:    "cookie",
+							Targets: target(12348),
+							Match: &ConfigDestinationBackendMatch{
+								Cookies: []ConfigDestinationBackendMatchRule{
+									{
+										Name:  "canary",
+										Value: util.NewType("v2.0"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}, func(t *testing.T, b *pbEnvoyBootstrapV3.Bootstrap) {
+			require.ElementsMatch(t, []string{"default", name, name + "_header", name + "_cookie"}, clusters(t, b))
+
+			r := routes(t, b)
+			require.Len(t, r, 4)
+
+			require.Equal(t, "/test/", r[0].GetMatch().GetPrefix())
+			require.Equal(t, name+"_header", r[0].GetRoute().GetCluster())
+			require.Len(t, r[0].GetMatch().GetHeaders(), 2)
+			require.Equal(t, "x-canary", r[0].GetMatch().GetHeaders()[0].GetName())
+			require.Equal(t, "true", r[0].GetMatch().GetHeaders()[0].GetStringMatch().GetExact())
+			require.Equal(t, "x-debug", r[0].GetMatch().GetHeaders()[1].GetName())
+			require.True(t, r[0].GetMatch().GetHeaders()[1].GetPresentMatch())
+
+			require.Equal(t, "/test/", r[1].GetMatch().GetPrefix())
+			require.Equal(t, name+"_cookie", r[1].GetRoute().GetCluster())
+			require.Len(t, r[1].GetMatch().GetHeaders(), 1)
+			require.Equal(t, "cookie", r[1].GetMatch().GetHeaders()[0].GetName())
+
+			re := regexp.MustCompile(fmt.Sprintf("^(?:%s)$", r[1].GetMatch().GetHeaders()[0].GetStringMatch().GetSafeRegex().GetRegex()))
+			require.True(t, re.MatchString("canary=v2.0"))
+			require.True(t, re.MatchString("session=abc; canary=v2.0; theme=dark"))
+			require.False(t, re.MatchString("canary=v2x0"))
+			require.False(t, re.MatchString("canary=v2.0.1"))
+			require.False(t, re.MatchString("mycanary=v2.0"))
+
+			// Unmatched requests are sent to the destination
+			require.Equal(t, "/test/", r[2].GetMatch().GetPrefix())
+			require.Empty(t, r[2].GetMatch().GetHeaders())
+			require.Equal(t, name, r[2].GetRoute().GetCluster())
+
+			require.Equal(t, "/", r[3].GetMatch().GetPrefix())
+		})
+	})
+
+	t.Run("Weighted without destination", func(t *testing.T) {
+		renderAndPrintGatewayConfig(t, Config{
+			DefaultDestination: ConfigDestination{
+				Targets: target(12345),
+			},
+			Destinations: ConfigDestinations{
+				"/test/": ConfigDestination{
+					Targets: target(12346),
+					Weight:  util.NewType[uint32](0),
+					Backends: ConfigDestinationBackends{
+						{
+							Name:    "new",
+							Targets: target(12347),
+							Weight:  1,
+						},
+					},
+				},
+			},
+		}, func(t *testing.T, b *pbEnvoyBootstrapV3.Bootstrap) {
+			r := routes(t, b)
+			require.Len(t, r, 2)
+			require.Equal(t, name+"_new", r[0].GetRoute().GetCluster())
+		})
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		require.EqualError(t, Config{
+			DefaultDestination: ConfigDestination{
+				Targets: target(12345),
+			},
+			Destinations: ConfigDestinations{
+				"/test/": ConfigDestination{
+					Targets: target(12346),
+					Backends: ConfigDestinationBackends{
+						{
+							Name:    "canary",
+							Targets: target(12347),
+							Match:   &ConfigDestinationBackendMatch{},
+						},
+						{
+							Name:    "canary",
+							Targets: target(12348),
+						},
+					},
+				},
+			},
+		}.Validate(), "Received 1 errors: destinations.`/test/`: Received 1 errors: backends: Received 2 errors: [0]: Received 1 errors: match: At least one header or cookie rule is required, Backend name `canary` is not unique")
+	})
+}
@@ -489,6 +489,15 @@ func (m *MemberArangoDPod) GetInitContainers(cachedStatus interfaces.Inspector)
 		initContainers = append(initContainers, c)
 	}
 
+	if pod.IsEncryptionKMSEnabled(m.Input) {
+		// Encryption keys are unwrapped before the server or upgrade container starts
+		kmsSpec := m.Deployment.RocksDB.Encryption.KMS
+		sc := k8sutil.CreateSecurityContext(m.GroupSpec.SecurityContext)
+		c := k8sutil.ArangodEncryptionKMSInitContainer(api.ServerGroupReservedInitContainerNameEncryption, executable,
+			m.resources.context.GetOperatorImage(), pod.EncryptionKMSInitContainerArgs(kmsSpec), sc, kmsSpec.GetVault().GetCASecretName() != "")
+		initContainers = append(initContainers, c)
+	}
+
 	{
 		// Upgrade container - run in background
 		if m.AutoUpgrade || m.Member.Upgrade {
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2025 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -282,7 +282,17 @@ func (r *Resources) InspectPods(ctx context.Context, cachedStatus inspectorInter
 
 		}
 
-		if k8sutil.IsPodReady(pod) && k8sutil.AreContainersReady(pod, coreContainers) {
+		if c, ok := memberStatus.Conditions.Get(api.ConditionTypeReadinessPaused); ok && c.IsTrue() {
+			if time.Since(c.LastTransitionTime.Time) >= spec.Chaos.GetInterval() {
+				// Readiness paused by the chaos monkey is restored after the chaos interval
+				memberStatus.Conditions.Remove(api.ConditionTypeReadinessPaused)
+				updateMemberStatusNeeded = true
+			} else {
+				nextInterval = nextInterval.ReduceTo(recheckSoonPodInspectorInterval)
+			}
+		}
+
+		if k8sutil.IsPodReady(pod) && k8sutil.AreContainersReady(pod, coreContainers) && !memberStatus.Conditions.IsTrue(api.ConditionTypeReadinessPaused) {
 			// Pod is now ready
 			if util.Or(
 				memberStatus.Conditions.Update(api.ConditionTypeReady, true, "Pod Ready", ""),
@@ -36,6 +36,8 @@ type Resources struct {
 	context         Context
 
 	metrics Metrics
+
+	certManagerCertificates certManagerCertificatesCache
 }
 
 // NewResources creates a new Resources service, used to
@@ -22,7 +22,6 @@ package resources
 
 import (
 	"context"
-	"crypto/sha256"
 	"fmt"
 	"time"
 
@@ -144,6 +143,18 @@ func (r *Resources) EnsureSecrets(ctx context.Context, cachedStatus inspectorInt
 				}
 
 				tlsKeyfileSecretName := k8sutil.AppendTLSKeyfileSecretPostfix(member.GetName())
+				if spec.TLS.IsCertManager() {
+					serverNames, err := ktls.GetServerAltNames(apiObject, spec, spec.TLS, service, members[id].Group, members[id].Member)
+					if err != nil {
+						return errors.WithStack(errors.Wrapf(err, "Failed to render alt names"))
+					}
+					if created, err := r.ensureTLSCertManagerCertificate(ctx, log, cachedStatus, secrets, serverNames, spec.TLS, members[id].Group, member, tlsKeyfileSecretName); err != nil {
+						return errors.WithStack(errors.Wrapf(err, "Failed to ensure cert-manager Certificate"))
+					} else if created {
+						reconcileRequired.Required()
+					}
+					return nil
+				}
 				if _, exists := cachedStatus.Secret().V1().GetSimple(tlsKeyfileSecretName); !exists {
 					serverNames, err := ktls.GetServerAltNames(apiObject, spec, spec.TLS, service, members[id].Group, members[id].Member)
 					if err != nil {
@@ -167,10 +178,20 @@ func (r *Resources) EnsureSecrets(ctx context.Context, cachedStatus inspectorInt
 		}); err != nil {
 			return errors.Section(err, "TLS TrustStore")
 		}
+
+		if spec.TLS.IsCertManager() {
+			memberNames := make([]string, 0, len(members))
+			for _, m := range members {
+				memberNames = append(memberNames, m.Member.ArangoMemberName(deploymentName, m.Group))
+			}
+			if err := reconcileRequired.WithError(r.ensureTLSCertManagerCASecret(ctx, cachedStatus, secrets, spec.TLS, memberNames)); err != nil {
+				return errors.Section(err, "TLS CA")
+			}
+		}
 	}
 	if spec.RocksDB.IsEncrypted() {
 		if i := status.CurrentImage; i != nil && features.EncryptionRotation().Supported(i.ArangoDBVersion, i.Enterprise) {
-			if err := reconcileRequired.WithError(r.ensureEncryptionKeyfolderSecret(ctx, cachedStatus, secrets, spec.RocksDB.Encryption.GetKeySecretName(), pod.GetEncryptionFolderSecretName(deploymentName))); err != nil {
+			if err := reconcileRequired.WithError(r.ensureEncryptionKeyfolderSecret(ctx, cachedStatus, secrets, spec.RocksDB.Encryption, pod.GetEncryptionFolderSecretName(deploymentName))); err != nil {
 				return errors.Section(err, "Encryption")
 			}
 		}
@@ -396,35 +417,32 @@ func (r *Resources) createTokenSecretObject(ctx context.Context, secrets generic
 	return errors.Reconcile()
 }
 
-func (r *Resources) ensureEncryptionKeyfolderSecret(ctx context.Context, cachedStatus inspectorInterface.Inspector, secrets generic.ModClient[*core.Secret], keyfileSecretName, secretName string) error {
+func (r *Resources) ensureEncryptionKeyfolderSecret(ctx context.Context, cachedStatus inspectorInterface.Inspector, secrets generic.ModClient[*core.Secret], spec api.RocksDBEncryptionSpec, secretName string) error {
 	_, folderExists := cachedStatus.Secret().V1().GetSimple(secretName)
 
+	if folderExists {
+		return nil
+	}
+
+	keyfileSecretName := spec.GetKeySecretName()
+
 	keyfile, exists := cachedStatus.Secret().V1().GetSimple(keyfileSecretName)
 	if !exists {
-		if folderExists {
-			return nil
-		}
 		return errors.Errorf("Unable to find original secret %s", keyfileSecretName)
 	}
 
 	if len(keyfile.Data) == 0 {
-		if folderExists {
-			return nil
-		}
 		return errors.Errorf("Missing key in secret")
 	}
 
-	d, ok := keyfile.Data[utilConstants.SecretEncryptionKey]
-	if !ok {
-		if folderExists {
-			return nil
-		}
-		return errors.Errorf("Missing key in secret")
+	sha, d, err := pod.GetEncryptionKeyfolderEntryFromSecret(ctx, cachedStatus.Secret().V1().Read(), spec, keyfile)
+	if err != nil {
+		return errors.Wrapf(err, "Missing key in secret")
 	}
 
 	owner := r.context.GetAPIObject().AsOwner()
-	err := globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
-		return AppendKeyfileToKeyfolder(ctxChild, cachedStatus, secrets, &owner, secretName, d)
+	err = globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+		return AppendKeyfileToKeyfolder(ctxChild, cachedStatus, secrets, &owner, secretName, sha, d)
 	})
 	if err != nil {
 		return errors.Wrapf(err, "Unable to create keyfolder secret")
@@ -432,9 +450,9 @@ func (r *Resources) ensureEncryptionKeyfolderSecret(ctx context.Context, cachedS
 	return nil
 }
 
+// AppendKeyfileToKeyfolder creates the keyfolder secret with the encryption key stored under its sha256
 func AppendKeyfileToKeyfolder(ctx context.Context, cachedStatus inspectorInterface.Inspector,
-	secrets generic.ModClient[*core.Secret], ownerRef *meta.OwnerReference, secretName string, encryptionKey []byte) error {
-	encSha := fmt.Sprintf("%0x", sha256.Sum256(encryptionKey))
+	secrets generic.ModClient[*core.Secret], ownerRef *meta.OwnerReference, secretName, encSha string, encryptionKey []byte) error {
 	if _, exists := cachedStatus.Secret().V1().GetSimple(secretName); !exists {
 
 		// Create secret
@@ -544,6 +562,10 @@ func (r *Resources) ensureExporterTokenSecretCreateRequired(cachedStatus inspect
 // ensureTLSCACertificateSecret checks if a secret with given name exists in the namespace
 // of the deployment. If not, it will add such a secret with a generated CA certificate.
 func (r *Resources) ensureTLSCACertificateSecret(ctx context.Context, cachedStatus inspectorInterface.Inspector, secrets generic.ModClient[*core.Secret], spec api.TLSSpec) error {
+	if spec.IsCertManager() {
+		// CA is managed by the cert-manager issuer
+		return nil
+	}
 	if _, exists := cachedStatus.Secret().V1().GetSimple(spec.GetCASecretName()); !exists {
 		// Secret not found, create it
 		apiObject := r.context.GetAPIObject()
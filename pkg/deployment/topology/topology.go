@@ -27,6 +27,7 @@ import (
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency/state"
 	"github.com/arangodb/kube-arangodb/pkg/util/errors"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 )
@@ -61,6 +62,25 @@ func GetTopologyMapping(status api.DeploymentStatus) (Mapping, error) {
 	return m, nil
 }
 
+// GetDBServerZones returns the topology zones of the DBServers owned by the deployment topology
+func GetDBServerZones(status api.DeploymentStatus) state.ServerZones {
+	zones := state.ServerZones{}
+
+	if !status.Topology.Enabled() {
+		return zones
+	}
+
+	for _, member := range status.Members.DBServers {
+		if !status.Topology.IsTopologyOwned(member.Topology) {
+			continue
+		}
+
+		zones[state.Server(member.ID)] = member.Topology.Zone
+	}
+
+	return zones
+}
+
 func GetTopologyAffinityRules(name string, status api.DeploymentStatus, group api.ServerGroup, member api.MemberStatus) core.Affinity {
 	var a = core.Affinity{
 		NodeAffinity: &core.NodeAffinity{
@@ -0,0 +1,145 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package spec
+
+import (
+	"context"
+	goStrings "strings"
+
+	admission "k8s.io/api/admission/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/patch"
+	"github.com/arangodb/kube-arangodb/pkg/logging"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/webhook"
+)
+
+// Handler returns the ArangoDeployment spec webhook handler.
+// Mutation applies the spec defaults, validation rejects invalid specs and changes of immutable fields.
+func Handler() webhook.Handler[*api.ArangoDeployment] {
+	return handler{}
+}
+
+var _ webhook.MutationHandler[*api.ArangoDeployment] = handler{}
+var _ webhook.ValidationHandler[*api.ArangoDeployment] = handler{}
+
+type handler struct {
+}
+
+func (h handler) CanHandle(ctx context.Context, log logging.Logger, t webhook.AdmissionRequestType, request *admission.AdmissionRequest, old, new *api.ArangoDeployment) bool {
+	if request == nil {
+		return false
+	}
+
+	if request.Operation != admission.Create && request.Operation != admission.Update {
+		return false
+	}
+
+	if new == nil {
+		return false
+	}
+
+	if new.GetDeletionTimestamp() != nil {
+		// Do not block finalizers removal
+		return false
+	}
+
+	return true
+}
+
+func (h handler) Mutate(ctx context.Context, log logging.Logger, t webhook.AdmissionRequestType, request *admission.AdmissionRequest, old, new *api.ArangoDeployment) (webhook.MutationResponse, error) {
+	if !h.CanHandle(ctx, log, t, request, old, new) {
+		return webhook.MutationResponse{}, errors.Errorf("Object cannot be handled")
+	}
+
+	spec := new.Spec.DeepCopy()
+	spec.SetDefaults(new.GetName())
+
+	if changed, err := specChanged(new.Spec, *spec); err != nil {
+		return webhook.MutationResponse{}, err
+	} else if !changed {
+		return webhook.MutationResponse{
+			ValidationResponse: webhook.ValidationResponse{Allowed: true},
+		}, nil
+	}
+
+	return webhook.MutationResponse{
+		ValidationResponse: webhook.ValidationResponse{Allowed: true},
+		Patch: []patch.Item{
+			patch.ItemReplace(patch.NewPath("spec"), spec),
+		},
+	}, nil
+}
+
+func (h handler) Validate(ctx context.Context, log logging.Logger, t webhook.AdmissionRequestType, request *admission.AdmissionRequest, old, new *api.ArangoDeployment) (webhook.ValidationResponse, error) {
+	if !h.CanHandle(ctx, log, t, request, old, new) {
+		return webhook.ValidationResponse{}, errors.Errorf("Object cannot be handled")
+	}
+
+	if old != nil {
+		if changed, err := specChanged(old.Spec, new.Spec); err != nil {
+			return webhook.ValidationResponse{}, err
+		} else if !changed {
+			// Metadata or finalizers update, spec is not evaluated
+			return webhook.ValidationResponse{Allowed: true}, nil
+		}
+	}
+
+	spec := new.Spec.DeepCopy()
+	spec.SetDefaults(new.GetName())
+
+	if old != nil {
+		accepted := old.Status.AcceptedSpec
+		if accepted == nil {
+			accepted = old.Spec.DeepCopy()
+			accepted.SetDefaults(old.GetName())
+		}
+
+		if fields := accepted.ResetImmutableFields(spec.DeepCopy()); len(fields) > 0 {
+			log.Strs("fields", fields...).Debug("Immutable fields change rejected")
+			return webhook.NewValidationResponse(false, "Immutable fields cannot be changed: %s", goStrings.Join(util.FormatList(fields, func(a string) string {
+				return "spec." + a
+			}), ", ")), nil
+		}
+	}
+
+	if err := spec.Validate(); err != nil {
+		return webhook.NewValidationResponse(false, "Invalid spec: %s", err.Error()), nil
+	}
+
+	return webhook.ValidationResponse{Allowed: true}, nil
+}
+
+func specChanged(a, b api.DeploymentSpec) (bool, error) {
+	ac, err := a.Checksum()
+	if err != nil {
+		return false, err
+	}
+
+	bc, err := b.Checksum()
+	if err != nil {
+		return false, err
+	}
+
+	return ac != bc, nil
+}
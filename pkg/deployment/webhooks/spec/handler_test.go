@@ -0,0 +1,137 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package spec
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	admission "k8s.io/api/admission/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/logging"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/tests"
+	"github.com/arangodb/kube-arangodb/pkg/webhook"
+)
+
+func newDeployment(mod func(in *api.DeploymentSpec)) *api.ArangoDeployment {
+	depl := &api.ArangoDeployment{
+		ObjectMeta: meta.ObjectMeta{
+			Name:      "deployment",
+			Namespace: tests.FakeNamespace,
+		},
+		Spec: api.DeploymentSpec{
+			Mode: api.NewMode(api.DeploymentModeCluster),
+		},
+	}
+
+	if mod != nil {
+		mod(&depl.Spec)
+	}
+
+	return depl
+}
+
+func Test_Handler_Mutate(t *testing.T) {
+	h := handler{}
+	log := logging.Global().Get("test")
+
+	depl := newDeployment(nil)
+
+	resp, err := h.Mutate(context.Background(), log, webhook.AdmissionRequestMutate, &admission.AdmissionRequest{Operation: admission.Create}, nil, depl)
+	require.NoError(t, err)
+	require.True(t, resp.Allowed)
+	require.Len(t, resp.Patch, 1)
+
+	// Defaults are already applied
+	defaulted := depl.DeepCopy()
+	defaulted.Spec.SetDefaults(defaulted.GetName())
+
+	resp, err = h.Mutate(context.Background(), log, webhook.AdmissionRequestMutate, &admission.AdmissionRequest{Operation: admission.Create}, nil, defaulted)
+	require.NoError(t, err)
+	require.True(t, resp.Allowed)
+	require.Empty(t, resp.Patch)
+}
+
+func Test_Handler_Validate(t *testing.T) {
+	h := handler{}
+	log := logging.Global().Get("test")
+
+	validate := func(t *testing.T, op admission.Operation, old, new *api.ArangoDeployment) webhook.ValidationResponse {
+		resp, err := h.Validate(context.Background(), log, webhook.AdmissionRequestValidate, &admission.AdmissionRequest{Operation: op}, old, new)
+		require.NoError(t, err)
+		return resp
+	}
+
+	t.Run("Create", func(t *testing.T) {
+		resp := validate(t, admission.Create, nil, newDeployment(nil))
+		require.True(t, resp.Allowed, resp.Message)
+	})
+
+	t.Run("Create with invalid spec", func(t *testing.T) {
+		resp := validate(t, admission.Create, nil, newDeployment(func(in *api.DeploymentSpec) {
+			in.Mode = api.NewMode("Unknown")
+		}))
+		require.False(t, resp.Allowed)
+		require.Contains(t, resp.Message, "spec.mode")
+	})
+
+	t.Run("Update of mutable field", func(t *testing.T) {
+		resp := validate(t, admission.Update, newDeployment(nil), newDeployment(func(in *api.DeploymentSpec) {
+			in.DBServers.Count = util.NewType(5)
+		}))
+		require.True(t, resp.Allowed, resp.Message)
+	})
+
+	t.Run("Update of immutable fields", func(t *testing.T) {
+		resp := validate(t, admission.Update, newDeployment(nil), newDeployment(func(in *api.DeploymentSpec) {
+			in.StorageEngine = api.NewStorageEngine(api.StorageEngineMMFiles)
+			in.DisableIPv6 = util.NewType(true)
+		}))
+		require.False(t, resp.Allowed)
+		require.Equal(t, "Immutable fields cannot be changed: spec.storageEngine, spec.disableIPv6", resp.Message)
+	})
+
+	t.Run("Update compared with accepted spec", func(t *testing.T) {
+		old := newDeployment(func(in *api.DeploymentSpec) {
+			in.Mode = api.NewMode(api.DeploymentModeSingle)
+		})
+		old.Status.AcceptedSpec = newDeployment(nil).Spec.DeepCopy()
+		old.Status.AcceptedSpec.SetDefaults(old.GetName())
+
+		resp := validate(t, admission.Update, old, newDeployment(nil))
+		require.True(t, resp.Allowed, resp.Message)
+	})
+
+	t.Run("Update without spec change", func(t *testing.T) {
+		invalid := newDeployment(func(in *api.DeploymentSpec) {
+			in.Mode = api.NewMode("Unknown")
+		})
+		updated := invalid.DeepCopy()
+		updated.Finalizers = nil
+
+		resp := validate(t, admission.Update, invalid, updated)
+		require.True(t, resp.Allowed, resp.Message)
+	})
+}
@@ -0,0 +1,69 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package metric_descriptions
+
+import (
+	"github.com/arangodb/kube-arangodb/pkg/util/metrics"
+)
+
+var (
+	arangodbOperatorAgencyJobsOldestAge = metrics.NewDescription("arangodb_operator_agency_jobs_oldest_age", "Age of the oldest agency supervision job in seconds", []string{`namespace`, `name`, `phase`, `job_type`}, nil)
+)
+
+func init() {
+	registerDescription(arangodbOperatorAgencyJobsOldestAge)
+}
+
+func NewArangodbOperatorAgencyJobsOldestAgeGaugeFactory() metrics.FactoryGauge[ArangodbOperatorAgencyJobsOldestAgeInput] {
+	return metrics.NewFactoryGauge[ArangodbOperatorAgencyJobsOldestAgeInput]()
+}
+
+func NewArangodbOperatorAgencyJobsOldestAgeInput(namespace string, name string, phase string, jobType string) ArangodbOperatorAgencyJobsOldestAgeInput {
+	return ArangodbOperatorAgencyJobsOldestAgeInput{
+		Namespace: namespace,
+		Name:      name,
+		Phase:     phase,
+		JobType:   jobType,
+	}
+}
+
+type ArangodbOperatorAgencyJobsOldestAgeInput struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Phase     string `json:"phase"`
+	JobType   string `json:"jobType"`
+}
+
+func (i ArangodbOperatorAgencyJobsOldestAgeInput) Gauge(value float64) metrics.Metric {
+	return ArangodbOperatorAgencyJobsOldestAgeGauge(value, i.Namespace, i.Name, i.Phase, i.JobType)
+}
+
+func (i ArangodbOperatorAgencyJobsOldestAgeInput) Desc() metrics.Description {
+	return ArangodbOperatorAgencyJobsOldestAge()
+}
+
+func ArangodbOperatorAgencyJobsOldestAge() metrics.Description {
+	return arangodbOperatorAgencyJobsOldestAge
+}
+
+func ArangodbOperatorAgencyJobsOldestAgeGauge(value float64, namespace string, name string, phase string, jobType string) metrics.Metric {
+	return ArangodbOperatorAgencyJobsOldestAge().Gauge(value, namespace, name, phase, jobType)
+}
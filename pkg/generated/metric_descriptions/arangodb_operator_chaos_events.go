@@ -0,0 +1,75 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package metric_descriptions
+
+import (
+	"github.com/arangodb/kube-arangodb/pkg/util/metrics"
+)
+
+var (
+	arangodbOperatorChaosEvents = metrics.NewDescription("arangodb_operator_chaos_events", "Number of the chaos events injected by the chaos monkey", []string{`namespace`, `name`, `scenario`}, nil)
+
+	// Global Fields
+	globalArangodbOperatorChaosEventsCounter = NewArangodbOperatorChaosEventsCounterFactory()
+)
+
+func init() {
+	registerDescription(arangodbOperatorChaosEvents)
+	registerCollector(globalArangodbOperatorChaosEventsCounter)
+}
+
+func GlobalArangodbOperatorChaosEventsCounter() metrics.FactoryCounter[ArangodbOperatorChaosEventsInput] {
+	return globalArangodbOperatorChaosEventsCounter
+}
+
+func NewArangodbOperatorChaosEventsCounterFactory() metrics.FactoryCounter[ArangodbOperatorChaosEventsInput] {
+	return metrics.NewFactoryCounter[ArangodbOperatorChaosEventsInput]()
+}
+
+func NewArangodbOperatorChaosEventsInput(namespace string, name string, scenario string) ArangodbOperatorChaosEventsInput {
+	return ArangodbOperatorChaosEventsInput{
+		Namespace: namespace,
+		Name:      name,
+		Scenario:  scenario,
+	}
+}
+
+type ArangodbOperatorChaosEventsInput struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Scenario  string `json:"scenario"`
+}
+
+func (i ArangodbOperatorChaosEventsInput) Counter(value float64) metrics.Metric {
+	return ArangodbOperatorChaosEventsCounter(value, i.Namespace, i.Name, i.Scenario)
+}
+
+func (i ArangodbOperatorChaosEventsInput) Desc() metrics.Description {
+	return ArangodbOperatorChaosEvents()
+}
+
+func ArangodbOperatorChaosEvents() metrics.Description {
+	return arangodbOperatorChaosEvents
+}
+
+func ArangodbOperatorChaosEventsCounter(value float64, namespace string, name string, scenario string) metrics.Metric {
+	return ArangodbOperatorChaosEvents().Counter(value, namespace, name, scenario)
+}
@@ -0,0 +1,69 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package metric_descriptions
+
+import (
+	"github.com/arangodb/kube-arangodb/pkg/util/metrics"
+)
+
+var (
+	arangodbOperatorDeploymentJwtKeyAge = metrics.NewDescription("arangodb_operator_deployment_jwt_key_age", "Age of the JWT signing key in seconds", []string{`namespace`, `name`, `key`, `state`}, nil)
+)
+
+func init() {
+	registerDescription(arangodbOperatorDeploymentJwtKeyAge)
+}
+
+func NewArangodbOperatorDeploymentJwtKeyAgeGaugeFactory() metrics.FactoryGauge[ArangodbOperatorDeploymentJwtKeyAgeInput] {
+	return metrics.NewFactoryGauge[ArangodbOperatorDeploymentJwtKeyAgeInput]()
+}
+
+func NewArangodbOperatorDeploymentJwtKeyAgeInput(namespace string, name string, key string, state string) ArangodbOperatorDeploymentJwtKeyAgeInput {
+	return ArangodbOperatorDeploymentJwtKeyAgeInput{
+		Namespace: namespace,
+		Name:      name,
+		Key:       key,
+		State:     state,
+	}
+}
+
+type ArangodbOperatorDeploymentJwtKeyAgeInput struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Key       string `json:"key"`
+	State     string `json:"state"`
+}
+
+func (i ArangodbOperatorDeploymentJwtKeyAgeInput) Gauge(value float64) metrics.Metric {
+	return ArangodbOperatorDeploymentJwtKeyAgeGauge(value, i.Namespace, i.Name, i.Key, i.State)
+}
+
+func (i ArangodbOperatorDeploymentJwtKeyAgeInput) Desc() metrics.Description {
+	return ArangodbOperatorDeploymentJwtKeyAge()
+}
+
+func ArangodbOperatorDeploymentJwtKeyAge() metrics.Description {
+	return arangodbOperatorDeploymentJwtKeyAge
+}
+
+func ArangodbOperatorDeploymentJwtKeyAgeGauge(value float64, namespace string, name string, key string, state string) metrics.Metric {
+	return ArangodbOperatorDeploymentJwtKeyAge().Gauge(value, namespace, name, key, state)
+}
@@ -0,0 +1,67 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package metric_descriptions
+
+import (
+	"github.com/arangodb/kube-arangodb/pkg/util/metrics"
+)
+
+var (
+	arangodbOperatorLocalStorageAvailable = metrics.NewDescription("arangodb_operator_local_storage_available", "Space available on the filesystem containing the local path in bytes", []string{`name`, `node`, `path`}, nil)
+)
+
+func init() {
+	registerDescription(arangodbOperatorLocalStorageAvailable)
+}
+
+func NewArangodbOperatorLocalStorageAvailableGaugeFactory() metrics.FactoryGauge[ArangodbOperatorLocalStorageAvailableInput] {
+	return metrics.NewFactoryGauge[ArangodbOperatorLocalStorageAvailableInput]()
+}
+
+func NewArangodbOperatorLocalStorageAvailableInput(name string, node string, path string) ArangodbOperatorLocalStorageAvailableInput {
+	return ArangodbOperatorLocalStorageAvailableInput{
+		Name: name,
+		Node: node,
+		Path: path,
+	}
+}
+
+type ArangodbOperatorLocalStorageAvailableInput struct {
+	Name string `json:"name"`
+	Node string `json:"node"`
+	Path string `json:"path"`
+}
+
+func (i ArangodbOperatorLocalStorageAvailableInput) Gauge(value float64) metrics.Metric {
+	return ArangodbOperatorLocalStorageAvailableGauge(value, i.Name, i.Node, i.Path)
+}
+
+func (i ArangodbOperatorLocalStorageAvailableInput) Desc() metrics.Description {
+	return ArangodbOperatorLocalStorageAvailable()
+}
+
+func ArangodbOperatorLocalStorageAvailable() metrics.Description {
+	return arangodbOperatorLocalStorageAvailable
+}
+
+func ArangodbOperatorLocalStorageAvailableGauge(value float64, name string, node string, path string) metrics.Metric {
+	return ArangodbOperatorLocalStorageAvailable().Gauge(value, name, node, path)
+}
@@ -0,0 +1,67 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package metric_descriptions
+
+import (
+	"github.com/arangodb/kube-arangodb/pkg/util/metrics"
+)
+
+var (
+	arangodbOperatorLocalStorageCapacity = metrics.NewDescription("arangodb_operator_local_storage_capacity", "Capacity of the filesystem containing the local path in bytes", []string{`name`, `node`, `path`}, nil)
+)
+
+func init() {
+	registerDescription(arangodbOperatorLocalStorageCapacity)
+}
+
+func NewArangodbOperatorLocalStorageCapacityGaugeFactory() metrics.FactoryGauge[ArangodbOperatorLocalStorageCapacityInput] {
+	return metrics.NewFactoryGauge[ArangodbOperatorLocalStorageCapacityInput]()
+}
+
+func NewArangodbOperatorLocalStorageCapacityInput(name string, node string, path string) ArangodbOperatorLocalStorageCapacityInput {
+	return ArangodbOperatorLocalStorageCapacityInput{
+		Name: name,
+		Node: node,
+		Path: path,
+	}
+}
+
+type ArangodbOperatorLocalStorageCapacityInput struct {
+	Name string `json:"name"`
+	Node string `json:"node"`
+	Path string `json:"path"`
+}
+
+func (i ArangodbOperatorLocalStorageCapacityInput) Gauge(value float64) metrics.Metric {
+	return ArangodbOperatorLocalStorageCapacityGauge(value, i.Name, i.Node, i.Path)
+}
+
+func (i ArangodbOperatorLocalStorageCapacityInput) Desc() metrics.Description {
+	return ArangodbOperatorLocalStorageCapacity()
+}
+
+func ArangodbOperatorLocalStorageCapacity() metrics.Description {
+	return arangodbOperatorLocalStorageCapacity
+}
+
+func ArangodbOperatorLocalStorageCapacityGauge(value float64, name string, node string, path string) metrics.Metric {
+	return ArangodbOperatorLocalStorageCapacity().Gauge(value, name, node, path)
+}
@@ -0,0 +1,65 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package metric_descriptions
+
+import (
+	"github.com/arangodb/kube-arangodb/pkg/util/metrics"
+)
+
+var (
+	arangodbOperatorTopologyShardsSameZone = metrics.NewDescription("arangodb_operator_topology_shards_same_zone", "Number of the shards which replicas share the topology zone", []string{`namespace`, `name`}, nil)
+)
+
+func init() {
+	registerDescription(arangodbOperatorTopologyShardsSameZone)
+}
+
+func NewArangodbOperatorTopologyShardsSameZoneGaugeFactory() metrics.FactoryGauge[ArangodbOperatorTopologyShardsSameZoneInput] {
+	return metrics.NewFactoryGauge[ArangodbOperatorTopologyShardsSameZoneInput]()
+}
+
+func NewArangodbOperatorTopologyShardsSameZoneInput(namespace string, name string) ArangodbOperatorTopologyShardsSameZoneInput {
+	return ArangodbOperatorTopologyShardsSameZoneInput{
+		Namespace: namespace,
+		Name:      name,
+	}
+}
+
+type ArangodbOperatorTopologyShardsSameZoneInput struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+func (i ArangodbOperatorTopologyShardsSameZoneInput) Gauge(value float64) metrics.Metric {
+	return ArangodbOperatorTopologyShardsSameZoneGauge(value, i.Namespace, i.Name)
+}
+
+func (i ArangodbOperatorTopologyShardsSameZoneInput) Desc() metrics.Description {
+	return ArangodbOperatorTopologyShardsSameZone()
+}
+
+func ArangodbOperatorTopologyShardsSameZone() metrics.Description {
+	return arangodbOperatorTopologyShardsSameZone
+}
+
+func ArangodbOperatorTopologyShardsSameZoneGauge(value float64, namespace string, name string) metrics.Metric {
+	return ArangodbOperatorTopologyShardsSameZone().Gauge(value, namespace, name)
+}
@@ -77,5 +77,8 @@ type ArangoBackupClient interface {
 
 	HealthCheck() error
 
+	// Query executes the AQL query in the database and returns the number of the results
+	Query(database, query string) (int, error)
+
 	List() (map[string]adbDriverV2.BackupMeta, error)
 }
@@ -34,6 +34,7 @@ import (
 
 	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	arangoClientSet "github.com/arangodb/kube-arangodb/pkg/generated/clientset/versioned"
 	"github.com/arangodb/kube-arangodb/pkg/util"
 	"github.com/arangodb/kube-arangodb/pkg/util/arangod"
 	"github.com/arangodb/kube-arangodb/pkg/util/errors"
@@ -46,6 +47,7 @@ type arangoClientBackupImpl struct {
 	backup     *backupApi.ArangoBackup
 	driver     adbDriverV2.Client
 	kubecli    kubernetes.Interface
+	client     arangoClientSet.Interface
 }
 
 func newArangoClientBackupFactory(handler *handler) ArangoClientFactory {
@@ -61,6 +63,7 @@ func newArangoClientBackupFactory(handler *handler) ArangoClientFactory {
 			backup:     backup,
 			driver:     client,
 			kubecli:    handler.kubeClient,
+			client:     handler.client,
 		}, nil
 	}
 }
@@ -197,12 +200,12 @@ func (ac *arangoClientBackupImpl) Upload(backupID string) (string, error) {
 		return "", errors.Errorf("upload was called but no upload spec was given")
 	}
 
-	cred, err := ac.getCredentialsFromSecret(ctx, uploadSpec.CredentialsSecretName)
+	repository, cred, err := ac.getRepository(ctx, *uploadSpec)
 	if err != nil {
 		return "", err
 	}
 
-	if transfer, err := ac.driver.BackupUpload(ctx, backupID, uploadSpec.RepositoryURL, cred); err != nil {
+	if transfer, err := ac.driver.BackupUpload(ctx, backupID, repository, cred); err != nil {
 		return "", err
 	} else {
 		return transfer.GetID(), err
@@ -218,12 +221,12 @@ func (ac *arangoClientBackupImpl) Download(backupID string) (string, error) {
 		return "", errors.Errorf("Download was called but not download spec was given")
 	}
 
-	cred, err := ac.getCredentialsFromSecret(ctx, downloadSpec.CredentialsSecretName)
+	repository, cred, err := ac.getRepository(ctx, downloadSpec.ArangoBackupSpecOperation)
 	if err != nil {
 		return "", err
 	}
 
-	if transfer, err := ac.driver.BackupDownload(ctx, backupID, downloadSpec.RepositoryURL, cred); err != nil {
+	if transfer, err := ac.driver.BackupDownload(ctx, backupID, repository, cred); err != nil {
 		return "", err
 	} else {
 		return transfer.GetID(), err
@@ -322,3 +325,31 @@ func (ac *arangoClientBackupImpl) HealthCheck() error {
 	_, err := ac.driver.Version(ctx)
 	return err
 }
+
+func (ac *arangoClientBackupImpl) Query(database, query string) (int, error) {
+	ctx, cancel := globals.GetGlobalTimeouts().BackupArangoClientTimeout().WithTimeout(context.Background())
+	defer cancel()
+
+	db, err := ac.driver.GetDatabase(ctx, database, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	cursor, err := db.Query(ctx, query, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close()
+
+	results := 0
+	for cursor.HasMore() {
+		var doc interface{}
+		if _, err := cursor.ReadDocument(ctx, &doc); err != nil {
+			return results, err
+		}
+
+		results++
+	}
+
+	return results, nil
+}
@@ -59,12 +59,13 @@ func newMockArangoClientBackup(errors mockErrorsArangoClientBackup) *mockArangoC
 	return &mockArangoClientBackupState{
 		backups:    map[string]adbDriverV2.BackupMeta{},
 		progresses: map[string]ArangoBackupProgress{},
+		queries:    map[string]int{},
 		errors:     errors,
 	}
 }
 
 type mockErrorsArangoClientBackup struct {
-	createError, listError, getError, uploadError, downloadError, progressError, existsError, deleteError, abortError, healthCheckError error
+	createError, listError, getError, uploadError, downloadError, progressError, existsError, deleteError, abortError, healthCheckError, queryError error
 }
 
 type mockArangoClientBackupState struct {
@@ -72,6 +73,7 @@ type mockArangoClientBackupState struct {
 
 	backups    map[string]adbDriverV2.BackupMeta
 	progresses map[string]ArangoBackupProgress
+	queries    map[string]int
 	createDone bool
 
 	errors mockErrorsArangoClientBackup
@@ -272,3 +274,14 @@ func (m *mockArangoClientBackup) getProgressIDs() []string {
 }
 
 var _ ArangoBackupClient = &mockArangoClientBackup{}
+
+func (m *mockArangoClientBackup) Query(database, query string) (int, error) {
+	m.state.lock.Lock()
+	defer m.state.lock.Unlock()
+
+	if m.state.errors.queryError != nil {
+		return 0, m.state.errors.queryError
+	}
+
+	return m.state.queries[query], nil
+}
@@ -0,0 +1,185 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	platformApi "github.com/arangodb/kube-arangodb/pkg/apis/platform/v1beta1"
+	utilConstants "github.com/arangodb/kube-arangodb/pkg/util/constants"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+)
+
+// repositoryConfig keeps the rclone remote configuration passed to arangod
+type repositoryConfig map[string]map[string]string
+
+type secretGetter func(ctx context.Context, name string) (*core.Secret, error)
+
+// getRepository returns the repository URL and credentials used by arangod for the given operation
+func (ac *arangoClientBackupImpl) getRepository(ctx context.Context, spec backupApi.ArangoBackupSpecOperation) (string, interface{}, error) {
+	if spec.Storage == nil {
+		cred, err := ac.getCredentialsFromSecret(ctx, spec.CredentialsSecretName)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return spec.RepositoryURL, cred, nil
+	}
+
+	ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
+	defer cancel()
+
+	storage, err := ac.client.PlatformV1beta1().ArangoPlatformStorages(ac.backup.Namespace).Get(ctxChild, spec.Storage.GetName(), meta.GetOptions{})
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "unable to get ArangoPlatformStorage %s", spec.Storage.GetName())
+	}
+
+	url, config, err := newStorageRepository(ctx, storage, func(ctx context.Context, name string) (*core.Secret, error) {
+		ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
+		defer cancel()
+
+		return ac.kubecli.CoreV1().Secrets(storage.GetNamespace()).Get(ctxChild, name, meta.GetOptions{})
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	return url, config, nil
+}
+
+// newStorageRepository translates the ArangoPlatformStorage backend into the rclone remote understood by arangod
+func newStorageRepository(ctx context.Context, storage *platformApi.ArangoPlatformStorage, secrets secretGetter) (string, repositoryConfig, error) {
+	if err := storage.Spec.Validate(); err != nil {
+		return "", nil, errors.Wrapf(err, "invalid ArangoPlatformStorage %s", storage.GetName())
+	}
+
+	remote := storage.GetName()
+	backend := storage.Spec.GetBackend()
+
+	getSecretKeys := func(obj interface {
+		GetName() string
+		GetNamespace(meta.Object) string
+	}, keys ...string) ([]string, error) {
+		if obj.GetNamespace(storage) != storage.GetNamespace() {
+			return nil, errors.Errorf("Secrets from different namespace are not supported")
+		}
+
+		secret, err := secrets(ctx, obj.GetName())
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to get Secret %s", obj.GetName())
+		}
+
+		r := make([]string, len(keys))
+
+		for id, key := range keys {
+			v, ok := secret.Data[key]
+			if !ok {
+				return nil, errors.Errorf("Key %s is missing in the Secret %s", key, obj.GetName())
+			}
+
+			r[id] = string(v)
+		}
+
+		return r, nil
+	}
+
+	if s3 := backend.GetS3(); s3 != nil {
+		if !s3.GetCASecret().IsEmpty() || s3.GetAllowInsecure() {
+			// rclone expects the CA as a file on the DBServers, which is not mounted there
+			return "", nil, errors.Errorf("Custom CA and insecure S3 endpoints are not supported for backup transfer")
+		}
+
+		keys, err := getSecretKeys(s3.GetCredentialsSecret(), utilConstants.SecretCredentialsAccessKey, utilConstants.SecretCredentialsSecretKey)
+		if err != nil {
+			return "", nil, err
+		}
+
+		config := map[string]string{
+			"type":              "s3",
+			"provider":          "Other",
+			"env_auth":          "false",
+			"access_key_id":     keys[0],
+			"secret_access_key": keys[1],
+			"endpoint":          s3.GetEndpoint(),
+		}
+
+		if region := s3.GetRegion(); region != "" {
+			config["region"] = region
+		}
+
+		return storageRepositoryURL(remote, s3.GetBucketName(), s3.GetBucketPrefix()), repositoryConfig{remote: config}, nil
+	}
+
+	if gcs := backend.GetGCS(); gcs != nil {
+		keys, err := getSecretKeys(gcs.GetCredentialsSecret(), utilConstants.SecretCredentialsServiceAccount)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return storageRepositoryURL(remote, gcs.GetBucketName(), gcs.GetBucketPrefix()), repositoryConfig{remote: {
+			"type":                        "google cloud storage",
+			"project_number":              gcs.GetProjectID(),
+			"service_account_credentials": keys[0],
+			"bucket_policy_only":          "true",
+		}}, nil
+	}
+
+	if abs := backend.GetAzureBlobStorage(); abs != nil {
+		if abs.ClientCertificateSecret != nil {
+			return "", nil, errors.Errorf("Azure client certificate authorization is not supported for backup transfer")
+		}
+
+		keys, err := getSecretKeys(abs.GetCredentialsSecret(), utilConstants.SecretCredentialsAzureBlobStorageClientID, utilConstants.SecretCredentialsAzureBlobStorageClientSecret)
+		if err != nil {
+			return "", nil, err
+		}
+
+		config := map[string]string{
+			"type":          "azureblob",
+			"tenant":        abs.GetTenantID(),
+			"client_id":     keys[0],
+			"client_secret": keys[1],
+		}
+
+		if account := abs.GetAccountName(); account != "" {
+			config["account"] = account
+		}
+
+		if endpoint := abs.GetEndpoint(); endpoint != "" {
+			config["endpoint"] = endpoint
+		}
+
+		return storageRepositoryURL(remote, abs.GetBucketName(), abs.GetBucketPrefix()), repositoryConfig{remote: config}, nil
+	}
+
+	return "", nil, errors.Errorf("Backend is not supported")
+}
+
+func storageRepositoryURL(remote, bucket, prefix string) string {
+	return fmt.Sprintf("%s:%s", remote, path.Join(bucket, prefix))
+}
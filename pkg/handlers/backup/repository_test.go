@@ -0,0 +1,312 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	goHttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+
+	adbDriverV2 "github.com/arangodb/go-driver/v2/arangodb"
+	adbDriverV2Connection "github.com/arangodb/go-driver/v2/connection"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	platformApi "github.com/arangodb/kube-arangodb/pkg/apis/platform/v1beta1"
+	sharedApi "github.com/arangodb/kube-arangodb/pkg/apis/shared/v1"
+	fakeClientSet "github.com/arangodb/kube-arangodb/pkg/generated/clientset/versioned/fake"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	utilConstants "github.com/arangodb/kube-arangodb/pkg/util/constants"
+)
+
+func newRepositoryStorage(backend platformApi.ArangoPlatformStorageSpecBackend) *platformApi.ArangoPlatformStorage {
+	return &platformApi.ArangoPlatformStorage{
+		ObjectMeta: meta.ObjectMeta{
+			Name:      "storage",
+			Namespace: "test",
+		},
+		Spec: platformApi.ArangoPlatformStorageSpec{
+			Backend: &backend,
+		},
+	}
+}
+
+func newRepositorySecrets(secrets ...*core.Secret) secretGetter {
+	return func(ctx context.Context, name string) (*core.Secret, error) {
+		for _, s := range secrets {
+			if s.GetName() == name {
+				return s, nil
+			}
+		}
+
+		return nil, apiErrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, name)
+	}
+}
+
+func newRepositorySecret(name string, data map[string]string) *core.Secret {
+	s := &core.Secret{
+		ObjectMeta: meta.ObjectMeta{
+			Name: name,
+		},
+		Data: map[string][]byte{},
+	}
+
+	for k, v := range data {
+		s.Data[k] = []byte(v)
+	}
+
+	return s
+}
+
+func Test_Repository_Storage(t *testing.T) {
+	t.Run("S3", func(t *testing.T) {
+		storage := newRepositoryStorage(platformApi.ArangoPlatformStorageSpecBackend{
+			S3: &platformApi.ArangoPlatformStorageSpecBackendS3{
+				BucketName:        util.NewType("bucket"),
+				BucketPrefix:      util.NewType("backups/prod"),
+				Endpoint:          util.NewType("https://s3.example.com"),
+				Region:            util.NewType("eu-central-1"),
+				CredentialsSecret: &sharedApi.Object{Name: "creds"},
+			},
+		})
+
+		url, config, err := newStorageRepository(context.Background(), storage, newRepositorySecrets(newRepositorySecret("creds", map[string]string{
+			utilConstants.SecretCredentialsAccessKey: "access",
+			utilConstants.SecretCredentialsSecretKey: "secret",
+		})))
+		require.NoError(t, err)
+
+		require.Equal(t, "storage:bucket/backups/prod", url)
+		require.Equal(t, repositoryConfig{
+			"storage": {
+				"type":              "s3",
+				"provider":          "Other",
+				"env_auth":          "false",
+				"access_key_id":     "access",
+				"secret_access_key": "secret",
+				"endpoint":          "https://s3.example.com",
+				"region":            "eu-central-1",
+			},
+		}, config)
+	})
+
+	t.Run("S3 with missing key", func(t *testing.T) {
+		storage := newRepositoryStorage(platformApi.ArangoPlatformStorageSpecBackend{
+			S3: &platformApi.ArangoPlatformStorageSpecBackendS3{
+				BucketName:        util.NewType("bucket"),
+				Endpoint:          util.NewType("https://s3.example.com"),
+				CredentialsSecret: &sharedApi.Object{Name: "creds"},
+			},
+		})
+
+		_, _, err := newStorageRepository(context.Background(), storage, newRepositorySecrets(newRepositorySecret("creds", map[string]string{
+			utilConstants.SecretCredentialsAccessKey: "access",
+		})))
+		require.EqualError(t, err, "Key secretKey is missing in the Secret creds")
+	})
+
+	t.Run("S3 with custom CA", func(t *testing.T) {
+		storage := newRepositoryStorage(platformApi.ArangoPlatformStorageSpecBackend{
+			S3: &platformApi.ArangoPlatformStorageSpecBackendS3{
+				BucketName:        util.NewType("bucket"),
+				Endpoint:          util.NewType("https://s3.example.com"),
+				CredentialsSecret: &sharedApi.Object{Name: "creds"},
+				CASecret:          &sharedApi.Object{Name: "ca"},
+			},
+		})
+
+		_, _, err := newStorageRepository(context.Background(), storage, newRepositorySecrets())
+		require.EqualError(t, err, "Custom CA and insecure S3 endpoints are not supported for backup transfer")
+	})
+
+	t.Run("S3 insecure", func(t *testing.T) {
+		storage := newRepositoryStorage(platformApi.ArangoPlatformStorageSpecBackend{
+			S3: &platformApi.ArangoPlatformStorageSpecBackendS3{
+				BucketName:        util.NewType("bucket"),
+				Endpoint:          util.NewType("https://s3.example.com"),
+				CredentialsSecret: &sharedApi.Object{Name: "creds"},
+				AllowInsecure:     util.NewType(true),
+			},
+		})
+
+		_, _, err := newStorageRepository(context.Background(), storage, newRepositorySecrets())
+		require.EqualError(t, err, "Custom CA and insecure S3 endpoints are not supported for backup transfer")
+	})
+
+	t.Run("GCS", func(t *testing.T) {
+		storage := newRepositoryStorage(platformApi.ArangoPlatformStorageSpecBackend{
+			GCS: &platformApi.ArangoPlatformStorageSpecBackendGCS{
+				ProjectID:         util.NewType("project"),
+				BucketName:        util.NewType("bucket"),
+				CredentialsSecret: &sharedApi.Object{Name: "creds"},
+			},
+		})
+
+		url, config, err := newStorageRepository(context.Background(), storage, newRepositorySecrets(newRepositorySecret("creds", map[string]string{
+			utilConstants.SecretCredentialsServiceAccount: "{}",
+		})))
+		require.NoError(t, err)
+
+		require.Equal(t, "storage:bucket", url)
+		require.Equal(t, "google cloud storage", config["storage"]["type"])
+		require.Equal(t, "project", config["storage"]["project_number"])
+		require.Equal(t, "{}", config["storage"]["service_account_credentials"])
+	})
+
+	t.Run("Azure", func(t *testing.T) {
+		storage := newRepositoryStorage(platformApi.ArangoPlatformStorageSpecBackend{
+			AzureBlobStorage: &platformApi.ArangoPlatformStorageSpecBackendAzureBlobStorage{
+				TenantID:          util.NewType("tenant"),
+				AccountName:       util.NewType("account"),
+				BucketName:        util.NewType("container"),
+				BucketPrefix:      util.NewType("prefix"),
+				CredentialsSecret: &sharedApi.Object{Name: "creds"},
+			},
+		})
+
+		url, config, err := newStorageRepository(context.Background(), storage, newRepositorySecrets(newRepositorySecret("creds", map[string]string{
+			utilConstants.SecretCredentialsAzureBlobStorageClientID:     "id",
+			utilConstants.SecretCredentialsAzureBlobStorageClientSecret: "secret",
+		})))
+		require.NoError(t, err)
+
+		require.Equal(t, "storage:container/prefix", url)
+		require.Equal(t, repositoryConfig{
+			"storage": {
+				"type":          "azureblob",
+				"tenant":        "tenant",
+				"account":       "account",
+				"client_id":     "id",
+				"client_secret": "secret",
+			},
+		}, config)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, _, err := newStorageRepository(context.Background(), newRepositoryStorage(platformApi.ArangoPlatformStorageSpecBackend{}), newRepositorySecrets())
+		require.Error(t, err)
+	})
+}
+
+func Test_Repository_Upload(t *testing.T) {
+	var requests []map[string]interface{}
+
+	server := httptest.NewServer(goHttp.HandlerFunc(func(w goHttp.ResponseWriter, r *goHttp.Request) {
+		if r.Method != goHttp.MethodPost || r.URL.Path != "/_admin/backup/upload" {
+			w.WriteHeader(goHttp.StatusNotFound)
+			return
+		}
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(goHttp.StatusBadRequest)
+			return
+		}
+
+		requests = append(requests, body)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(goHttp.StatusAccepted)
+		_, _ = w.Write([]byte(`{"error":false,"code":202,"result":{"uploadId":"upload-1"}}`))
+	}))
+	defer server.Close()
+
+	driver := adbDriverV2.NewClient(adbDriverV2Connection.NewHttpConnection(adbDriverV2Connection.HttpConfiguration{
+		Endpoint:    adbDriverV2Connection.NewRoundRobinEndpoints([]string{server.URL}),
+		ContentType: adbDriverV2Connection.ApplicationJSON,
+	}))
+
+	storage := newRepositoryStorage(platformApi.ArangoPlatformStorageSpecBackend{
+		S3: &platformApi.ArangoPlatformStorageSpecBackendS3{
+			BucketName:        util.NewType("bucket"),
+			BucketPrefix:      util.NewType("backups"),
+			Endpoint:          util.NewType("https://s3.example.com"),
+			CredentialsSecret: &sharedApi.Object{Name: "creds"},
+		},
+	})
+
+	kubecli := fake.NewSimpleClientset(&core.Secret{
+		ObjectMeta: meta.ObjectMeta{
+			Name:      "creds",
+			Namespace: "test",
+		},
+		Data: map[string][]byte{
+			utilConstants.SecretCredentialsAccessKey: []byte("access"),
+			utilConstants.SecretCredentialsSecretKey: []byte("secret"),
+		},
+	})
+	client := fakeClientSet.NewSimpleClientset(storage)
+
+	ac := &arangoClientBackupImpl{
+		backup: &backupApi.ArangoBackup{
+			ObjectMeta: meta.ObjectMeta{
+				Name:      "backup",
+				Namespace: "test",
+			},
+			Spec: backupApi.ArangoBackupSpec{
+				Upload: &backupApi.ArangoBackupSpecOperation{
+					Storage: &sharedApi.Object{Name: "storage"},
+				},
+			},
+		},
+		driver:  driver,
+		kubecli: kubecli,
+		client:  client,
+	}
+
+	id, err := ac.Upload("backup-id")
+	require.NoError(t, err)
+	require.Equal(t, "upload-1", id)
+
+	require.Len(t, requests, 1)
+	require.Equal(t, map[string]interface{}{
+		"id":               "backup-id",
+		"remoteRepository": "storage:bucket/backups",
+		"config": map[string]interface{}{
+			"storage": map[string]interface{}{
+				"type":              "s3",
+				"provider":          "Other",
+				"env_auth":          "false",
+				"access_key_id":     "access",
+				"secret_access_key": "secret",
+				"endpoint":          "https://s3.example.com",
+			},
+		},
+	}, requests[0])
+
+	t.Run("Custom CA", func(t *testing.T) {
+		storage.Spec.Backend.S3.CASecret = &sharedApi.Object{Name: "ca"}
+		_, err := client.PlatformV1beta1().ArangoPlatformStorages("test").Update(context.Background(), storage, meta.UpdateOptions{})
+		require.NoError(t, err)
+
+		_, err = ac.Upload("backup-id")
+		require.EqualError(t, err, "Custom CA and insecure S3 endpoints are not supported for backup transfer")
+		require.Len(t, requests, 1)
+	})
+}
@@ -133,8 +133,9 @@ func stateReadyHandler(h *handler, backup *backupApi.ArangoBackup) (*backupApi.A
 		)
 	}
 
-	// handle AutoDelete case
-	if backup.Spec.Upload != nil && backup.Spec.Upload.AutoDelete != nil && *backup.Spec.Upload.AutoDelete {
+	// handle AutoDelete case, backup is kept until the verification is finished
+	if backup.Spec.Upload != nil && backup.Spec.Upload.AutoDelete != nil && *backup.Spec.Upload.AutoDelete &&
+		(!backup.Spec.Verification.IsEnabled() || backup.Status.Verification.IsFinished()) {
 		err := h.client.BackupV1().ArangoBackups(backup.Namespace).Delete(context.Background(), backup.Name, meta.DeleteOptions{})
 		if err != nil {
 			return nil, err
@@ -145,5 +146,6 @@ func stateReadyHandler(h *handler, backup *backupApi.ArangoBackup) (*backupApi.A
 	return wrapUpdateStatus(backup,
 		updateStatusBackup(backupMeta),
 		updateStatusAvailable(true),
+		h.updateStatusVerification(backup, deployment),
 	)
 }
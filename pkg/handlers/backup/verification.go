@@ -0,0 +1,362 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package backup
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"time"
+
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// updateStatusVerification progresses the verification of the backup and returns the status update
+func (h *handler) updateStatusVerification(backup *backupApi.ArangoBackup, deployment *api.ArangoDeployment) updateStatusFunc {
+	if !backup.Spec.Verification.IsEnabled() || backup.Status.Verification.IsFinished() {
+		return func(status *backupApi.ArangoBackupStatus) {}
+	}
+
+	verification, err := h.verifyBackup(backup, deployment)
+	if err != nil {
+		logger.Err(err).Warn("Verification of %s/%s failed", backup.Namespace, backup.Name)
+		return func(status *backupApi.ArangoBackupStatus) {}
+	}
+
+	return updateStatusVerification(verification)
+}
+
+func updateStatusVerification(verification *backupApi.ArangoBackupStatusVerification) updateStatusFunc {
+	return func(status *backupApi.ArangoBackupStatus) {
+		status.Verification = verification
+
+		switch verification.Phase {
+		case backupApi.ArangoBackupVerificationPhaseSucceeded:
+			status.Conditions.Update(backupApi.ConditionTypeVerified, true, "Verified", "Backup restored and verified")
+		case backupApi.ArangoBackupVerificationPhaseFailed:
+			status.Conditions.Update(backupApi.ConditionTypeVerified, false, "VerificationFailed", verification.Message)
+		default:
+			status.Conditions.Update(backupApi.ConditionTypeVerified, false, "InProgress", fmt.Sprintf("Verification in phase %s", verification.Phase))
+		}
+	}
+}
+
+// verifyBackup executes one step of the verification and returns the new verification state
+func (h *handler) verifyBackup(backup *backupApi.ArangoBackup, deployment *api.ArangoDeployment) (*backupApi.ArangoBackupStatusVerification, error) {
+	current := backup.Status.Verification.DeepCopy()
+	if current == nil {
+		current = &backupApi.ArangoBackupStatusVerification{}
+	}
+
+	if current.Phase == "" {
+		return h.verifyBackupDeploy(backup, deployment, current)
+	}
+
+	if start := current.StartTime; start != nil && start.Add(backup.Spec.Verification.GetTimeout()).Before(time.Now()) {
+		return h.verifyBackupFinish(backup, current, errors.Errorf("Verification timed out after %s", backup.Spec.Verification.GetTimeout().String()))
+	}
+
+	scratch, err := util.WithKubernetesContextTimeoutP2A2(context.Background(), h.client.DatabaseV1().ArangoDeployments(backup.Namespace).Get, current.Deployment, meta.GetOptions{})
+	if err != nil {
+		if apiErrors.IsNotFound(err) {
+			return h.verifyBackupFinish(backup, current, errors.Errorf("Temporary deployment %s is missing", current.Deployment))
+		}
+		return nil, err
+	}
+
+	switch current.Phase {
+	case backupApi.ArangoBackupVerificationPhaseDeploying:
+		return h.verifyBackupDownload(backup, scratch, current)
+	case backupApi.ArangoBackupVerificationPhaseDownloading:
+		return h.verifyBackupRestore(backup, scratch, current)
+	case backupApi.ArangoBackupVerificationPhaseRestoring:
+		return h.verifyBackupRestored(backup, scratch, current)
+	case backupApi.ArangoBackupVerificationPhaseQuerying:
+		return h.verifyBackupQueries(backup, scratch, current)
+	}
+
+	return h.verifyBackupFinish(backup, current, errors.Errorf("Unknown verification phase %s", current.Phase))
+}
+
+// verifyBackupDeploy creates the temporary deployment with the topology of the source deployment
+func (h *handler) verifyBackupDeploy(backup *backupApi.ArangoBackup, deployment *api.ArangoDeployment, current *backupApi.ArangoBackupStatusVerification) (*backupApi.ArangoBackupStatusVerification, error) {
+	if _, err := getVerificationRepository(backup); err != nil {
+		now := meta.Now()
+		current.StartTime = &now
+		return h.verifyBackupFinish(backup, current, err)
+	}
+
+	scratch := newVerificationDeployment(backup, deployment)
+
+	if _, err := util.WithKubernetesContextTimeoutP2A2(context.Background(), h.client.DatabaseV1().ArangoDeployments(backup.Namespace).Create, scratch, meta.CreateOptions{}); err != nil {
+		if apiErrors.IsForbidden(err) {
+			now := meta.Now()
+			current.StartTime = &now
+			return h.verifyBackupFinish(backup, current, errors.Errorf("Operator is not allowed to create the verification deployment, enable the rbac.extensions.backupVerification chart value"))
+		}
+
+		if !apiErrors.IsAlreadyExists(err) {
+			return nil, err
+		}
+	}
+
+	now := meta.Now()
+	current.Phase = backupApi.ArangoBackupVerificationPhaseDeploying
+	current.Deployment = scratch.GetName()
+	current.StartTime = &now
+
+	return current, nil
+}
+
+// verifyBackupDownload downloads the backup into the temporary deployment once it is ready
+func (h *handler) verifyBackupDownload(backup *backupApi.ArangoBackup, scratch *api.ArangoDeployment, current *backupApi.ArangoBackupStatusVerification) (*backupApi.ArangoBackupStatusVerification, error) {
+	if !scratch.Status.Conditions.IsTrue(api.ConditionTypeReady) {
+		return current, nil
+	}
+
+	repository, err := getVerificationRepository(backup)
+	if err != nil {
+		return h.verifyBackupFinish(backup, current, err)
+	}
+
+	download := &backupApi.ArangoBackup{
+		ObjectMeta: meta.ObjectMeta{
+			Name:            scratch.GetName(),
+			Namespace:       backup.Namespace,
+			Labels:          map[string]string{backupApi.LabelArangoBackupVerification: backup.GetName()},
+			OwnerReferences: []meta.OwnerReference{backup.AsOwner()},
+		},
+		Spec: backupApi.ArangoBackupSpec{
+			Deployment: backupApi.ArangoBackupSpecDeployment{
+				Name: scratch.GetName(),
+			},
+			Download: &backupApi.ArangoBackupSpecDownload{
+				ArangoBackupSpecOperation: repository,
+				ID:                        backup.Status.Backup.ID,
+			},
+		},
+	}
+
+	if _, err := util.WithKubernetesContextTimeoutP2A2(context.Background(), h.client.BackupV1().ArangoBackups(backup.Namespace).Create, download, meta.CreateOptions{}); err != nil {
+		if !apiErrors.IsAlreadyExists(err) {
+			return nil, err
+		}
+	}
+
+	current.Phase = backupApi.ArangoBackupVerificationPhaseDownloading
+	current.Download = download.GetName()
+
+	return current, nil
+}
+
+// verifyBackupRestore requests the restore of the downloaded backup in the temporary deployment
+func (h *handler) verifyBackupRestore(backup *backupApi.ArangoBackup, scratch *api.ArangoDeployment, current *backupApi.ArangoBackupStatusVerification) (*backupApi.ArangoBackupStatusVerification, error) {
+	download, err := util.WithKubernetesContextTimeoutP2A2(context.Background(), h.client.BackupV1().ArangoBackups(backup.Namespace).Get, current.Download, meta.GetOptions{})
+	if err != nil {
+		if apiErrors.IsNotFound(err) {
+			return h.verifyBackupFinish(backup, current, errors.Errorf("Downloaded backup %s is missing", current.Download))
+		}
+		return nil, err
+	}
+
+	switch download.Status.State {
+	case backupApi.ArangoBackupStateFailed, backupApi.ArangoBackupStateDownloadError:
+		return h.verifyBackupFinish(backup, current, errors.Errorf("Download failed: %s", download.Status.Message))
+	case backupApi.ArangoBackupStateReady:
+		if download.Status.Backup == nil {
+			return current, nil
+		}
+	default:
+		return current, nil
+	}
+
+	scratch.Spec.RestoreFrom = util.NewType[string](download.GetName())
+
+	if _, err := util.WithKubernetesContextTimeoutP2A2(context.Background(), h.client.DatabaseV1().ArangoDeployments(backup.Namespace).Update, scratch, meta.UpdateOptions{}); err != nil {
+		return nil, err
+	}
+
+	current.Phase = backupApi.ArangoBackupVerificationPhaseRestoring
+
+	return current, nil
+}
+
+// verifyBackupRestored waits for the restore result in the temporary deployment
+func (h *handler) verifyBackupRestored(backup *backupApi.ArangoBackup, scratch *api.ArangoDeployment, current *backupApi.ArangoBackupStatusVerification) (*backupApi.ArangoBackupStatusVerification, error) {
+	restore := scratch.Status.Restore
+	if restore == nil || restore.RequestedFrom != current.Download {
+		return current, nil
+	}
+
+	switch restore.State {
+	case api.DeploymentRestoreStateRestored:
+		current.Phase = backupApi.ArangoBackupVerificationPhaseQuerying
+		return current, nil
+	case api.DeploymentRestoreStateRestoreFailed:
+		return h.verifyBackupFinish(backup, current, errors.Errorf("Restore failed: %s", restore.Message))
+	}
+
+	return current, nil
+}
+
+// verifyBackupQueries executes the sanity queries on the restored data
+func (h *handler) verifyBackupQueries(backup *backupApi.ArangoBackup, scratch *api.ArangoDeployment, current *backupApi.ArangoBackupStatusVerification) (*backupApi.ArangoBackupStatusVerification, error) {
+	if !scratch.Status.Conditions.IsTrue(api.ConditionTypeReady) {
+		return current, nil
+	}
+
+	queries := backup.Spec.Verification.Queries
+
+	if len(queries) > 0 {
+		client, err := h.arangoClientFactory(scratch, backup)
+		if err != nil {
+			return nil, err
+		}
+
+		current.Queries = make([]backupApi.ArangoBackupStatusVerificationQuery, len(queries))
+
+		var failed []string
+
+		for id, q := range queries {
+			result := backupApi.ArangoBackupStatusVerificationQuery{
+				Name: q.Name,
+			}
+
+			if count, err := client.Query(q.GetDatabase(), q.Query); err != nil {
+				result.Message = err.Error()
+			} else if result.Results = count; count < q.GetMinResults() {
+				result.Message = fmt.Sprintf("Expected at least %d results, got %d", q.GetMinResults(), count)
+			} else {
+				result.Succeeded = true
+			}
+
+			if !result.Succeeded {
+				failed = append(failed, q.Name)
+			}
+
+			current.Queries[id] = result
+		}
+
+		if len(failed) > 0 {
+			return h.verifyBackupFinish(backup, current, errors.Errorf("Queries failed: %s", strings.Join(failed, ", ")))
+		}
+	}
+
+	return h.verifyBackupFinish(backup, current, nil)
+}
+
+// verifyBackupFinish removes the temporary resources and marks the verification as finished
+func (h *handler) verifyBackupFinish(backup *backupApi.ArangoBackup, current *backupApi.ArangoBackupStatusVerification, reason error) (*backupApi.ArangoBackupStatusVerification, error) {
+	if current.Download != "" {
+		if err := util.WithKubernetesContextTimeoutP1A2(context.Background(), h.client.BackupV1().ArangoBackups(backup.Namespace).Delete, current.Download, meta.DeleteOptions{}); err != nil && !apiErrors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	if current.Deployment != "" {
+		if err := util.WithKubernetesContextTimeoutP1A2(context.Background(), h.client.DatabaseV1().ArangoDeployments(backup.Namespace).Delete, current.Deployment, meta.DeleteOptions{}); err != nil && !apiErrors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	now := meta.Now()
+	current.FinishTime = &now
+
+	if reason != nil {
+		current.Phase = backupApi.ArangoBackupVerificationPhaseFailed
+		current.Message = reason.Error()
+	} else {
+		current.Phase = backupApi.ArangoBackupVerificationPhaseSucceeded
+		current.Message = ""
+	}
+
+	return current, nil
+}
+
+// getVerificationRepository returns the repository from which backup is downloaded for the verification
+func getVerificationRepository(backup *backupApi.ArangoBackup) (backupApi.ArangoBackupSpecOperation, error) {
+	if backup.Status.Backup == nil {
+		return backupApi.ArangoBackupSpecOperation{}, errors.Errorf("Backup details are missing")
+	}
+
+	if backup.Spec.Upload != nil && util.TypeOrDefault(backup.Status.Backup.Uploaded, false) {
+		return *backup.Spec.Upload, nil
+	}
+
+	if backup.Spec.Download != nil {
+		return backup.Spec.Download.ArangoBackupSpecOperation, nil
+	}
+
+	return backupApi.ArangoBackupSpecOperation{}, errors.Errorf("Backup needs to be uploaded to the remote repository before verification")
+}
+
+// newVerificationDeployment returns the temporary deployment used for the backup verification
+func newVerificationDeployment(backup *backupApi.ArangoBackup, deployment *api.ArangoDeployment) *api.ArangoDeployment {
+	name := fmt.Sprintf("backup-verify-%s", fmt.Sprintf("%0x", sha256.Sum256([]byte(backup.GetUID())))[:8])
+
+	spec := api.DeploymentSpec{
+		Environment:      api.NewEnvironment(api.EnvironmentDevelopment),
+		StorageEngine:    deployment.Spec.StorageEngine,
+		Image:            deployment.Spec.Image,
+		ImagePullPolicy:  deployment.Spec.ImagePullPolicy,
+		ImagePullSecrets: deployment.Spec.ImagePullSecrets,
+		ExternalAccess: api.ExternalAccessSpec{
+			Type: api.NewExternalAccessType(api.ExternalAccessTypeNone),
+		},
+		RocksDB: *deployment.Spec.RocksDB.DeepCopy(),
+		License: *deployment.Spec.License.DeepCopy(),
+	}
+
+	if deployment.Spec.GetMode() == api.DeploymentModeCluster {
+		dbservers := 1
+		if b := backup.Status.Backup; b != nil && b.NumberOfDBServers > 1 {
+			dbservers = int(b.NumberOfDBServers)
+		}
+
+		spec.Mode = api.NewMode(api.DeploymentModeCluster)
+		spec.Agents = *deployment.Spec.Agents.DeepCopy()
+		spec.DBServers = *deployment.Spec.DBServers.DeepCopy()
+		spec.DBServers.Count = util.NewType[int](dbservers)
+		spec.Coordinators = *deployment.Spec.Coordinators.DeepCopy()
+		spec.Coordinators.Count = util.NewType[int](1)
+	} else {
+		spec.Mode = api.NewMode(api.DeploymentModeSingle)
+		spec.Single = *deployment.Spec.Single.DeepCopy()
+		spec.Single.Count = util.NewType[int](1)
+	}
+
+	return &api.ArangoDeployment{
+		ObjectMeta: meta.ObjectMeta{
+			Name:            name,
+			Namespace:       backup.Namespace,
+			Labels:          map[string]string{backupApi.LabelArangoBackupVerification: backup.GetName()},
+			OwnerReferences: []meta.OwnerReference{backup.AsOwner()},
+		},
+		Spec: spec,
+	}
+}
@@ -0,0 +1,245 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package backup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kTesting "k8s.io/client-go/testing"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	fakeClientSet "github.com/arangodb/kube-arangodb/pkg/generated/clientset/versioned/fake"
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/operation"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/tests"
+)
+
+func newVerificationObjectSet(t *testing.T, h *handler, mock *mockArangoClientBackup, queries ...backupApi.ArangoBackupSpecVerificationQuery) *backupApi.ArangoBackup {
+	obj, deployment := newObjectSet(t, backupApi.ArangoBackupStateReady)
+
+	createResponse, err := mock.Create()
+	require.NoError(t, err)
+
+	backupMeta, err := mock.Get(createResponse.ID)
+	require.NoError(t, err)
+
+	obj.Spec.Upload = &backupApi.ArangoBackupSpecOperation{
+		RepositoryURL: "s3://test",
+	}
+	obj.Spec.Verification = &backupApi.ArangoBackupSpecVerification{
+		Enabled: util.NewType[bool](true),
+		Queries: queries,
+	}
+	obj.Status.Backup = createBackupFromMeta(backupMeta, nil)
+	obj.Status.Backup.Uploaded = util.NewType[bool](true)
+
+	createArangoDeployment(t, h, deployment)
+	createArangoBackup(t, h, obj)
+
+	return obj
+}
+
+func handleVerification(t *testing.T, h *handler, obj *backupApi.ArangoBackup, phase backupApi.ArangoBackupVerificationPhase) *backupApi.ArangoBackup {
+	require.NoError(t, h.Handle(context.Background(), tests.NewItem(t, operation.Update, obj)))
+
+	newObj := refreshArangoBackup(t, h, obj)
+	checkBackup(t, newObj, backupApi.ArangoBackupStateReady, true)
+	require.NotNil(t, newObj.Status.Verification)
+	require.Equal(t, phase, newObj.Status.Verification.Phase)
+
+	return newObj
+}
+
+func updateVerificationDeployment(t *testing.T, h *handler, namespace, name string, mod func(in *api.ArangoDeployment)) {
+	depl, err := h.client.DatabaseV1().ArangoDeployments(namespace).Get(context.Background(), name, meta.GetOptions{})
+	require.NoError(t, err)
+
+	mod(depl)
+
+	_, err = h.client.DatabaseV1().ArangoDeployments(namespace).Update(context.Background(), depl, meta.UpdateOptions{})
+	require.NoError(t, err)
+}
+
+func Test_Verification_Success(t *testing.T) {
+	// Arrange
+	handler, mock := newErrorsFakeHandler(mockErrorsArangoClientBackup{})
+	mock.state.queries["FOR d IN docs RETURN d"] = 5
+
+	obj := newVerificationObjectSet(t, handler, mock, backupApi.ArangoBackupSpecVerificationQuery{
+		Name:       "docs",
+		Query:      "FOR d IN docs RETURN d",
+		MinResults: util.NewType[int](3),
+	})
+
+	var verification *backupApi.ArangoBackupStatusVerification
+
+	t.Run("Deploy", func(t *testing.T) {
+		newObj := handleVerification(t, handler, obj, backupApi.ArangoBackupVerificationPhaseDeploying)
+		verification = newObj.Status.Verification
+
+		require.False(t, newObj.Status.Conditions.IsTrue(backupApi.ConditionTypeVerified))
+
+		depl, err := handler.client.DatabaseV1().ArangoDeployments(obj.Namespace).Get(context.Background(), verification.Deployment, meta.GetOptions{})
+		require.NoError(t, err)
+		require.Equal(t, obj.GetName(), depl.GetLabels()[backupApi.LabelArangoBackupVerification])
+		require.Equal(t, api.DeploymentModeSingle, depl.Spec.GetMode())
+	})
+
+	t.Run("Wait for deployment", func(t *testing.T) {
+		handleVerification(t, handler, obj, backupApi.ArangoBackupVerificationPhaseDeploying)
+	})
+
+	t.Run("Download", func(t *testing.T) {
+		updateVerificationDeployment(t, handler, obj.Namespace, verification.Deployment, func(in *api.ArangoDeployment) {
+			in.Status.Conditions.Update(api.ConditionTypeReady, true, "", "")
+		})
+
+		newObj := handleVerification(t, handler, obj, backupApi.ArangoBackupVerificationPhaseDownloading)
+		verification = newObj.Status.Verification
+
+		download, err := handler.client.BackupV1().ArangoBackups(obj.Namespace).Get(context.Background(), verification.Download, meta.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, download.Spec.Download)
+		require.Equal(t, obj.Status.Backup.ID, download.Spec.Download.ID)
+		require.Equal(t, "s3://test", download.Spec.Download.RepositoryURL)
+		require.Equal(t, verification.Deployment, download.Spec.Deployment.Name)
+
+		download.Status.State = backupApi.ArangoBackupStateReady
+		download.Status.Backup = obj.Status.Backup.DeepCopy()
+		_, err = handler.client.BackupV1().ArangoBackups(obj.Namespace).Update(context.Background(), download, meta.UpdateOptions{})
+		require.NoError(t, err)
+	})
+
+	t.Run("Restore", func(t *testing.T) {
+		handleVerification(t, handler, obj, backupApi.ArangoBackupVerificationPhaseRestoring)
+
+		depl, err := handler.client.DatabaseV1().ArangoDeployments(obj.Namespace).Get(context.Background(), verification.Deployment, meta.GetOptions{})
+		require.NoError(t, err)
+		require.Equal(t, verification.Download, depl.Spec.GetRestoreFrom())
+
+		updateVerificationDeployment(t, handler, obj.Namespace, verification.Deployment, func(in *api.ArangoDeployment) {
+			in.Status.Restore = &api.DeploymentRestoreResult{
+				RequestedFrom: verification.Download,
+				State:         api.DeploymentRestoreStateRestored,
+			}
+		})
+	})
+
+	t.Run("Query", func(t *testing.T) {
+		handleVerification(t, handler, obj, backupApi.ArangoBackupVerificationPhaseQuerying)
+
+		newObj := handleVerification(t, handler, obj, backupApi.ArangoBackupVerificationPhaseSucceeded)
+
+		require.True(t, newObj.Status.Conditions.IsTrue(backupApi.ConditionTypeVerified))
+		require.NotNil(t, newObj.Status.Verification.FinishTime)
+		require.Len(t, newObj.Status.Verification.Queries, 1)
+		require.True(t, newObj.Status.Verification.Queries[0].Succeeded)
+		require.Equal(t, 5, newObj.Status.Verification.Queries[0].Results)
+
+		_, err := handler.client.DatabaseV1().ArangoDeployments(obj.Namespace).Get(context.Background(), verification.Deployment, meta.GetOptions{})
+		require.True(t, apiErrors.IsNotFound(err))
+
+		_, err = handler.client.BackupV1().ArangoBackups(obj.Namespace).Get(context.Background(), verification.Download, meta.GetOptions{})
+		require.True(t, apiErrors.IsNotFound(err))
+	})
+
+	t.Run("Finished", func(t *testing.T) {
+		handleVerification(t, handler, obj, backupApi.ArangoBackupVerificationPhaseSucceeded)
+	})
+}
+
+func Test_Verification_QueryFailed(t *testing.T) {
+	// Arrange
+	handler, mock := newErrorsFakeHandler(mockErrorsArangoClientBackup{})
+
+	obj := newVerificationObjectSet(t, handler, mock, backupApi.ArangoBackupSpecVerificationQuery{
+		Name:       "docs",
+		Query:      "FOR d IN docs RETURN d",
+		MinResults: util.NewType[int](1),
+	})
+
+	newObj := handleVerification(t, handler, obj, backupApi.ArangoBackupVerificationPhaseDeploying)
+	verification := newObj.Status.Verification
+
+	newObj.Status.Verification.Download = "missing"
+	newObj.Status.Verification.Phase = backupApi.ArangoBackupVerificationPhaseQuerying
+	_, err := handler.client.BackupV1().ArangoBackups(obj.Namespace).UpdateStatus(context.Background(), newObj, meta.UpdateOptions{})
+	require.NoError(t, err)
+
+	updateVerificationDeployment(t, handler, obj.Namespace, verification.Deployment, func(in *api.ArangoDeployment) {
+		in.Status.Conditions.Update(api.ConditionTypeReady, true, "", "")
+	})
+
+	// Act
+	newObj = handleVerification(t, handler, obj, backupApi.ArangoBackupVerificationPhaseFailed)
+
+	// Assert
+	require.False(t, newObj.Status.Conditions.IsTrue(backupApi.ConditionTypeVerified))
+	require.Equal(t, "Queries failed: docs", newObj.Status.Verification.Message)
+	require.Len(t, newObj.Status.Verification.Queries, 1)
+	require.False(t, newObj.Status.Verification.Queries[0].Succeeded)
+}
+
+func Test_Verification_UploadRequired(t *testing.T) {
+	// Arrange
+	handler, mock := newErrorsFakeHandler(mockErrorsArangoClientBackup{})
+
+	obj := newVerificationObjectSet(t, handler, mock)
+	obj.Spec.Upload = nil
+	obj.Status.Backup.Uploaded = nil
+	_, err := handler.client.BackupV1().ArangoBackups(obj.Namespace).Update(context.Background(), obj, meta.UpdateOptions{})
+	require.NoError(t, err)
+
+	// Act
+	newObj := handleVerification(t, handler, obj, backupApi.ArangoBackupVerificationPhaseFailed)
+
+	// Assert
+	require.Empty(t, newObj.Status.Verification.Deployment)
+	require.Contains(t, newObj.Status.Verification.Message, "uploaded")
+}
+
+func Test_Verification_Forbidden(t *testing.T) {
+	// Arrange
+	handler, mock := newErrorsFakeHandler(mockErrorsArangoClientBackup{})
+
+	obj := newVerificationObjectSet(t, handler, mock)
+
+	handler.client.(*fakeClientSet.Clientset).PrependReactor("create", deployment.ArangoDeploymentResourcePlural, func(action kTesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apiErrors.NewForbidden(api.SchemeGroupVersion.WithResource(deployment.ArangoDeploymentResourcePlural).GroupResource(), "", errors.Errorf("forbidden"))
+	})
+
+	// Act
+	newObj := handleVerification(t, handler, obj, backupApi.ArangoBackupVerificationPhaseFailed)
+
+	// Assert
+	require.False(t, newObj.Status.Conditions.IsTrue(backupApi.ConditionTypeVerified))
+	require.Empty(t, newObj.Status.Verification.Deployment)
+	require.NotNil(t, newObj.Status.Verification.FinishTime)
+	require.Contains(t, newObj.Status.Verification.Message, "rbac.extensions.backupVerification")
+}
@@ -0,0 +1,93 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package spec
+
+import (
+	"context"
+	goStrings "strings"
+
+	admission "k8s.io/api/admission/v1"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	"github.com/arangodb/kube-arangodb/pkg/logging"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/webhook"
+)
+
+// Handler returns the ArangoBackup spec webhook handler.
+// Validation rejects invalid specs and changes of immutable fields.
+func Handler() webhook.Handler[*backupApi.ArangoBackup] {
+	return handler{}
+}
+
+var _ webhook.ValidationHandler[*backupApi.ArangoBackup] = handler{}
+
+type handler struct {
+}
+
+func (h handler) CanHandle(ctx context.Context, log logging.Logger, t webhook.AdmissionRequestType, request *admission.AdmissionRequest, old, new *backupApi.ArangoBackup) bool {
+	if request == nil {
+		return false
+	}
+
+	if request.Operation != admission.Create && request.Operation != admission.Update {
+		return false
+	}
+
+	if new == nil {
+		return false
+	}
+
+	if new.GetDeletionTimestamp() != nil {
+		// Do not block finalizers removal
+		return false
+	}
+
+	return true
+}
+
+func (h handler) Validate(ctx context.Context, log logging.Logger, t webhook.AdmissionRequestType, request *admission.AdmissionRequest, old, new *backupApi.ArangoBackup) (webhook.ValidationResponse, error) {
+	if !h.CanHandle(ctx, log, t, request, old, new) {
+		return webhook.ValidationResponse{}, errors.Errorf("Object cannot be handled")
+	}
+
+	if old != nil {
+		if equal, err := util.CompareJSON(old.Spec, new.Spec); err != nil {
+			return webhook.ValidationResponse{}, err
+		} else if equal {
+			// Metadata or finalizers update, spec is not evaluated
+			return webhook.ValidationResponse{Allowed: true}, nil
+		}
+
+		if fields := old.Spec.ResetImmutableFields(new.Spec.DeepCopy()); len(fields) > 0 {
+			return webhook.NewValidationResponse(false, "Immutable fields cannot be changed: %s", goStrings.Join(util.FormatList(fields, func(a string) string {
+				return "spec." + a
+			}), ", ")), nil
+		}
+	}
+
+	if err := new.Spec.Validate(); err != nil {
+		return webhook.NewValidationResponse(false, "Invalid spec: %s", err.Error()), nil
+	}
+
+	return webhook.ValidationResponse{Allowed: true}, nil
+}
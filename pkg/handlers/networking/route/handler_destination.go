@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2024-2025 ArangoDB GmbH, Cologne, Germany
+// Copyright 2024-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -30,6 +30,52 @@ import (
 )
 
 func (h *handler) HandleArangoDestination(ctx context.Context, item operation.Item, extension *networkingApi.ArangoRoute, status *networkingApi.ArangoRouteStatus, deployment *api.ArangoDeployment) (*operator.Condition, bool, error) {
+	dest := extension.Spec.GetDestination()
+
+	if len(dest.GetBackends()) == 0 {
+		return h.handleArangoDestination(ctx, item, extension, status, deployment)
+	}
+
+	// Render the main destination first, backends are attached to its target
+	var main networkingApi.ArangoRouteStatus
+
+	c, _, err := h.handleArangoDestination(ctx, item, extension, &main, deployment)
+	if err != nil || c == nil || !c.Status || main.Target == nil {
+		return c, false, err
+	}
+
+	target := main.Target
+
+	target.Weight = dest.GetWeight()
+
+	for _, backend := range dest.GetBackends() {
+		b, err := h.HandleArangoDestinationBackend(ctx, item, extension, deployment, dest, backend)
+		if err != nil {
+			return nil, false, err
+		}
+
+		target.Backends = append(target.Backends, b)
+	}
+
+	if status.Target.Hash() == target.Hash() {
+		return &operator.Condition{
+			Status:  true,
+			Reason:  "Destination Found",
+			Message: "Destination Found",
+			Hash:    target.Hash(),
+		}, false, nil
+	}
+
+	status.Target = target
+	return &operator.Condition{
+		Status:  true,
+		Reason:  "Destination Found",
+		Message: "Destination Found",
+		Hash:    target.Hash(),
+	}, true, nil
+}
+
+func (h *handler) handleArangoDestination(ctx context.Context, item operation.Item, extension *networkingApi.ArangoRoute, status *networkingApi.ArangoRouteStatus, deployment *api.ArangoDeployment) (*operator.Condition, bool, error) {
 	if dest := extension.Spec.GetDestination(); dest != nil {
 		if svc := dest.GetService(); svc != nil {
 			return h.HandleArangoDestinationService(ctx, item, extension, status, deployment, dest, svc)
@@ -0,0 +1,70 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package route
+
+import (
+	"context"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	networkingApi "github.com/arangodb/kube-arangodb/pkg/apis/networking/v1beta1"
+	operator "github.com/arangodb/kube-arangodb/pkg/operatorV2"
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/operation"
+)
+
+// HandleArangoDestinationBackend discovers the backend destinations. Backend which is not ready is reported in the status,
+// but does not affect the route readiness
+func (h *handler) HandleArangoDestinationBackend(ctx context.Context, item operation.Item, extension *networkingApi.ArangoRoute, deployment *api.ArangoDeployment, dest *networkingApi.ArangoRouteSpecDestination, backend networkingApi.ArangoRouteSpecDestinationBackend) (networkingApi.ArangoRouteStatusTargetBackend, error) {
+	var r = networkingApi.ArangoRouteStatusTargetBackend{
+		Name:   backend.Name,
+		Weight: backend.GetWeight(),
+		Match:  backend.Match.AsStatus(),
+	}
+
+	// Backend shares the connection settings with the main destination
+	var status networkingApi.ArangoRouteStatus
+	var c *operator.Condition
+	var err error
+
+	if svc := backend.GetService(); svc != nil {
+		c, _, err = h.HandleArangoDestinationService(ctx, item, extension, &status, deployment, dest, svc)
+	} else if endpoints := backend.GetEndpoints(); endpoints != nil {
+		c, _, err = h.HandleArangoDestinationEndpoints(ctx, item, extension, &status, deployment, dest, endpoints)
+	} else {
+		r.Message = "Destination Not Found"
+		return r, nil
+	}
+
+	if err != nil {
+		return networkingApi.ArangoRouteStatusTargetBackend{}, err
+	}
+
+	if c == nil || !c.Status || status.Target == nil {
+		if c != nil {
+			r.Message = c.Message
+		}
+		return r, nil
+	}
+
+	r.Ready = true
+	r.Destinations = status.Target.Destinations
+
+	return r, nil
+}
@@ -0,0 +1,190 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package route
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	networkingApi "github.com/arangodb/kube-arangodb/pkg/apis/networking/v1beta1"
+	sharedApi "github.com/arangodb/kube-arangodb/pkg/apis/shared/v1"
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/operation"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/tests"
+)
+
+func Test_Handler_Destination_Backends(t *testing.T) {
+	// Setup
+	handler := newFakeHandler()
+
+	// Arrange
+	extension := tests.NewMetaObject[*networkingApi.ArangoRoute](t, tests.FakeNamespace, "test",
+		func(t *testing.T, obj *networkingApi.ArangoRoute) {
+			obj.Spec.Deployment = util.NewType("deployment")
+		},
+		func(t *testing.T, obj *networkingApi.ArangoRoute) {
+			obj.Spec.Destination = &networkingApi.ArangoRouteSpecDestination{
+				Service: &networkingApi.ArangoRouteSpecDestinationService{
+					Object: &sharedApi.Object{
+						Name: "stable",
+					},
+					Port: util.NewType(intstr.FromInt32(10244)),
+				},
+				Weight: util.NewType[uint32](90),
+				Backends: networkingApi.ArangoRouteSpecDestinationBackends{
+					{
+						Name: "canary",
+						Service: &networkingApi.ArangoRouteSpecDestinationService{
+							Object: &sharedApi.Object{
+								Name: "canary",
+							},
+							Port: util.NewType(intstr.FromInt32(10244)),
+						},
+						Weight: util.NewType[uint32](10),
+						Match: &networkingApi.ArangoRouteSpecDestinationBackendMatch{
+							Headers: networkingApi.ArangoRouteSpecDestinationBackendMatchRules{
+								{
+									Name:  "x-canary",
+									Value: util.NewType("true"),
+								},
+							},
+						},
+					},
+					{
+						Name: "missing",
+						Service: &networkingApi.ArangoRouteSpecDestinationService{
+							Object: &sharedApi.Object{
+								Name: "missing",
+							},
+							Port: util.NewType(intstr.FromInt32(10244)),
+						},
+						Weight: util.NewType[uint32](10),
+					},
+				},
+			}
+		})
+	deployment := tests.NewMetaObject[*api.ArangoDeployment](t, tests.FakeNamespace, "deployment")
+	stable := tests.NewMetaObject[*core.Service](t, tests.FakeNamespace, "stable", func(t *testing.T, obj *core.Service) {
+		obj.Spec.Ports = []core.ServicePort{
+			{
+				Port: 10244,
+			},
+		}
+	})
+	canary := tests.NewMetaObject[*core.Service](t, tests.FakeNamespace, "canary", func(t *testing.T, obj *core.Service) {
+		obj.Spec.Ports = []core.ServicePort{
+			{
+				Port: 10244,
+			},
+		}
+	})
+
+	refresh := tests.CreateObjects(t, handler.kubeClient, handler.client, &deployment, &extension, &stable, &canary)
+
+	// Test
+	require.NoError(t, tests.Handle(handler, tests.NewItem(t, operation.Update, extension)))
+
+	// Refresh
+	refresh(t)
+
+	// Assert
+	require.True(t, extension.Status.Conditions.IsTrue(networkingApi.SpecValidCondition))
+	require.True(t, extension.Status.Conditions.IsTrue(networkingApi.DestinationValidCondition))
+	require.True(t, extension.Status.Conditions.IsTrue(networkingApi.ReadyCondition))
+
+	c, ok := extension.Status.Conditions.Get(networkingApi.DestinationValidCondition)
+	require.True(t, ok)
+	require.EqualValues(t, c.Hash, extension.Status.Target.Hash())
+
+	target := extension.Status.Target
+	require.NotNil(t, target)
+	require.Len(t, target.RenderURLs(), 1)
+	require.EqualValues(t, "http://stable.fake.svc:10244/", target.RenderURLs()[0])
+	require.EqualValues(t, 90, target.Weight)
+
+	require.Len(t, target.Backends, 2)
+
+	require.Equal(t, "canary", target.Backends[0].Name)
+	require.True(t, target.Backends[0].Ready)
+	require.EqualValues(t, 10, target.Backends[0].Weight)
+	require.Len(t, target.Backends[0].Destinations, 1)
+	require.Equal(t, "canary.fake.svc", target.Backends[0].Destinations[0].Host)
+	require.EqualValues(t, 10244, target.Backends[0].Destinations[0].Port)
+	require.NotNil(t, target.Backends[0].Match)
+	require.Len(t, target.Backends[0].Match.Headers, 1)
+	require.Equal(t, "x-canary", target.Backends[0].Match.Headers[0].Name)
+
+	require.Equal(t, "missing", target.Backends[1].Name)
+	require.False(t, target.Backends[1].Ready)
+	require.Empty(t, target.Backends[1].Destinations)
+	require.Equal(t, "Service `fake/missing` Not found", target.Backends[1].Message)
+}
+
+func Test_Handler_Destination_Backends_Invalid(t *testing.T) {
+	// Setup
+	handler := newFakeHandler()
+
+	// Arrange
+	extension := tests.NewMetaObject[*networkingApi.ArangoRoute](t, tests.FakeNamespace, "test",
+		func(t *testing.T, obj *networkingApi.ArangoRoute) {
+			obj.Spec.Deployment = util.NewType("deployment")
+		},
+		func(t *testing.T, obj *networkingApi.ArangoRoute) {
+			obj.Spec.Destination = &networkingApi.ArangoRouteSpecDestination{
+				Service: &networkingApi.ArangoRouteSpecDestinationService{
+					Object: &sharedApi.Object{
+						Name: "stable",
+					},
+					Port: util.NewType(intstr.FromInt32(10244)),
+				},
+				Backends: networkingApi.ArangoRouteSpecDestinationBackends{
+					{
+						Name: "canary",
+						Service: &networkingApi.ArangoRouteSpecDestinationService{
+							Object: &sharedApi.Object{
+								Name: "canary",
+							},
+							Port: util.NewType(intstr.FromInt32(10244)),
+						},
+					},
+				},
+			}
+		})
+	deployment := tests.NewMetaObject[*api.ArangoDeployment](t, tests.FakeNamespace, "deployment")
+
+	refresh := tests.CreateObjects(t, handler.kubeClient, handler.client, &deployment, &extension)
+
+	// Test
+	require.NoError(t, tests.Handle(handler, tests.NewItem(t, operation.Update, extension)))
+
+	// Refresh
+	refresh(t)
+
+	// Assert
+	require.False(t, extension.Status.Conditions.IsTrue(networkingApi.SpecValidCondition))
+	c, ok := extension.Status.Conditions.Get(networkingApi.SpecValidCondition)
+	require.True(t, ok)
+	require.EqualValues(t, "Received 1 errors: spec.destination.backends: Received 1 errors: [0]: Received 1 errors: weight: Weight or Match is required", c.Message)
+}
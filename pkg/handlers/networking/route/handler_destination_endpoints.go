@@ -130,6 +130,7 @@ func (h *handler) HandleArangoDestinationEndpoints(ctx context.Context, item ope
 	target.Protocol = dest.GetProtocol().Get()
 
 	target.Options = extension.Spec.Options.AsStatus()
+	target.Limits = dest.GetLimits().AsStatus()
 
 	// Render Auth Settings
 
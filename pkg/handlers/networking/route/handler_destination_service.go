@@ -127,6 +127,7 @@ func (h *handler) HandleArangoDestinationService(ctx context.Context, item opera
 	target.Protocol = dest.GetProtocol().Get()
 
 	target.Options = extension.Spec.Options.AsStatus()
+	target.Limits = dest.GetLimits().AsStatus()
 
 	// Render Auth Settings
 
@@ -1133,3 +1133,105 @@ func Test_Handler_Destination_Service_Insecure_HTTP_Override(t *testing.T) {
 
 	require.False(t, extension.Status.Target.TLS.IsInsecure())
 }
+
+func Test_Handler_Destination_Service_Limits(t *testing.T) {
+	// Setup
+	handler := newFakeHandler()
+
+	// Arrange
+	extension := tests.NewMetaObject[*networkingApi.ArangoRoute](t, tests.FakeNamespace, "test",
+		func(t *testing.T, obj *networkingApi.ArangoRoute) {
+			obj.Spec.Deployment = util.NewType("deployment")
+		},
+		func(t *testing.T, obj *networkingApi.ArangoRoute) {
+			obj.Spec.Destination = &networkingApi.ArangoRouteSpecDestination{
+				Service: &networkingApi.ArangoRouteSpecDestinationService{
+					Object: &sharedApi.Object{
+						Name: "deployment",
+					},
+					Port: util.NewType(intstr.FromInt32(10244)),
+				},
+				Limits: &networkingApi.ArangoRouteSpecDestinationLimits{
+					RequestsPerSecond: util.NewType[uint32](10),
+					Key:               util.NewType(networkingApi.ArangoRouteSpecDestinationLimitsKeyUser),
+					MaxConnections:    util.NewType[uint32](64),
+				},
+			}
+		})
+	deployment := tests.NewMetaObject[*api.ArangoDeployment](t, tests.FakeNamespace, "deployment")
+	svc := tests.NewMetaObject[*core.Service](t, tests.FakeNamespace, "deployment", func(t *testing.T, obj *core.Service) {
+		obj.Spec.Ports = []core.ServicePort{
+			{
+				Port: 10244,
+			},
+		}
+	})
+
+	refresh := tests.CreateObjects(t, handler.kubeClient, handler.client, &deployment, &extension, &svc)
+
+	// Test
+	require.NoError(t, tests.Handle(handler, tests.NewItem(t, operation.Update, extension)))
+
+	// Refresh
+	refresh(t)
+
+	// Assert
+	require.True(t, extension.Status.Conditions.IsTrue(networkingApi.SpecValidCondition))
+	require.True(t, extension.Status.Conditions.IsTrue(networkingApi.DestinationValidCondition))
+	require.True(t, extension.Status.Conditions.IsTrue(networkingApi.ReadyCondition))
+
+	c, ok := extension.Status.Conditions.Get(networkingApi.DestinationValidCondition)
+	require.True(t, ok)
+	require.EqualValues(t, c.Hash, extension.Status.Target.Hash())
+
+	limits := extension.Status.Target.Limits
+	require.NotNil(t, limits)
+	require.NotNil(t, limits.RateLimit)
+	require.EqualValues(t, 10, limits.RateLimit.RequestsPerSecond)
+	require.EqualValues(t, 10, limits.RateLimit.Burst)
+	require.EqualValues(t, networkingApi.ArangoRouteSpecDestinationLimitsKeyUser, limits.RateLimit.Key)
+	require.NotNil(t, limits.MaxConnections)
+	require.EqualValues(t, 64, *limits.MaxConnections)
+	require.Nil(t, limits.MaxPendingRequests)
+	require.Nil(t, limits.MaxRequests)
+}
+
+func Test_Handler_Destination_Service_Limits_Invalid(t *testing.T) {
+	// Setup
+	handler := newFakeHandler()
+
+	// Arrange
+	extension := tests.NewMetaObject[*networkingApi.ArangoRoute](t, tests.FakeNamespace, "test",
+		func(t *testing.T, obj *networkingApi.ArangoRoute) {
+			obj.Spec.Deployment = util.NewType("deployment")
+		},
+		func(t *testing.T, obj *networkingApi.ArangoRoute) {
+			obj.Spec.Destination = &networkingApi.ArangoRouteSpecDestination{
+				Service: &networkingApi.ArangoRouteSpecDestinationService{
+					Object: &sharedApi.Object{
+						Name: "deployment",
+					},
+					Port: util.NewType(intstr.FromInt32(10244)),
+				},
+				Limits: &networkingApi.ArangoRouteSpecDestinationLimits{
+					RequestsPerSecond: util.NewType[uint32](10),
+					Burst:             util.NewType[uint32](5),
+				},
+			}
+		})
+	deployment := tests.NewMetaObject[*api.ArangoDeployment](t, tests.FakeNamespace, "deployment")
+
+	refresh := tests.CreateObjects(t, handler.kubeClient, handler.client, &deployment, &extension)
+
+	// Test
+	require.NoError(t, tests.Handle(handler, tests.NewItem(t, operation.Update, extension)))
+
+	// Refresh
+	refresh(t)
+
+	// Assert
+	require.False(t, extension.Status.Conditions.IsTrue(networkingApi.SpecValidCondition))
+	c, ok := extension.Status.Conditions.Get(networkingApi.SpecValidCondition)
+	require.True(t, ok)
+	require.EqualValues(t, "Received 1 errors: spec.destination.limits.burst: Burst must not be lower than RequestsPerSecond", c.Message)
+}
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2025 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -120,6 +120,7 @@ func (h *handler) processBackupPolicy(policy *backupApi.ArangoBackupPolicy) back
 			Scheduled: meta.Time{
 				Time: next,
 			},
+			Retention: newRetentionStatus(policy.Spec, next, nil),
 		}
 	}
 
@@ -130,10 +131,16 @@ func (h *handler) processBackupPolicy(policy *backupApi.ArangoBackupPolicy) back
 		next := expr.Next(now)
 
 		if next != policy.Status.Scheduled.Time {
+			var retained []backupApi.ArangoBackupPolicyRetentionBackup
+			if policy.Status.Retention != nil {
+				retained = policy.Status.Retention.Backups
+			}
+
 			return backupApi.ArangoBackupPolicyStatus{
 				Scheduled: meta.Time{
 					Time: next,
 				},
+				Retention: newRetentionStatus(policy.Spec, next, retained),
 			}
 		}
 
@@ -159,8 +166,14 @@ func (h *handler) processBackupPolicy(policy *backupApi.ArangoBackupPolicy) back
 
 	next := expr.Next(time.Now())
 
-	needToListBackups := !policy.Spec.GetAllowConcurrent() || policy.Spec.MaxBackups > 0
+	needToListBackups := !policy.Spec.GetAllowConcurrent() || policy.Spec.MaxBackups > 0 || policy.Spec.Retention != nil
+	var retained []backupApi.ArangoBackupPolicyRetentionBackup
 	for _, deployment := range deployments.Items {
+		if _, ok := deployment.GetLabels()[backupApi.LabelArangoBackupVerification]; ok {
+			// Temporary deployments created for the backup verification are not backed up
+			continue
+		}
+
 		depl := deployment.DeepCopy()
 		ctx := context.Background()
 
@@ -173,7 +186,24 @@ func (h *handler) processBackupPolicy(policy *backupApi.ArangoBackupPolicy) back
 					Message:   fmt.Sprintf("backup creation failed: %s", err.Error()),
 				}
 			}
-			if numRemoved, err := h.removeOldHealthyBackups(ctx, policy.Spec.MaxBackups, backups); err != nil {
+			if policy.Spec.Retention != nil {
+				kept, numRemoved, err := h.applyRetention(ctx, policy.Spec.Retention, backups)
+				if err != nil {
+					h.eventRecorder.Warning(policy, policyError, "Policy Error: %s", err.Error())
+					return backupApi.ArangoBackupPolicyStatus{
+						Scheduled: policy.Status.Scheduled,
+						Message:   fmt.Sprintf("automatic backup retention failed: %s", err.Error()),
+						Retention: policy.Status.Retention,
+					}
+				}
+
+				retained = append(retained, kept...)
+
+				if numRemoved > 0 {
+					eventMsg := fmt.Sprintf("Cleaned up %d old backups due to retention setting %s/%s", numRemoved, deployment.Namespace, deployment.Name)
+					h.eventRecorder.Normal(policy, cleanedUpOldBackups, eventMsg)
+				}
+			} else if numRemoved, err := h.removeOldHealthyBackups(ctx, policy.Spec.MaxBackups, backups); err != nil {
 				h.eventRecorder.Warning(policy, policyError, "Policy Error: %s", err.Error())
 				return backupApi.ArangoBackupPolicyStatus{
 					Scheduled: policy.Status.Scheduled,
@@ -222,6 +252,7 @@ func (h *handler) processBackupPolicy(policy *backupApi.ArangoBackupPolicy) back
 		Scheduled: meta.Time{
 			Time: next,
 		},
+		Retention: newRetentionStatus(policy.Spec, next, retained),
 	}
 }
 
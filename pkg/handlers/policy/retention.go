@@ -0,0 +1,203 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package policy
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/kerrors"
+)
+
+// newRetentionStatus returns the retention status with the next prune time, nil if retention is not enabled
+func newRetentionStatus(spec backupApi.ArangoBackupPolicySpec, next time.Time, backups []backupApi.ArangoBackupPolicyRetentionBackup) *backupApi.ArangoBackupPolicyRetentionStatus {
+	if spec.Retention == nil {
+		return nil
+	}
+
+	return &backupApi.ArangoBackupPolicyRetentionStatus{
+		NextPrune: &meta.Time{
+			Time: next,
+		},
+		Backups: backups,
+	}
+}
+
+// applyRetention deletes the healthy backups which are not kept by any retention tier
+func (h *handler) applyRetention(ctx context.Context, retention *backupApi.ArangoBackupPolicyRetention, backups util.List[*backupApi.ArangoBackup]) ([]backupApi.ArangoBackupPolicyRetentionBackup, int, error) {
+	kept, prune := evaluateRetention(time.Now(), retention, backups)
+
+	numDeleted := 0
+	for _, b := range prune {
+		err := globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+			return h.client.BackupV1().ArangoBackups(b.Namespace).Delete(ctxChild, b.Name, meta.DeleteOptions{})
+		})
+		if err != nil && !kerrors.IsNotFound(err) {
+			return kept, numDeleted, errors.Wrapf(err, "could not trigger deletion of backup %s", b.Name)
+		}
+		numDeleted++
+	}
+
+	return kept, numDeleted, nil
+}
+
+// evaluateRetention returns backups kept by the retention tiers and backups which should be pruned.
+// Only Ready backups are considered. Backups with expired Lifetime are skipped, as they are removed by the backup handler.
+// Backups which are requested to be uploaded, but are not uploaded yet, are never pruned.
+func evaluateRetention(now time.Time, retention *backupApi.ArangoBackupPolicyRetention, backups util.List[*backupApi.ArangoBackup]) ([]backupApi.ArangoBackupPolicyRetentionBackup, []*backupApi.ArangoBackup) {
+	candidates := backups.Filter(func(b *backupApi.ArangoBackup) bool {
+		if b.Status.State != backupApi.ArangoBackupStateReady {
+			return false
+		}
+
+		if b.Spec.Lifetime != nil && retentionBackupTime(b).Add(b.Spec.Lifetime.Duration).Before(now) {
+			return false
+		}
+
+		return true
+	}).Sort(func(a, b *backupApi.ArangoBackup) bool {
+		// newest first
+		if at, bt := retentionBackupTime(a), retentionBackupTime(b); !at.Equal(bt) {
+			return at.After(bt)
+		}
+
+		return a.Name < b.Name
+	})
+
+	tiers := make([][]backupApi.ArangoBackupPolicyRetentionTier, len(candidates))
+
+	for _, tier := range backupApi.ArangoBackupPolicyRetentionTiers() {
+		count := retention.GetTier(tier)
+		if count <= 0 {
+			continue
+		}
+
+		if tier == backupApi.ArangoBackupPolicyRetentionTierLatest {
+			for id := range candidates {
+				if id >= count {
+					break
+				}
+
+				tiers[id] = append(tiers[id], tier)
+			}
+
+			continue
+		}
+
+		current := retentionPeriod(tier, now)
+		periods := map[int64]bool{}
+
+		for id, b := range candidates {
+			period := retentionPeriod(tier, retentionBackupTime(b))
+
+			if current-period >= int64(count) {
+				// Backups are sorted, all remaining backups are older
+				break
+			}
+
+			if periods[period] {
+				// Newest backup of the period is already kept
+				continue
+			}
+
+			periods[period] = true
+			tiers[id] = append(tiers[id], tier)
+		}
+	}
+
+	var kept []backupApi.ArangoBackupPolicyRetentionBackup
+	var prune []*backupApi.ArangoBackup
+
+	for id, b := range candidates {
+		uploaded := b.Status.Backup != nil && util.TypeOrDefault(b.Status.Backup.Uploaded, false)
+
+		if b.Spec.Upload != nil && !uploaded {
+			tiers[id] = append(tiers[id], backupApi.ArangoBackupPolicyRetentionTierUploadPending)
+		}
+
+		if len(tiers[id]) == 0 {
+			prune = append(prune, b)
+			continue
+		}
+
+		kept = append(kept, backupApi.ArangoBackupPolicyRetentionBackup{
+			Name:       b.Name,
+			Deployment: b.Spec.Deployment.Name,
+			Uploaded:   uploaded,
+			Tiers:      tiers[id],
+		})
+	}
+
+	sort.Slice(kept, func(i, j int) bool {
+		if kept[i].Deployment != kept[j].Deployment {
+			return kept[i].Deployment < kept[j].Deployment
+		}
+
+		return kept[i].Name < kept[j].Name
+	})
+
+	return kept, prune
+}
+
+// retentionBackupTime returns the ArangoDB backup creation time, or Custom Resource creation time if backup details are missing
+func retentionBackupTime(b *backupApi.ArangoBackup) time.Time {
+	if b.Status.Backup != nil && !b.Status.Backup.CreationTimestamp.IsZero() {
+		return b.Status.Backup.CreationTimestamp.UTC()
+	}
+
+	return b.CreationTimestamp.UTC()
+}
+
+// retentionPeriod returns the sequential number of the tier period in UTC
+func retentionPeriod(tier backupApi.ArangoBackupPolicyRetentionTier, t time.Time) int64 {
+	t = t.UTC()
+
+	switch tier {
+	case backupApi.ArangoBackupPolicyRetentionTierHourly:
+		return floorDiv(t.Unix(), int64(time.Hour/time.Second))
+	case backupApi.ArangoBackupPolicyRetentionTierDaily:
+		return floorDiv(t.Unix(), int64(24*time.Hour/time.Second))
+	case backupApi.ArangoBackupPolicyRetentionTierWeekly:
+		// 1970-01-01 is Thursday, shift to start weeks on Monday
+		return floorDiv(floorDiv(t.Unix(), int64(24*time.Hour/time.Second))+3, 7)
+	case backupApi.ArangoBackupPolicyRetentionTierMonthly:
+		return int64(t.Year())*12 + int64(t.Month()) - 1
+	case backupApi.ArangoBackupPolicyRetentionTierYearly:
+		return int64(t.Year())
+	default:
+		return 0
+	}
+}
+
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
@@ -0,0 +1,213 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/operation"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func newRetentionTestBackup(name string, created time.Time) *backupApi.ArangoBackup {
+	return &backupApi.ArangoBackup{
+		ObjectMeta: meta.ObjectMeta{
+			Name:      name,
+			Namespace: "test",
+		},
+		Spec: backupApi.ArangoBackupSpec{
+			Deployment: backupApi.ArangoBackupSpecDeployment{
+				Name: "deployment",
+			},
+		},
+		Status: backupApi.ArangoBackupStatus{
+			ArangoBackupState: backupApi.ArangoBackupState{
+				State: backupApi.ArangoBackupStateReady,
+			},
+			Backup: &backupApi.ArangoBackupDetails{
+				ID:                name,
+				CreationTimestamp: meta.Time{Time: created},
+			},
+		},
+	}
+}
+
+func retentionKeptNames(kept []backupApi.ArangoBackupPolicyRetentionBackup) map[string][]backupApi.ArangoBackupPolicyRetentionTier {
+	r := map[string][]backupApi.ArangoBackupPolicyRetentionTier{}
+	for _, k := range kept {
+		r[k.Name] = k.Tiers
+	}
+	return r
+}
+
+func Test_Retention_Evaluate(t *testing.T) {
+	now := time.Date(2026, time.March, 11, 12, 30, 0, 0, time.UTC)
+
+	t.Run("Hourly and daily", func(t *testing.T) {
+		var backups util.List[*backupApi.ArangoBackup]
+		// Backup every 30 minutes for the last 3 days
+		for i := 0; i < 144; i++ {
+			backups = append(backups, newRetentionTestBackup(fmt.Sprintf("b-%03d", i), now.Add(-time.Duration(i)*30*time.Minute)))
+		}
+
+		kept, prune := evaluateRetention(now, &backupApi.ArangoBackupPolicyRetention{
+			Hourly: util.NewType(3),
+			Daily:  util.NewType(2),
+		}, backups)
+
+		names := retentionKeptNames(kept)
+		require.Len(t, names, 4)
+		require.Len(t, prune, 140)
+
+		// Newest backup is kept by both tiers
+		require.Equal(t, []backupApi.ArangoBackupPolicyRetentionTier{backupApi.ArangoBackupPolicyRetentionTierHourly, backupApi.ArangoBackupPolicyRetentionTierDaily}, names["b-000"])
+		// 11:30 and 10:30
+		require.Equal(t, []backupApi.ArangoBackupPolicyRetentionTier{backupApi.ArangoBackupPolicyRetentionTierHourly}, names["b-002"])
+		require.Equal(t, []backupApi.ArangoBackupPolicyRetentionTier{backupApi.ArangoBackupPolicyRetentionTierHourly}, names["b-004"])
+		// 2026-03-10 23:30
+		require.Equal(t, []backupApi.ArangoBackupPolicyRetentionTier{backupApi.ArangoBackupPolicyRetentionTierDaily}, names["b-026"])
+	})
+
+	t.Run("Weekly, monthly and yearly", func(t *testing.T) {
+		backups := util.List[*backupApi.ArangoBackup]{
+			newRetentionTestBackup("wed", time.Date(2026, time.March, 11, 1, 0, 0, 0, time.UTC)),
+			newRetentionTestBackup("mon", time.Date(2026, time.March, 9, 1, 0, 0, 0, time.UTC)),
+			newRetentionTestBackup("sun", time.Date(2026, time.March, 8, 1, 0, 0, 0, time.UTC)),
+			newRetentionTestBackup("feb", time.Date(2026, time.February, 2, 1, 0, 0, 0, time.UTC)),
+			newRetentionTestBackup("last-year", time.Date(2025, time.June, 1, 1, 0, 0, 0, time.UTC)),
+			newRetentionTestBackup("old", time.Date(2023, time.June, 1, 1, 0, 0, 0, time.UTC)),
+		}
+
+		kept, prune := evaluateRetention(now, &backupApi.ArangoBackupPolicyRetention{
+			Weekly:  util.NewType(2),
+			Monthly: util.NewType(2),
+			Yearly:  util.NewType(2),
+		}, backups)
+
+		names := retentionKeptNames(kept)
+		require.Equal(t, []backupApi.ArangoBackupPolicyRetentionTier{
+			backupApi.ArangoBackupPolicyRetentionTierWeekly,
+			backupApi.ArangoBackupPolicyRetentionTierMonthly,
+			backupApi.ArangoBackupPolicyRetentionTierYearly,
+		}, names["wed"])
+		require.Equal(t, []backupApi.ArangoBackupPolicyRetentionTier{backupApi.ArangoBackupPolicyRetentionTierWeekly}, names["sun"])
+		require.Equal(t, []backupApi.ArangoBackupPolicyRetentionTier{backupApi.ArangoBackupPolicyRetentionTierMonthly}, names["feb"])
+		require.Equal(t, []backupApi.ArangoBackupPolicyRetentionTier{backupApi.ArangoBackupPolicyRetentionTierYearly}, names["last-year"])
+
+		require.Len(t, prune, 2)
+		require.Equal(t, "mon", prune[0].Name)
+		require.Equal(t, "old", prune[1].Name)
+	})
+
+	t.Run("Upload and lifetime", func(t *testing.T) {
+		pending := newRetentionTestBackup("pending", now.Add(-3*time.Hour))
+		pending.Spec.Upload = &backupApi.ArangoBackupSpecOperation{RepositoryURL: "s3://test"}
+
+		uploaded := newRetentionTestBackup("uploaded", now.Add(-4*time.Hour))
+		uploaded.Spec.Upload = &backupApi.ArangoBackupSpecOperation{RepositoryURL: "s3://test"}
+		uploaded.Status.Backup.Uploaded = util.NewType(true)
+
+		expired := newRetentionTestBackup("expired", now.Add(-5*time.Hour))
+		expired.Spec.Lifetime = &meta.Duration{Duration: time.Hour}
+
+		failed := newRetentionTestBackup("failed", now.Add(-6*time.Hour))
+		failed.Status.State = backupApi.ArangoBackupStateFailed
+
+		kept, prune := evaluateRetention(now, &backupApi.ArangoBackupPolicyRetention{
+			Latest: util.NewType(1),
+		}, util.List[*backupApi.ArangoBackup]{
+			newRetentionTestBackup("latest", now.Add(-time.Hour)),
+			pending,
+			uploaded,
+			expired,
+			failed,
+		})
+
+		names := retentionKeptNames(kept)
+		require.Len(t, names, 2)
+		require.Equal(t, []backupApi.ArangoBackupPolicyRetentionTier{backupApi.ArangoBackupPolicyRetentionTierLatest}, names["latest"])
+		require.Equal(t, []backupApi.ArangoBackupPolicyRetentionTier{backupApi.ArangoBackupPolicyRetentionTierUploadPending}, names["pending"])
+
+		require.Len(t, prune, 1)
+		require.Equal(t, "uploaded", prune[0].Name)
+	})
+}
+
+func Test_Retention_Handler(t *testing.T) {
+	handler := newFakeHandler()
+
+	name := string(uuid.NewUUID())
+	namespace := string(uuid.NewUUID())
+
+	spec := newSimpleArangoBackupPolicySpec("* * * */2 *")
+	spec.Retention = &backupApi.ArangoBackupPolicyRetention{
+		Latest: util.NewType(2),
+	}
+	policy := newArangoBackupPolicy(namespace, name, spec)
+	policy.Status.Scheduled = meta.Time{
+		Time: time.Now().Add(-1 * time.Hour),
+	}
+
+	database := newArangoDeployment(namespace, nil)
+
+	createArangoBackupPolicy(t, handler, policy)
+	createArangoDeployment(t, handler, database)
+
+	for i := 0; i < 3; i++ {
+		b := policy.NewBackup(database)
+		b.Name = fmt.Sprintf("backup-%d", i)
+		b.Status.State = backupApi.ArangoBackupStateReady
+		b.Status.Backup = &backupApi.ArangoBackupDetails{
+			ID:                b.Name,
+			CreationTimestamp: meta.Time{Time: time.Now().Add(-time.Duration(3-i) * time.Hour)},
+		}
+
+		_, err := handler.client.BackupV1().ArangoBackups(namespace).Create(context.Background(), b, meta.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, handler.Handle(context.Background(), newItemFromBackupPolicy(operation.Update, policy)))
+
+	backups := listArangoBackups(t, handler, namespace)
+	// backup-0 is pruned, new backup is created
+	require.Len(t, backups, 3)
+	for _, b := range backups {
+		require.NotEqual(t, "backup-0", b.Name)
+	}
+
+	policy = refreshArangoBackupPolicy(t, handler, policy)
+	require.Empty(t, policy.Status.Message)
+	require.NotNil(t, policy.Status.Retention)
+	require.NotNil(t, policy.Status.Retention.NextPrune)
+	require.Equal(t, policy.Status.Scheduled.Unix(), policy.Status.Retention.NextPrune.Unix())
+	require.Len(t, policy.Status.Retention.Backups, 2)
+	require.Equal(t, "backup-1", policy.Status.Retention.Backups[0].Name)
+	require.Equal(t, "backup-2", policy.Status.Retention.Backups[1].Name)
+	require.Equal(t, database.Name, policy.Status.Retention.Backups[0].Deployment)
+}
@@ -23,6 +23,7 @@ package operator
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -95,9 +96,10 @@ type Operator struct {
 	Config
 	Dependencies
 
-	log           logging.Logger
-	deployments   map[string]*deployment.Deployment
-	localStorages map[string]*storage.LocalStorage
+	log             logging.Logger
+	deploymentsLock sync.RWMutex
+	deployments     map[string]*deployment.Deployment
+	localStorages   map[string]*storage.LocalStorage
 }
 
 type Config struct {
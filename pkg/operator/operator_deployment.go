@@ -22,6 +22,7 @@ package operator
 
 import (
 	"context"
+	"sort"
 
 	kwatch "k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/tools/cache"
@@ -126,7 +127,7 @@ func (o *Operator) syncArangoDeployment(apiObject *api.ArangoDeployment) {
 	// re-watch or restart could give ADD event.
 	// If for an ADD event the cluster spec is invalid then it is not added to the local cache
 	// so modifying that deployment will result in another ADD event
-	if _, ok := o.deployments[apiObject.Name]; ok {
+	if _, ok := o.GetDeployment(apiObject.Name); ok {
 		ev.Type = kwatch.Modified
 	}
 
@@ -138,8 +139,37 @@ func (o *Operator) syncArangoDeployment(apiObject *api.ArangoDeployment) {
 	//pt.stop()
 }
 
+// GetDeployment returns the ArangoDeployment handler managed by the Operator
+func (o *Operator) GetDeployment(name string) (*deployment.Deployment, bool) {
+	o.deploymentsLock.RLock()
+	defer o.deploymentsLock.RUnlock()
+
+	d, ok := o.deployments[name]
+	return d, ok
+}
+
+// ListDeployments returns the ArangoDeployment handlers managed by the Operator, sorted by name
+func (o *Operator) ListDeployments() []*deployment.Deployment {
+	o.deploymentsLock.RLock()
+	defer o.deploymentsLock.RUnlock()
+
+	r := make([]*deployment.Deployment, 0, len(o.deployments))
+	for _, d := range o.deployments {
+		r = append(r, d)
+	}
+
+	sort.Slice(r, func(i, j int) bool {
+		return r[i].GetName() < r[j].GetName()
+	})
+
+	return r
+}
+
 // handleDeploymentEvent processed the given event.
 func (o *Operator) handleDeploymentEvent(event *Event) error {
+	o.deploymentsLock.Lock()
+	defer o.deploymentsLock.Unlock()
+
 	apiObject := event.Deployment
 
 	if apiObject.Status.Phase.IsFailed() {
@@ -101,6 +101,12 @@ func (ls *LocalStorage) fetchClientNodeInfo(ctx context.Context, c provisioner.A
 	return c.GetNodeInfo(nctx)
 }
 
+func (ls *LocalStorage) fetchClientInfo(ctx context.Context, c provisioner.API, localPath string) (provisioner.Info, error) {
+	nctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+	return c.GetInfo(nctx, localPath)
+}
+
 // GetClientByNodeName looks for a client that serves the given node name.
 // Returns an error if no such client is found.
 func (ls *LocalStorage) GetClientByNodeName(ctx context.Context, nodeName string) (provisioner.API, error) {
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2024 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -22,12 +22,12 @@ package storage
 
 import (
 	"context"
-	"reflect"
 	"sync/atomic"
 	"time"
 
 	"github.com/rs/zerolog"
 	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
 
@@ -100,6 +100,9 @@ type LocalStorage struct {
 
 	inspectTrigger trigger.Trigger
 	pvCleaner      *pvCleaner
+
+	allocations       volumeAllocations
+	capacityInspected time.Time
 }
 
 // New creates a new LocalStorage from the given API object.
@@ -144,6 +147,7 @@ func (ls *LocalStorage) Delete() {
 	if atomic.CompareAndSwapInt32(&ls.stopped, 0, 1) {
 		close(ls.stopCh)
 	}
+	localStorages.remove(ls.apiObject.GetName())
 }
 
 // send given event into the local storage event queue.
@@ -229,7 +233,7 @@ func (ls *LocalStorage) run() {
 
 		case <-ls.inspectTrigger.Done():
 			hasError := false
-			unboundPVCs, err := ls.inspectPVCs()
+			unboundPVCs, resizedPVCs, err := ls.inspectPVCs()
 			if err != nil {
 				hasError = true
 				ls.createEvent(k8sutil.NewErrorEvent("PVC inspection failed", err, ls.apiObject))
@@ -239,6 +243,16 @@ func (ls *LocalStorage) run() {
 				hasError = true
 				ls.createEvent(k8sutil.NewErrorEvent("PV inspection failed", err, ls.apiObject))
 			}
+			if len(resizedPVCs) > 0 {
+				if err := ls.resizePVs(context.Background(), resizedPVCs); err != nil {
+					hasError = true
+					ls.createEvent(k8sutil.NewErrorEvent("PV resize failed", err, ls.apiObject))
+				}
+			}
+			if err := ls.inspectCapacity(context.Background()); err != nil {
+				hasError = true
+				ls.createEvent(k8sutil.NewErrorEvent("Capacity inspection failed", err, ls.apiObject))
+			}
 			if len(unboundPVCs) == 0 {
 				pvsNeededSince = nil
 			} else if len(unboundPVCs) > 0 {
@@ -337,6 +351,11 @@ func (ls *LocalStorage) handleArangoLocalStorageUpdatedEvent(event *localStorage
 		return errors.WithStack(errors.Errorf("failed to update ArangoLocalStorage spec: %v", err))
 	}
 
+	// Update StorageClass
+	if err := ls.ensureStorageClass(ls.apiObject); err != nil {
+		ls.createEvent(k8sutil.NewErrorEvent("Failed to update storage class", err, ls.apiObject))
+	}
+
 	// Trigger inspect
 	ls.inspectTrigger.Trigger()
 
@@ -351,7 +370,7 @@ func (ls *LocalStorage) createEvent(evt *k8sutil.Event) {
 
 // Update the status of the API object from the internal status
 func (ls *LocalStorage) updateCRStatus() error {
-	if reflect.DeepEqual(ls.apiObject.Status, ls.status) {
+	if equality.Semantic.DeepEqual(ls.apiObject.Status, ls.status) {
 		// Nothing has changed
 		return nil
 	}
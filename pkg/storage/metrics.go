@@ -0,0 +1,76 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package storage
+
+import (
+	"sync"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/storage/v1alpha"
+	"github.com/arangodb/kube-arangodb/pkg/generated/metric_descriptions"
+	"github.com/arangodb/kube-arangodb/pkg/metrics/collector"
+	"github.com/arangodb/kube-arangodb/pkg/util/metrics"
+)
+
+func init() {
+	collector.GetCollector().RegisterMetric(&localStorages)
+}
+
+var localStorages = localStorageInventory{
+	nodes: map[string]api.LocalStorageNodesStatus{},
+}
+
+// localStorageInventory keeps the capacity of the local paths reported by the local storages
+type localStorageInventory struct {
+	lock sync.Mutex
+
+	nodes map[string]api.LocalStorageNodesStatus
+}
+
+func (i *localStorageInventory) setNodes(name string, nodes api.LocalStorageNodesStatus) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	i.nodes[name] = nodes
+}
+
+func (i *localStorageInventory) remove(name string) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	delete(i.nodes, name)
+}
+
+func (i *localStorageInventory) CollectMetrics(in metrics.PushMetric) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	for name, nodes := range i.nodes {
+		for _, node := range nodes {
+			for _, path := range node.Paths {
+				in.Push(
+					metric_descriptions.ArangodbOperatorLocalStorageCapacityGauge(float64(path.Capacity.Value()), name, node.Name, path.Path),
+					metric_descriptions.ArangodbOperatorLocalStorageAvailableGauge(float64(path.Available.Value()), name, node.Name, path.Path),
+					metric_descriptions.ArangodbOperatorLocalStorageAllocatedGauge(float64(path.Allocated.Value()), name, node.Name, path.Path),
+				)
+			}
+		}
+	}
+}
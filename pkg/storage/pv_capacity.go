@@ -0,0 +1,155 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package storage
+
+import (
+	"context"
+	"path/filepath"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/storage/v1alpha"
+	"github.com/arangodb/kube-arangodb/pkg/storage/provisioner"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+const (
+	capacityInspectionInterval = 30 * time.Second
+)
+
+// volumeAllocations keeps the capacity of the PersistentVolumes created in the local paths, per node and path
+type volumeAllocations map[string]map[string]int64
+
+// Get returns the capacity of the PersistentVolumes created in the local path on the node
+func (v volumeAllocations) Get(node, path string) int64 {
+	return v[node][filepath.Clean(path)]
+}
+
+func (v volumeAllocations) add(node, path string, size int64) {
+	path = filepath.Clean(path)
+
+	if _, ok := v[node]; !ok {
+		v[node] = map[string]int64{}
+	}
+
+	v[node][path] += size
+}
+
+// newVolumeAllocations calculates the allocations of the local paths from the PersistentVolumes of the storage class
+func newVolumeAllocations(storageClass string, localPaths []string, volumes []*core.PersistentVolume) volumeAllocations {
+	r := volumeAllocations{}
+
+	for _, pv := range volumes {
+		if pv.Spec.StorageClassName != storageClass {
+			continue
+		}
+
+		local := pv.Spec.PersistentVolumeSource.Local
+		if local == nil {
+			continue
+		}
+
+		node := pv.GetAnnotations()[nodeNameAnnotation]
+		if node == "" {
+			continue
+		}
+
+		root := filepath.Dir(filepath.Clean(local.Path))
+
+		for _, path := range localPaths {
+			if filepath.Clean(path) != root {
+				continue
+			}
+
+			size := pv.Spec.Capacity[core.ResourceStorage]
+			r.add(node, root, size.Value())
+		}
+	}
+
+	return r
+}
+
+// isOvercommitted returns true if the volume of the given size does not fit into the unallocated space of the local path
+func isOvercommitted(info provisioner.Info, allocated, volSize int64) bool {
+	return allocated+volSize > info.Capacity
+}
+
+func newLocalStoragePathStatus(path string, info provisioner.Info, allocated int64) api.LocalStoragePathStatus {
+	free := info.Capacity - allocated
+	if free < 0 {
+		free = 0
+	}
+
+	return api.LocalStoragePathStatus{
+		Path:      path,
+		Capacity:  *resource.NewQuantity(info.Capacity, resource.BinarySI),
+		Available: *resource.NewQuantity(info.Available, resource.BinarySI),
+		Allocated: *resource.NewQuantity(allocated, resource.BinarySI),
+		Free:      *resource.NewQuantity(free, resource.BinarySI),
+	}
+}
+
+// inspectCapacity fetches the capacity of the local paths from the provisioners and saves it in the status
+func (ls *LocalStorage) inspectCapacity(ctx context.Context) error {
+	if time.Since(ls.capacityInspected) < capacityInspectionInterval {
+		return nil
+	}
+
+	clients, err := ls.createProvisionerClients(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	nodes := clients.Keys()
+	sort.Strings(nodes)
+
+	status := make(api.LocalStorageNodesStatus, 0, len(nodes))
+
+	for _, node := range nodes {
+		n := api.LocalStorageNodeStatus{
+			Name: node,
+		}
+
+		for _, path := range ls.apiObject.Spec.LocalPath {
+			info, err := ls.fetchClientInfo(ctx, clients[node], path)
+			if err != nil {
+				ls.log.Err(err).Str("node", node).Str("local-path-root", path).Warn("Failed to get client info")
+				continue
+			}
+
+			n.Paths = append(n.Paths, newLocalStoragePathStatus(path, info, ls.allocations.Get(node, path)))
+		}
+
+		status = append(status, n)
+	}
+
+	ls.capacityInspected = time.Now()
+	ls.status.Nodes = status
+	if atomic.LoadInt32(&ls.stopped) == 0 {
+		localStorages.setNodes(ls.apiObject.GetName(), status.DeepCopy())
+	}
+
+	return ls.updateCRStatus()
+}
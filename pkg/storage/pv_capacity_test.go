@@ -0,0 +1,88 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/storage/provisioner"
+)
+
+func newTestVolume(storageClass, node, path string, size int64) *core.PersistentVolume {
+	return &core.PersistentVolume{
+		ObjectMeta: meta.ObjectMeta{
+			Annotations: map[string]string{
+				nodeNameAnnotation: node,
+			},
+		},
+		Spec: core.PersistentVolumeSpec{
+			StorageClassName: storageClass,
+			Capacity: core.ResourceList{
+				core.ResourceStorage: *resource.NewQuantity(size, resource.BinarySI),
+			},
+			PersistentVolumeSource: core.PersistentVolumeSource{
+				Local: &core.LocalVolumeSource{
+					Path: path,
+				},
+			},
+		},
+	}
+}
+
+// TestVolumeAllocations tests newVolumeAllocations.
+func TestVolumeAllocations(t *testing.T) {
+	allocations := newVolumeAllocations("local", []string{"/mnt/data", "/mnt/fast/"}, []*core.PersistentVolume{
+		newTestVolume("local", "node-a", "/mnt/data/abc", 100),
+		newTestVolume("local", "node-a", "/mnt/data/def", 50),
+		newTestVolume("local", "node-a", "/mnt/fast/ghi", 10),
+		newTestVolume("local", "node-b", "/mnt/data/jkl", 20),
+		newTestVolume("other", "node-a", "/mnt/data/mno", 1000),
+		newTestVolume("local", "node-a", "/mnt/other/pqr", 1000),
+		newTestVolume("local", "", "/mnt/data/stu", 1000),
+	})
+
+	assert.EqualValues(t, 150, allocations.Get("node-a", "/mnt/data"))
+	assert.EqualValues(t, 150, allocations.Get("node-a", "/mnt/data/"))
+	assert.EqualValues(t, 10, allocations.Get("node-a", "/mnt/fast"))
+	assert.EqualValues(t, 20, allocations.Get("node-b", "/mnt/data"))
+	assert.EqualValues(t, 0, allocations.Get("node-b", "/mnt/fast"))
+	assert.EqualValues(t, 0, allocations.Get("node-c", "/mnt/data"))
+}
+
+// TestIsOvercommitted tests isOvercommitted and newLocalStoragePathStatus.
+func TestIsOvercommitted(t *testing.T) {
+	info := provisioner.Info{Capacity: 100, Available: 90}
+
+	assert.False(t, isOvercommitted(info, 0, 100))
+	assert.False(t, isOvercommitted(info, 60, 40))
+	assert.True(t, isOvercommitted(info, 60, 41))
+
+	status := newLocalStoragePathStatus("/mnt/data", info, 120)
+	assert.EqualValues(t, 100, status.Capacity.Value())
+	assert.EqualValues(t, 90, status.Available.Value())
+	assert.EqualValues(t, 120, status.Allocated.Value())
+	assert.EqualValues(t, 0, status.Free.Value())
+}
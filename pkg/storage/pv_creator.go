@@ -164,6 +164,7 @@ func (ls *LocalStorage) createPVs(ctx context.Context, apiObject *api.ArangoLoca
 func (ls *LocalStorage) createPV(ctx context.Context, apiObject *api.ArangoLocalStorage, clients Clients, clientsOffset int, volSize int64, claim core.PersistentVolumeClaim, storageClassReclaimPolicy core.PersistentVolumeReclaimPolicy, deploymentName, role string) error {
 	// Try clients
 	keys := clients.Keys()
+	overcommitted := false
 
 	for clientIdx := 0; clientIdx < len(keys); clientIdx++ {
 		client := clients[keys[(clientsOffset+clientIdx)%len(keys)]]
@@ -180,6 +181,11 @@ func (ls *LocalStorage) createPV(ctx context.Context, apiObject *api.ArangoLocal
 				ls.log.Error("Not enough available size")
 				continue
 			}
+			if allocated := ls.allocations.Get(info.NodeName, localPathRoot); isOvercommitted(info, allocated, volSize) {
+				log.Int64("allocated", allocated).Int64("capacity", info.Capacity).Int64("size", volSize).Warn("Not enough unallocated size")
+				overcommitted = true
+				continue
+			}
 			// Ok, prepare a directory
 			name := goStrings.ToLower(uniuri.New())
 			localPath := filepath.Join(localPathRoot, name)
@@ -252,6 +258,11 @@ func (ls *LocalStorage) createPV(ctx context.Context, apiObject *api.ArangoLocal
 				Str("node-name", info.NodeName).
 				Debug("Created PersistentVolume")
 
+			if ls.allocations == nil {
+				ls.allocations = volumeAllocations{}
+			}
+			ls.allocations.add(info.NodeName, localPathRoot, volSize)
+
 			// Bind claim to volume
 			if err := ls.bindClaimToVolume(claim, pv.GetName()); err != nil {
 				// Try to delete the PV now
@@ -268,6 +279,9 @@ func (ls *LocalStorage) createPV(ctx context.Context, apiObject *api.ArangoLocal
 			return nil
 		}
 	}
+	if overcommitted {
+		ls.createEvent(k8sutil.NewLocalStorageOvercommitEvent(apiObject, fmt.Sprintf("%s/%s", claim.GetNamespace(), claim.GetName()), resource.NewQuantity(volSize, resource.BinarySI).String()))
+	}
 	return errors.WithStack(errors.Errorf("No more nodes available"))
 }
 
@@ -56,6 +56,7 @@ func (ls *LocalStorage) inspectPVs() (int, error) {
 		}
 	}
 	spec := ls.apiObject.Spec
+	ls.allocations = newVolumeAllocations(spec.StorageClass.Name, spec.LocalPath, volumes)
 	availableVolumes := 0
 	cleanupBeforeTimestamp := time.Now().Add(time.Hour * -24)
 	for _, pv := range volumes {
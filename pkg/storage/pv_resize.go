@@ -0,0 +1,191 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+// resizePVs expands the PersistentVolumes bound to the given claims.
+// Volume is expanded only if the local path has enough unallocated space, otherwise the resize is rejected.
+func (ls *LocalStorage) resizePVs(ctx context.Context, claims []core.PersistentVolumeClaim) error {
+	var failed []string
+
+	for _, claim := range claims {
+		if err := ls.resizePV(ctx, claim); err != nil {
+			ls.log.Err(err).Str("pvc-name", claim.GetName()).Warn("Failed to resize PersistentVolume")
+			failed = append(failed, claim.GetName())
+		}
+	}
+
+	if len(failed) > 0 {
+		return errors.Errorf("Unable to resize PersistentVolumes bound to claims: %v", failed)
+	}
+
+	return nil
+}
+
+// resizePV expands the PersistentVolume bound to the given claim
+func (ls *LocalStorage) resizePV(ctx context.Context, claim core.PersistentVolumeClaim) error {
+	log := ls.log.Str("pvc-name", claim.GetName()).Str("volume-name", claim.Spec.VolumeName)
+	pvs := ls.deps.Client.Kubernetes().CoreV1().PersistentVolumes()
+
+	pv, err := pvs.Get(ctx, claim.Spec.VolumeName, meta.GetOptions{})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if !ls.isOwnerOf(pv) {
+		log.Debug("PersistentVolume is not owned by us")
+		return nil
+	}
+
+	localSource := pv.Spec.PersistentVolumeSource.Local
+	if localSource == nil {
+		return errors.WithStack(errors.Errorf("PersistentVolume has no local source"))
+	}
+
+	nodeName := pv.GetAnnotations()[nodeNameAnnotation]
+	if nodeName == "" {
+		return errors.WithStack(errors.Errorf("PersistentVolume has no node-name annotation"))
+	}
+
+	requested := claim.Spec.Resources.Requests[core.ResourceStorage]
+	current := pv.Spec.Capacity[core.ResourceStorage]
+
+	if current.Cmp(requested) < 0 {
+		localPathRoot := filepath.Dir(filepath.Clean(localSource.Path))
+
+		client, err := ls.GetClientByNodeName(ctx, nodeName)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		info, err := ls.fetchClientInfo(ctx, client, localPathRoot)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		// Space allocated by the volume itself is reused
+		allocated := ls.allocations.Get(nodeName, localPathRoot) - current.Value()
+
+		if isOvercommitted(info, allocated, requested.Value()) {
+			reason := fmt.Sprintf("Local path %s on node %s has not enough unallocated space: requested %s, unallocated %s",
+				localPathRoot, nodeName, requested.String(), resource.NewQuantity(info.Capacity-allocated, resource.BinarySI).String())
+			log.Str("reason", reason).Warn("PersistentVolume cannot be expanded")
+			return ls.rejectPVCResize(ctx, claim, reason)
+		}
+
+		pv.Spec.Capacity[core.ResourceStorage] = requested
+		if _, err := pvs.Update(ctx, pv, meta.UpdateOptions{}); err != nil {
+			return errors.WithStack(err)
+		}
+
+		if ls.allocations == nil {
+			ls.allocations = volumeAllocations{}
+		}
+		ls.allocations.add(nodeName, localPathRoot, requested.Value()-current.Value())
+
+		log.Str("size", requested.String()).Info("PersistentVolume expanded")
+	}
+
+	return ls.completePVCResize(ctx, claim, requested)
+}
+
+// completePVCResize sets the capacity of the claim to the size of the expanded volume.
+// Local volumes are directories, so the filesystem does not need to be resized.
+func (ls *LocalStorage) completePVCResize(ctx context.Context, claim core.PersistentVolumeClaim, size resource.Quantity) error {
+	pvcs := ls.deps.Client.Kubernetes().CoreV1().PersistentVolumeClaims(claim.GetNamespace())
+
+	updated := claim.DeepCopy()
+	if updated.Status.Capacity == nil {
+		updated.Status.Capacity = core.ResourceList{}
+	}
+	updated.Status.Capacity[core.ResourceStorage] = size
+	updated.Status.Conditions = removePVCConditions(updated.Status.Conditions, core.PersistentVolumeClaimResizing, core.PersistentVolumeClaimControllerResizeError)
+
+	if _, err := pvcs.UpdateStatus(ctx, updated, meta.UpdateOptions{}); err != nil {
+		return errors.WithStack(err)
+	}
+
+	ls.createEvent(k8sutil.NewVolumeResizeSuccessfulEvent(updated, size.String()))
+
+	return nil
+}
+
+// rejectPVCResize sets the ControllerResizeError condition with the reason on the claim
+func (ls *LocalStorage) rejectPVCResize(ctx context.Context, claim core.PersistentVolumeClaim, reason string) error {
+	for _, c := range claim.Status.Conditions {
+		if c.Type == core.PersistentVolumeClaimControllerResizeError && c.Status == core.ConditionTrue && c.Message == reason {
+			// Already rejected
+			return nil
+		}
+	}
+
+	pvcs := ls.deps.Client.Kubernetes().CoreV1().PersistentVolumeClaims(claim.GetNamespace())
+
+	updated := claim.DeepCopy()
+	updated.Status.Conditions = append(removePVCConditions(updated.Status.Conditions, core.PersistentVolumeClaimControllerResizeError), core.PersistentVolumeClaimCondition{
+		Type:               core.PersistentVolumeClaimControllerResizeError,
+		Status:             core.ConditionTrue,
+		LastTransitionTime: meta.Now(),
+		Reason:             "NotEnoughSpace",
+		Message:            reason,
+	})
+
+	if _, err := pvcs.UpdateStatus(ctx, updated, meta.UpdateOptions{}); err != nil {
+		return errors.WithStack(err)
+	}
+
+	ls.createEvent(k8sutil.NewVolumeResizeFailedEvent(updated, reason))
+
+	return nil
+}
+
+// removePVCConditions returns the conditions without the given types
+func removePVCConditions(conditions []core.PersistentVolumeClaimCondition, types ...core.PersistentVolumeClaimConditionType) []core.PersistentVolumeClaimCondition {
+	var r []core.PersistentVolumeClaimCondition
+
+	for _, c := range conditions {
+		remove := false
+		for _, t := range types {
+			if c.Type == t {
+				remove = true
+				break
+			}
+		}
+
+		if !remove {
+			r = append(r, c)
+		}
+	}
+
+	return r
+}
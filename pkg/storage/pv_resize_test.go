@@ -0,0 +1,73 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// TestPVCNeedsResize tests pvcNeedsResize.
+func TestPVCNeedsResize(t *testing.T) {
+	newClaim := func(phase core.PersistentVolumeClaimPhase, requested, capacity string) core.PersistentVolumeClaim {
+		return core.PersistentVolumeClaim{
+			Spec: core.PersistentVolumeClaimSpec{
+				VolumeName: "pv",
+				Resources: core.VolumeResourceRequirements{
+					Requests: core.ResourceList{
+						core.ResourceStorage: resource.MustParse(requested),
+					},
+				},
+			},
+			Status: core.PersistentVolumeClaimStatus{
+				Phase: phase,
+				Capacity: core.ResourceList{
+					core.ResourceStorage: resource.MustParse(capacity),
+				},
+			},
+		}
+	}
+
+	assert.True(t, pvcNeedsResize(newClaim(core.ClaimBound, "20Gi", "10Gi")))
+	assert.False(t, pvcNeedsResize(newClaim(core.ClaimBound, "10Gi", "10Gi")))
+	assert.False(t, pvcNeedsResize(newClaim(core.ClaimBound, "10Gi", "20Gi")))
+	assert.False(t, pvcNeedsResize(newClaim(core.ClaimPending, "20Gi", "10Gi")))
+
+	claim := newClaim(core.ClaimBound, "20Gi", "10Gi")
+	claim.Spec.VolumeName = ""
+	assert.False(t, pvcNeedsResize(claim))
+}
+
+// TestRemovePVCConditions tests removePVCConditions.
+func TestRemovePVCConditions(t *testing.T) {
+	conditions := []core.PersistentVolumeClaimCondition{
+		{Type: core.PersistentVolumeClaimResizing},
+		{Type: core.PersistentVolumeClaimFileSystemResizePending},
+		{Type: core.PersistentVolumeClaimControllerResizeError},
+	}
+
+	r := removePVCConditions(conditions, core.PersistentVolumeClaimResizing, core.PersistentVolumeClaimControllerResizeError)
+	assert.Len(t, r, 1)
+	assert.Equal(t, core.PersistentVolumeClaimFileSystemResizePending, r[0].Type)
+}
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -30,26 +30,29 @@ import (
 )
 
 // inspectPVCs queries all PVC's and checks if there is a need to
-// build new persistent volumes.
-// Returns the PVC's that need a volume.
-func (ls *LocalStorage) inspectPVCs() ([]core.PersistentVolumeClaim, error) {
+// build new persistent volumes or to expand the bound ones.
+// Returns the PVC's that need a volume and the PVC's that need a volume expansion.
+func (ls *LocalStorage) inspectPVCs() ([]core.PersistentVolumeClaim, []core.PersistentVolumeClaim, error) {
 	ns := ls.apiObject.GetNamespace()
 	list, err := ls.deps.Client.Kubernetes().CoreV1().PersistentVolumeClaims(ns).List(context.Background(), meta.ListOptions{})
 	if err != nil {
-		return nil, errors.WithStack(err)
+		return nil, nil, errors.WithStack(err)
 	}
 	spec := ls.apiObject.Spec
-	var result []core.PersistentVolumeClaim
+	var result, resized []core.PersistentVolumeClaim
 	for _, pvc := range list.Items {
 		if !pvcMatchesStorageClass(pvc, spec.StorageClass.Name, spec.StorageClass.IsDefault) {
 			continue
 		}
-		if !pvcNeedsVolume(pvc) {
+		if pvcNeedsVolume(pvc) {
+			result = append(result, pvc)
 			continue
 		}
-		result = append(result, pvc)
+		if spec.StorageClass.IsVolumeExpansionAllowed() && pvcNeedsResize(pvc) {
+			resized = append(resized, pvc)
+		}
 	}
-	return result, nil
+	return result, resized, nil
 }
 
 // pvcMatchesStorageClass checks if the given pvc requests a volume
@@ -67,3 +70,22 @@ func pvcMatchesStorageClass(pvc core.PersistentVolumeClaim, storageClassName str
 func pvcNeedsVolume(pvc core.PersistentVolumeClaim) bool {
 	return pvc.Status.Phase == core.ClaimPending
 }
+
+// pvcNeedsResize checks if the given bound pvc requests more storage than its current capacity.
+func pvcNeedsResize(pvc core.PersistentVolumeClaim) bool {
+	if pvc.Status.Phase != core.ClaimBound || pvc.Spec.VolumeName == "" {
+		return false
+	}
+
+	requested, ok := pvc.Spec.Resources.Requests[core.ResourceStorage]
+	if !ok {
+		return false
+	}
+
+	capacity, ok := pvc.Status.Capacity[core.ResourceStorage]
+	if !ok {
+		return false
+	}
+
+	return capacity.Cmp(requested) < 0
+}
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2023 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -25,6 +25,7 @@ import (
 
 	storage "k8s.io/api/storage/v1"
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	storageTyped "k8s.io/client-go/kubernetes/typed/storage/v1"
 
 	api "github.com/arangodb/kube-arangodb/pkg/apis/storage/v1alpha"
 	"github.com/arangodb/kube-arangodb/pkg/util"
@@ -46,9 +47,10 @@ func (l *LocalStorage) ensureStorageClass(apiObject *api.ArangoLocalStorage) err
 		ObjectMeta: meta.ObjectMeta{
 			Name: spec.Name,
 		},
-		ReclaimPolicy:     util.NewType(apiObject.Spec.StorageClass.GetReclaimPolicy()),
-		VolumeBindingMode: &bindingMode,
-		Provisioner:       storageClassProvisioner,
+		ReclaimPolicy:        util.NewType(apiObject.Spec.StorageClass.GetReclaimPolicy()),
+		VolumeBindingMode:    &bindingMode,
+		Provisioner:          storageClassProvisioner,
+		AllowVolumeExpansion: util.NewType(apiObject.Spec.StorageClass.IsVolumeExpansionAllowed()),
 	}
 	// Note: We do not attach the StorageClass to the apiObject (OwnerRef) because many
 	// ArangoLocalStorage resource may use the same StorageClass.
@@ -57,6 +59,10 @@ func (l *LocalStorage) ensureStorageClass(apiObject *api.ArangoLocalStorage) err
 		l.log.
 			Str("storageclass", sc.GetName()).
 			Debug("StorageClass already exists")
+
+		if err := l.ensureStorageClassVolumeExpansion(cli, sc); err != nil {
+			return errors.WithStack(err)
+		}
 	} else if err != nil {
 		l.log.Err(err).
 			Str("storageclass", sc.GetName()).
@@ -107,3 +113,39 @@ func (l *LocalStorage) ensureStorageClass(apiObject *api.ArangoLocalStorage) err
 
 	return nil
 }
+
+// ensureStorageClassVolumeExpansion updates the AllowVolumeExpansion field of the existing StorageClass.
+// This is the only field of the StorageClass which can be changed.
+func (l *LocalStorage) ensureStorageClassVolumeExpansion(cli storageTyped.StorageV1Interface, expected *storage.StorageClass) error {
+	current, err := cli.StorageClasses().Get(context.Background(), expected.GetName(), meta.GetOptions{})
+	if err != nil {
+		l.log.Err(err).
+			Str("storageclass", expected.GetName()).
+			Debug("Failed to get StorageClass")
+		return errors.WithStack(err)
+	}
+
+	if current.Provisioner != storageClassProvisioner {
+		// Not our StorageClass
+		return nil
+	}
+
+	if util.TypeOrDefault(current.AllowVolumeExpansion, false) == util.TypeOrDefault(expected.AllowVolumeExpansion, false) {
+		return nil
+	}
+
+	current.AllowVolumeExpansion = expected.AllowVolumeExpansion
+	if _, err := cli.StorageClasses().Update(context.Background(), current, meta.UpdateOptions{}); err != nil {
+		l.log.Err(err).
+			Str("storageclass", expected.GetName()).
+			Debug("Failed to update StorageClass")
+		return errors.WithStack(err)
+	}
+
+	l.log.
+		Str("storageclass", expected.GetName()).
+		Bool("allowVolumeExpansion", util.TypeOrDefault(expected.AllowVolumeExpansion, false)).
+		Debug("StorageClass updated")
+
+	return nil
+}
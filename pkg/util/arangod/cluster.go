@@ -75,3 +75,10 @@ func CleanNumberOfServers(ctx context.Context, conn adbDriverV2Connection.Connec
 func RemoveServerFromCluster(ctx context.Context, conn adbDriverV2Connection.Connection, id adbDriverV2.ServerID) error {
 	return PostRequest[adbDriverV2.ServerID, any](ctx, conn, id, "_admin/cluster/removeServer").Do(ctx).AcceptCode(goHttp.StatusOK).Evaluate()
 }
+
+// CancelAgencyJob aborts the agency supervision job. Only MoveShard and CleanOutServer jobs can be aborted.
+func CancelAgencyJob(ctx context.Context, conn adbDriverV2Connection.Connection, id string) error {
+	return PostRequest[map[string]string, any](ctx, conn, map[string]string{
+		"id": id,
+	}, "_admin/cluster/cancelAgencyJob").Do(ctx).AcceptCode(goHttp.StatusOK).Evaluate()
+}
@@ -44,6 +44,7 @@ const (
 	EnvArangoSyncMonitoringToken = "ARANGOSYNC_MONITORING_TOKEN" // Constains monitoring token for ArangoSync servers
 
 	SecretEncryptionKey          = "key"                   // Key in a Secret.Data used to store an 32-byte encryption key
+	SecretEncryptionWrappedKey   = "wrappedKey"            // Key in a Secret.Data used to store an encryption key wrapped by the KMS
 	SecretKeyToken               = "token"                 // Key inside a Secret used to hold a JWT or monitoring token
 	SecretKeyV2Token             = "token-v2"              // Key inside a Secret used to hold a License in V2 Format
 	SecretKeyV2License           = "license-v2"            // Key inside a Secret used to hold a License in V2 Format
@@ -34,6 +34,7 @@ const (
 	ManagementDestination               = "/_management"
 
 	EnvoyIntegrationSidecarFilterName = "envoy.filters.http.ext_authz"
+	EnvoyLocalRateLimitFilterName     = "envoy.filters.http.local_ratelimit"
 
 	EnvoyIntegrationSidecarCluster = "integration_sidecar"
 )
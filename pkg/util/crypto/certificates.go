@@ -24,10 +24,42 @@ import (
 	"bytes"
 	"crypto/x509"
 	"encoding/pem"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
 )
 
 type Certificates []*x509.Certificate
 
+// ParseCertificates parses all PEM encoded certificates from the data
+func ParseCertificates(data []byte) (Certificates, error) {
+	var certs Certificates
+
+	for len(data) > 0 {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 {
+		return nil, errors.Errorf("No certificates found")
+	}
+
+	return certs, nil
+}
+
 func (c Certificates) Contains(cert *x509.Certificate) bool {
 	for _, localCert := range c {
 		if !localCert.Equal(cert) {
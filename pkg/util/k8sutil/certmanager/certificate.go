@@ -0,0 +1,154 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package certmanager
+
+import (
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+const (
+	// Group is the API group of cert-manager
+	Group = "cert-manager.io"
+	// Version is the supported API version of cert-manager
+	Version = "v1"
+
+	// CertificateKind is the kind of the cert-manager Certificate
+	CertificateKind = "Certificate"
+	// CertificateResource is the resource name of the cert-manager Certificate
+	CertificateResource = "certificates"
+
+	// CertificateConditionReady is the condition set by cert-manager once the certificate is issued
+	CertificateConditionReady = "Ready"
+
+	// SecretCACertificate is the field of the issued Secret with the CA certificate of the issuer
+	SecretCACertificate = "ca.crt"
+)
+
+// CertificateGVR returns the GroupVersionResource of the cert-manager Certificate
+func CertificateGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    Group,
+		Version:  Version,
+		Resource: CertificateResource,
+	}
+}
+
+// Certificate is the subset of the cert-manager Certificate managed by the Operator
+type Certificate struct {
+	meta.TypeMeta   `json:",inline"`
+	meta.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CertificateSpec   `json:"spec"`
+	Status CertificateStatus `json:"status,omitempty"`
+}
+
+// CertificateSpec is the subset of the cert-manager Certificate spec managed by the Operator
+type CertificateSpec struct {
+	SecretName     string          `json:"secretName"`
+	IssuerRef      IssuerReference `json:"issuerRef"`
+	CommonName     string          `json:"commonName,omitempty"`
+	DNSNames       []string        `json:"dnsNames,omitempty"`
+	IPAddresses    []string        `json:"ipAddresses,omitempty"`
+	EmailAddresses []string        `json:"emailAddresses,omitempty"`
+	Duration       *meta.Duration  `json:"duration,omitempty"`
+	RenewBefore    *meta.Duration  `json:"renewBefore,omitempty"`
+	Usages         []string        `json:"usages,omitempty"`
+	SecretTemplate *SecretTemplate `json:"secretTemplate,omitempty"`
+	PrivateKey     *PrivateKeySpec `json:"privateKey,omitempty"`
+}
+
+// IssuerReference references the cert-manager issuer
+type IssuerReference struct {
+	Name  string `json:"name"`
+	Kind  string `json:"kind,omitempty"`
+	Group string `json:"group,omitempty"`
+}
+
+// SecretTemplate defines the metadata of the issued Secret
+type SecretTemplate struct {
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// PrivateKeySpec defines the private key of the certificate
+type PrivateKeySpec struct {
+	RotationPolicy string `json:"rotationPolicy,omitempty"`
+	Algorithm      string `json:"algorithm,omitempty"`
+	Size           int    `json:"size,omitempty"`
+}
+
+// CertificateStatus is the subset of the cert-manager Certificate status read by the Operator
+type CertificateStatus struct {
+	Conditions []CertificateCondition `json:"conditions,omitempty"`
+	NotAfter   *meta.Time             `json:"notAfter,omitempty"`
+	Revision   *int                   `json:"revision,omitempty"`
+}
+
+// CertificateCondition is the condition of the cert-manager Certificate
+type CertificateCondition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// IsReady returns true when the certificate is issued and up to date
+func (c *Certificate) IsReady() bool {
+	if c == nil {
+		return false
+	}
+
+	for _, cond := range c.Status.Conditions {
+		if cond.Type == CertificateConditionReady {
+			return cond.Status == string(meta.ConditionTrue)
+		}
+	}
+
+	return false
+}
+
+// ToUnstructured converts the certificate into the unstructured object
+func (c *Certificate) ToUnstructured() (*unstructured.Unstructured, error) {
+	c.APIVersion = schema.GroupVersion{Group: Group, Version: Version}.String()
+	c.Kind = CertificateKind
+
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(c)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &unstructured.Unstructured{Object: obj}, nil
+}
+
+// FromUnstructured converts the unstructured object into the certificate
+func FromUnstructured(in *unstructured.Unstructured) (*Certificate, error) {
+	var c Certificate
+
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(in.UnstructuredContent(), &c); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &c, nil
+}
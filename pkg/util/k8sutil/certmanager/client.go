@@ -0,0 +1,86 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package certmanager
+
+import (
+	"context"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+)
+
+// Client manages cert-manager Certificates in a single namespace
+type Client interface {
+	Get(ctx context.Context, name string) (*Certificate, error)
+	Create(ctx context.Context, cert *Certificate) (*Certificate, error)
+	Update(ctx context.Context, cert *Certificate) (*Certificate, error)
+}
+
+// NewClient returns the Certificate client for the given namespace
+func NewClient(client dynamic.Interface, namespace string) Client {
+	return certificateClient{
+		client: client.Resource(CertificateGVR()).Namespace(namespace),
+	}
+}
+
+type certificateClient struct {
+	client dynamic.ResourceInterface
+}
+
+func (c certificateClient) Get(ctx context.Context, name string) (*Certificate, error) {
+	obj, err := c.client.Get(ctx, name, meta.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return FromUnstructured(obj)
+}
+
+func (c certificateClient) Create(ctx context.Context, cert *Certificate) (*Certificate, error) {
+	obj, err := cert.ToUnstructured()
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err = c.client.Create(ctx, obj, meta.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return FromUnstructured(obj)
+}
+
+func (c certificateClient) Update(ctx context.Context, cert *Certificate) (*Certificate, error) {
+	obj, err := cert.ToUnstructured()
+	if err != nil {
+		return nil, err
+	}
+
+	// Status is managed by cert-manager
+	delete(obj.Object, "status")
+
+	obj, err = c.client.Update(ctx, obj, meta.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return FromUnstructured(obj)
+}
@@ -0,0 +1,80 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package certmanager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicFake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/kerrors"
+)
+
+func Test_Client(t *testing.T) {
+	ctx := context.Background()
+
+	c := NewClient(dynamicFake.NewSimpleDynamicClient(runtime.NewScheme()), "default")
+
+	_, err := c.Get(ctx, "cert")
+	require.True(t, kerrors.IsNotFound(err))
+
+	var cert Certificate
+	cert.SetName("cert")
+	cert.Spec.SecretName = "cert-secret"
+	cert.Spec.IssuerRef = IssuerReference{Name: "issuer", Kind: "Issuer", Group: Group}
+	cert.Spec.DNSNames = []string{"example.com"}
+	cert.Spec.Duration = &meta.Duration{Duration: time.Hour}
+
+	created, err := c.Create(ctx, &cert)
+	require.NoError(t, err)
+	require.Equal(t, "cert", created.GetName())
+	require.Equal(t, CertificateKind, created.Kind)
+	require.False(t, created.IsReady())
+
+	current, err := c.Get(ctx, "cert")
+	require.NoError(t, err)
+	require.Equal(t, cert.Spec, current.Spec)
+
+	current.Spec.DNSNames = append(current.Spec.DNSNames, "db.example.com")
+
+	updated, err := c.Update(ctx, current)
+	require.NoError(t, err)
+	require.Equal(t, []string{"example.com", "db.example.com"}, updated.Spec.DNSNames)
+}
+
+func Test_CertificateIsReady(t *testing.T) {
+	var cert *Certificate
+	require.False(t, cert.IsReady())
+
+	cert = &Certificate{}
+	require.False(t, cert.IsReady())
+
+	cert.Status.Conditions = []CertificateCondition{{Type: CertificateConditionReady, Status: "False"}}
+	require.False(t, cert.IsReady())
+
+	cert.Status.Conditions = []CertificateCondition{{Type: CertificateConditionReady, Status: "True"}}
+	require.True(t, cert.IsReady())
+}
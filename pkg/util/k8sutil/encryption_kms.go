@@ -0,0 +1,82 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package k8sutil
+
+import (
+	core "k8s.io/api/core/v1"
+
+	shared "github.com/arangodb/kube-arangodb/pkg/apis/shared"
+)
+
+// RocksdbEncryptionWrappedVolumeMount creates a volume mount structure for a RocksDB keyfolder with the wrapped encryption keys.
+func RocksdbEncryptionWrappedVolumeMount() core.VolumeMount {
+	return core.VolumeMount{
+		Name:      shared.RocksdbEncryptionWrappedVolumeName,
+		MountPath: shared.RocksDBEncryptionWrappedVolumeMountDir,
+		ReadOnly:  true,
+	}
+}
+
+// RocksdbEncryptionKMSVolumeMount creates a volume mount structure for a KMS credentials.
+func RocksdbEncryptionKMSVolumeMount() core.VolumeMount {
+	return core.VolumeMount{
+		Name:      shared.RocksdbEncryptionKMSVolumeName,
+		MountPath: shared.RocksDBEncryptionKMSVolumeMountDir,
+		ReadOnly:  true,
+	}
+}
+
+// RocksdbEncryptionKMSCAVolumeMount creates a volume mount structure for a KMS CA certificate.
+func RocksdbEncryptionKMSCAVolumeMount() core.VolumeMount {
+	return core.VolumeMount{
+		Name:      shared.RocksdbEncryptionKMSCAVolumeName,
+		MountPath: shared.RocksDBEncryptionKMSCAVolumeMountDir,
+		ReadOnly:  true,
+	}
+}
+
+// ArangodEncryptionKMSInitContainer creates a container which unwraps the encryption keys with the KMS
+// and stores them in the RocksDB encryption keyfolder volume
+func ArangodEncryptionKMSInitContainer(name, executable, operatorImage string, args []string, securityContext *core.SecurityContext, withCA bool) core.Container {
+	var command = []string{
+		executable,
+		"init-containers",
+		"encryption-kms",
+		"--source",
+		shared.RocksDBEncryptionWrappedVolumeMountDir,
+		"--target",
+		shared.RocksDBEncryptionVolumeMountDir,
+	}
+
+	command = append(command, args...)
+
+	volumes := []core.VolumeMount{
+		RocksdbEncryptionVolumeMount(),
+		RocksdbEncryptionWrappedVolumeMount(),
+		RocksdbEncryptionKMSVolumeMount(),
+	}
+
+	if withCA {
+		volumes = append(volumes, RocksdbEncryptionKMSCAVolumeMount())
+	}
+
+	return operatorInitContainer(name, operatorImage, command, securityContext, volumes)
+}
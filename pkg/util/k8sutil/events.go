@@ -195,6 +195,24 @@ func NewPlanAbortedEvent(apiObject APIObject, itemType, memberID, role string) *
 	return event
 }
 
+// NewPlanActionSkippedEvent creates an event indicating that a plan item has been skipped on user request
+func NewPlanActionSkippedEvent(apiObject APIObject, itemType, itemID, memberID, role string) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = core.EventTypeNormal
+	event.Reason = "Reconciliation Plan Action Skipped"
+	event.Message = fmt.Sprintf("A plan item %s of type %s for member %s with role %s has been skipped on request", itemID, itemType, memberID, role)
+	return event
+}
+
+// NewPlanActionSkipRejectedEvent creates an event indicating that the started plan item has not been skipped.
+func NewPlanActionSkipRejectedEvent(apiObject APIObject, itemType, itemID, memberID, role string) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = core.EventTypeWarning
+	event.Reason = "Reconciliation Plan Action Skip Rejected"
+	event.Message = fmt.Sprintf("A plan item %s of type %s for member %s with role %s is already started and has not been skipped", itemID, itemType, memberID, role)
+	return event
+}
+
 // NewCannotChangeStorageClassEvent creates an event indicating that an item would need to use a different StorageClass,
 // but this is not possible for the given reason.
 func NewCannotChangeStorageClassEvent(apiObject APIObject, memberID, role, subReason string) *Event {
@@ -271,6 +289,80 @@ func newDeploymentEvent(apiObject runtime.Object) *Event {
 	}
 }
 
+// NewChaosEvent creates an event indicating that the chaos monkey injected the scenario.
+func NewChaosEvent(scenario, target string, apiObject APIObject) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = core.EventTypeWarning
+	event.Reason = "Chaos"
+	event.Message = fmt.Sprintf("Chaos scenario %s injected on %s", scenario, target)
+	return event
+}
+
+// NewAutoscalingEvent creates an event indicating that the autoscaler changed the count of the group.
+func NewAutoscalingEvent(apiObject APIObject, role string, from, to int, reason string) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = core.EventTypeNormal
+	event.Reason = "Autoscaling"
+	event.Message = fmt.Sprintf("Count of %s changed from %d to %d: %s", role, from, to, reason)
+	return event
+}
+
+// NewAutoscalingBlockedEvent creates an event indicating that the autoscaler decision is blocked by the safety check.
+func NewAutoscalingBlockedEvent(apiObject APIObject, role string, from, to int, reason string) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = core.EventTypeWarning
+	event.Reason = "Autoscaling Blocked"
+	event.Message = fmt.Sprintf("Count of %s cannot be changed from %d to %d: %s", role, from, to, reason)
+	return event
+}
+
+// NewMemberUnschedulableEvent creates an event indicating that the pod of the member is not scheduled in time.
+func NewMemberUnschedulableEvent(apiObject APIObject, memberID, role, reason string) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = core.EventTypeWarning
+	event.Reason = fmt.Sprintf("%s Member Unschedulable", strings.Title(role))
+	event.Message = fmt.Sprintf("Pod of member %s with role %s is not scheduled: %s", memberID, role, reason)
+	return event
+}
+
+// NewMemberVolumeReleasedEvent creates an event indicating that the PVC of the member has been released,
+// so the replacement can be scheduled on another node.
+func NewMemberVolumeReleasedEvent(apiObject APIObject, memberID, role, pvcName string) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = core.EventTypeNormal
+	event.Reason = fmt.Sprintf("%s Member Volume Released", strings.Title(role))
+	event.Message = fmt.Sprintf("The persistent volume claim %s of member %s with role %s has been released, member data will be resynchronized", pvcName, memberID, role)
+	return event
+}
+
+// NewLocalStorageOvercommitEvent creates an event indicating that the PersistentVolume has not been created,
+// because it would overcommit the local paths.
+func NewLocalStorageOvercommitEvent(apiObject APIObject, pvcName, size string) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = core.EventTypeWarning
+	event.Reason = "Local Storage Overcommit"
+	event.Message = fmt.Sprintf("PersistentVolume of size %s for claim %s cannot be created, no local path has enough unallocated space", size, pvcName)
+	return event
+}
+
+// NewVolumeResizeSuccessfulEvent creates an event indicating that the volume bound to the PVC has been expanded.
+func NewVolumeResizeSuccessfulEvent(pvc runtime.Object, size string) *Event {
+	event := newDeploymentEvent(pvc)
+	event.Type = core.EventTypeNormal
+	event.Reason = "VolumeResizeSuccessful"
+	event.Message = fmt.Sprintf("Volume has been expanded to %s", size)
+	return event
+}
+
+// NewVolumeResizeFailedEvent creates an event indicating that the volume bound to the PVC cannot be expanded.
+func NewVolumeResizeFailedEvent(pvc runtime.Object, reason string) *Event {
+	event := newDeploymentEvent(pvc)
+	event.Type = core.EventTypeWarning
+	event.Reason = "VolumeResizeFailed"
+	event.Message = reason
+	return event
+}
+
 // NewOperatorEngineOpsAlertEvent creates an even of type OperatorEngineOpsAlert.
 func NewOperatorEngineOpsAlertEvent(reason string, apiObject APIObject) *Event {
 	event := newDeploymentEvent(apiObject)
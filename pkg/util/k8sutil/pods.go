@@ -280,6 +280,15 @@ func IsPodNotScheduledFor(pod *core.Pod, timeout time.Duration) bool {
 		condition.LastTransitionTime.Time.Add(timeout).Before(time.Now())
 }
 
+// GetPodNotScheduledMessage returns the scheduler message if the pod has not been scheduled.
+func GetPodNotScheduledMessage(pod *core.Pod) string {
+	condition := getPodCondition(&pod.Status, core.PodScheduled)
+	if condition == nil || condition.Status != core.ConditionFalse {
+		return ""
+	}
+	return condition.Message
+}
+
 // IsPodMarkedForDeletion returns true if the pod has been marked for deletion.
 func IsPodMarkedForDeletion(pod *core.Pod) bool {
 	return pod.DeletionTimestamp != nil
@@ -321,6 +330,16 @@ func AppendTLSKeyfileSecretPostfix(name string) string {
 	return fmt.Sprintf("%s-tls-keyfile", name)
 }
 
+// AppendTLSCertificatePostfix returns the name of the cert-manager Certificate extended with TLS certificate postfix.
+func AppendTLSCertificatePostfix(name string) string {
+	return fmt.Sprintf("%s-tls", name)
+}
+
+// AppendTLSCertificateSecretPostfix returns the name of the Secret issued by cert-manager extended with TLS certificate postfix.
+func AppendTLSCertificateSecretPostfix(name string) string {
+	return fmt.Sprintf("%s-tls-certificate", name)
+}
+
 // ArangodVolumeMount creates a volume mount structure for arangod.
 func ArangodVolumeMount() core.VolumeMount {
 	return core.VolumeMount{
@@ -631,6 +650,17 @@ func CreateVolumeEmptyDir(name string) core.Volume {
 	}
 }
 
+func CreateVolumeMemoryEmptyDir(name string) core.Volume {
+	return core.Volume{
+		Name: name,
+		VolumeSource: core.VolumeSource{
+			EmptyDir: &core.EmptyDirVolumeSource{
+				Medium: core.StorageMediumMemory,
+			},
+		},
+	}
+}
+
 func CreateVolumeWithSecret(name, secretName string) core.Volume {
 	return core.Volume{
 		Name: name,
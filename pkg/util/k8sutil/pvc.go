@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2025 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -63,6 +63,16 @@ func IsPersistentVolumeClaimResizing(pvc *core.PersistentVolumeClaim) bool {
 	return false
 }
 
+// GetPersistentVolumeClaimResizeError returns the message of the ControllerResizeError condition, if set to true
+func GetPersistentVolumeClaimResizeError(pvc *core.PersistentVolumeClaim) (string, bool) {
+	for _, c := range pvc.Status.Conditions {
+		if c.Type == core.PersistentVolumeClaimControllerResizeError && c.Status == core.ConditionTrue {
+			return c.Message, true
+		}
+	}
+	return "", false
+}
+
 // CreatePersistentVolumeClaim creates a persistent volume claim with given name and configuration.
 // If the pvc already exists, nil is returned.
 // If another error occurs, that error is returned.
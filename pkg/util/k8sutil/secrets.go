@@ -171,6 +171,16 @@ func GetKeyCertFromSecret(secret *core.Secret, certName, keyName string) (crypto
 	return cert, keys, nil
 }
 
+// GetCertsFromSecret loads PEM encoded certificates, without the private key, from the secret.
+func GetCertsFromSecret(secret *core.Secret, certName string) (crypto.Certificates, error) {
+	data, exists := secret.Data[certName]
+	if !exists {
+		return nil, errors.Errorf("Key %s missing in secret", certName)
+	}
+
+	return crypto.ParseCertificates(data)
+}
+
 // CreateCASecret creates a secret used to store a PEM encoded CA certificate & private key.
 func CreateCASecret(ctx context.Context, secrets generic.ModClient[*core.Secret], secretName string, certificate, key string,
 	ownerRef *meta.OwnerReference) error {
@@ -1,7 +1,7 @@
 //
 // DISCLAIMER
 //
-// Copyright 2016-2025 ArangoDB GmbH, Cologne, Germany
+// Copyright 2016-2026 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -26,6 +26,7 @@ import (
 	"github.com/dchest/uniuri"
 	monitoring "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
 	apiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 
@@ -186,19 +187,21 @@ type Client interface {
 	KubernetesExtensions() apiextensionsclient.Interface
 	Arango() versioned.Interface
 	Monitoring() monitoring.Interface
+	Dynamic() dynamic.Interface
 
 	Name() string
 
 	Config() *rest.Config
 }
 
-func NewStaticClient(config *rest.Config, kubernetes kubernetes.Interface, kubernetesExtensions apiextensionsclient.Interface, arango versioned.Interface, monitoring monitoring.Interface) Client {
+func NewStaticClient(config *rest.Config, kubernetes kubernetes.Interface, kubernetesExtensions apiextensionsclient.Interface, arango versioned.Interface, monitoring monitoring.Interface, dynamic dynamic.Interface) Client {
 	return &client{
 		name:                 "static",
 		kubernetes:           kubernetes,
 		kubernetesExtensions: kubernetesExtensions,
 		arango:               arango,
 		monitoring:           monitoring,
+		dynamic:              dynamic,
 		config:               config,
 	}
 }
@@ -237,6 +240,12 @@ func newClient(name string, cfg *rest.Config) (*client, error) {
 		c.monitoring = q
 	}
 
+	if q, err := dynamic.NewForConfig(cfg); err != nil {
+		return nil, err
+	} else {
+		c.dynamic = q
+	}
+
 	return &c, nil
 }
 
@@ -247,6 +256,7 @@ type client struct {
 	kubernetesExtensions apiextensionsclient.Interface
 	arango               versioned.Interface
 	monitoring           monitoring.Interface
+	dynamic              dynamic.Interface
 	config               *rest.Config
 }
 
@@ -273,3 +283,7 @@ func (c *client) Arango() versioned.Interface {
 func (c *client) Monitoring() monitoring.Interface {
 	return c.monitoring
 }
+
+func (c *client) Dynamic() dynamic.Interface {
+	return c.dynamic
+}
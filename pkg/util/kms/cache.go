@@ -0,0 +1,65 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package kms
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// WithVersionCache returns the provider which keeps the current version of the key-encryption key for the given TTL
+func WithVersionCache(provider Provider, ttl time.Duration) Provider {
+	return &versionCache{
+		Provider: provider,
+		ttl:      ttl,
+	}
+}
+
+type versionCache struct {
+	Provider
+
+	lock sync.Mutex
+
+	ttl time.Duration
+
+	version int
+	until   time.Time
+}
+
+func (v *versionCache) Version(ctx context.Context) (int, error) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if v.version > 0 && time.Now().Before(v.until) {
+		return v.version, nil
+	}
+
+	version, err := v.Provider.Version(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	v.version = version
+	v.until = time.Now().Add(v.ttl)
+
+	return version, nil
+}
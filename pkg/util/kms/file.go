@@ -0,0 +1,174 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package kms
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+const (
+	// FilePrefix is the prefix of the keys wrapped by the File provider
+	FilePrefix = "file"
+)
+
+// NewFileProvider returns the provider which wraps data keys with AES-256-GCM.
+// Keys map the version of the key-encryption key to the 32 bytes long key, the highest version is used to wrap data keys.
+func NewFileProvider(keys map[string][]byte) (Provider, error) {
+	p := fileProvider{
+		keys: map[int]cipher.AEAD{},
+	}
+
+	for name, key := range keys {
+		v, err := strconv.Atoi(name)
+		if err != nil || v <= 0 {
+			return nil, errors.Errorf("Invalid key-encryption key version: %s", name)
+		}
+
+		if len(key) != 32 {
+			return nil, errors.Errorf("Key-encryption key %s is expected to be 32 bytes long, found %d", name, len(key))
+		}
+
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Unable to create cipher for key-encryption key %s", name)
+		}
+
+		gcm, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Unable to create cipher for key-encryption key %s", name)
+		}
+
+		p.keys[v] = gcm
+
+		if v > p.current {
+			p.current = v
+		}
+	}
+
+	if p.current == 0 {
+		return nil, errors.Errorf("Key-encryption keys are missing")
+	}
+
+	return p, nil
+}
+
+// NewFileProviderFromDirectory returns the File provider with the key-encryption keys loaded from the directory.
+// Each file name is the version of the key, hidden files (like the ones created by the Secret volume) are ignored.
+func NewFileProviderFromDirectory(path string) (Provider, error) {
+	files, err := os.ReadDir(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to read key-encryption keys directory")
+	}
+
+	keys := map[string][]byte{}
+
+	for _, f := range files {
+		if f.IsDir() || strings.HasPrefix(f.Name(), ".") {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(path, f.Name()))
+		if err != nil {
+			return nil, errors.Wrapf(err, "Unable to read key-encryption key %s", f.Name())
+		}
+
+		keys[f.Name()] = data
+	}
+
+	return NewFileProvider(keys)
+}
+
+type fileProvider struct {
+	current int
+
+	keys map[int]cipher.AEAD
+}
+
+func (f fileProvider) Wrap(ctx context.Context, key []byte) (string, error) {
+	return f.wrap(f.current, key)
+}
+
+func (f fileProvider) Unwrap(ctx context.Context, wrapped string) ([]byte, error) {
+	v, err := WrappedKeyVersion(wrapped)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.HasPrefix(wrapped, FilePrefix+":") {
+		return nil, errors.Errorf("Key is not wrapped by the %s provider", FilePrefix)
+	}
+
+	gcm, ok := f.keys[v]
+	if !ok {
+		return nil, errors.Errorf("Key-encryption key in version %d is missing", v)
+	}
+
+	data, err := base64.StdEncoding.DecodeString(strings.SplitN(wrapped, ":", 3)[2])
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to decode wrapped key")
+	}
+
+	if len(data) < gcm.NonceSize() {
+		return nil, errors.Errorf("Wrapped key is too short")
+	}
+
+	key, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to unwrap key")
+	}
+
+	return key, nil
+}
+
+func (f fileProvider) Rewrap(ctx context.Context, wrapped string) (string, error) {
+	key, err := f.Unwrap(ctx, wrapped)
+	if err != nil {
+		return "", err
+	}
+
+	return f.wrap(f.current, key)
+}
+
+func (f fileProvider) Version(ctx context.Context) (int, error) {
+	return f.current, nil
+}
+
+func (f fileProvider) wrap(version int, key []byte) (string, error) {
+	gcm := f.keys[version]
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", errors.Wrapf(err, "Unable to generate nonce")
+	}
+
+	return fmt.Sprintf("%s:v%d:%s", FilePrefix, version, base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, key, nil))), nil
+}
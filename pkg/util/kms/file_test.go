@@ -0,0 +1,115 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package kms
+
+import (
+	"context"
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newKey(t *testing.T) []byte {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return key
+}
+
+func Test_FileProvider(t *testing.T) {
+	ctx := context.Background()
+
+	kek1, kek2, dek := newKey(t), newKey(t), newKey(t)
+
+	p1, err := NewFileProvider(map[string][]byte{"1": kek1})
+	require.NoError(t, err)
+
+	wrapped, err := p1.Wrap(ctx, dek)
+	require.NoError(t, err)
+
+	v, err := WrappedKeyVersion(wrapped)
+	require.NoError(t, err)
+	require.Equal(t, 1, v)
+
+	key, err := p1.Unwrap(ctx, wrapped)
+	require.NoError(t, err)
+	require.Equal(t, dek, key)
+
+	t.Run("Rotate key-encryption key", func(t *testing.T) {
+		p2, err := NewFileProvider(map[string][]byte{"1": kek1, "2": kek2})
+		require.NoError(t, err)
+
+		required, err := RewrapRequired(ctx, p2, wrapped)
+		require.NoError(t, err)
+		require.True(t, required)
+
+		rewrapped, err := p2.Rewrap(ctx, wrapped)
+		require.NoError(t, err)
+
+		v, err := WrappedKeyVersion(rewrapped)
+		require.NoError(t, err)
+		require.Equal(t, 2, v)
+
+		required, err = RewrapRequired(ctx, p2, rewrapped)
+		require.NoError(t, err)
+		require.False(t, required)
+
+		key, err := p2.Unwrap(ctx, rewrapped)
+		require.NoError(t, err)
+		require.Equal(t, dek, key)
+
+		_, err = p1.Unwrap(ctx, rewrapped)
+		require.Error(t, err)
+	})
+
+	t.Run("Directory", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "1"), kek1, 0600))
+		require.NoError(t, os.Mkdir(filepath.Join(dir, "..data"), 0700))
+
+		p, err := NewFileProviderFromDirectory(dir)
+		require.NoError(t, err)
+
+		key, err := p.Unwrap(ctx, wrapped)
+		require.NoError(t, err)
+		require.Equal(t, dek, key)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := NewFileProvider(nil)
+		require.Error(t, err)
+
+		_, err = NewFileProvider(map[string][]byte{"latest": kek1})
+		require.Error(t, err)
+
+		_, err = NewFileProvider(map[string][]byte{"1": []byte("short")})
+		require.Error(t, err)
+
+		_, err = p1.Unwrap(ctx, "vault:v1:data")
+		require.Error(t, err)
+
+		_, err = WrappedKeyVersion("file:1:data")
+		require.Error(t, err)
+	})
+}
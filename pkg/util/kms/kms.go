@@ -0,0 +1,80 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package kms
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// Provider wraps and unwraps data keys with the key-encryption key managed by the KMS.
+// Wrapped keys use the `<prefix>:v<version>:<ciphertext>` format, where version is the version of the key-encryption key.
+type Provider interface {
+	// Wrap encrypts the data key with the current version of the key-encryption key
+	Wrap(ctx context.Context, key []byte) (string, error)
+
+	// Unwrap decrypts the wrapped data key
+	Unwrap(ctx context.Context, wrapped string) ([]byte, error)
+
+	// Rewrap encrypts the wrapped data key with the current version of the key-encryption key.
+	// The data key itself does not change.
+	Rewrap(ctx context.Context, wrapped string) (string, error)
+
+	// Version returns the current version of the key-encryption key
+	Version(ctx context.Context) (int, error)
+}
+
+// WrappedKeyVersion returns the version of the key-encryption key used to wrap the data key
+func WrappedKeyVersion(wrapped string) (int, error) {
+	parts := strings.SplitN(strings.TrimSpace(wrapped), ":", 3)
+	if len(parts) != 3 {
+		return 0, errors.Errorf("Invalid wrapped key format")
+	}
+
+	if !strings.HasPrefix(parts[1], "v") {
+		return 0, errors.Errorf("Invalid wrapped key version: %s", parts[1])
+	}
+
+	v, err := strconv.Atoi(strings.TrimPrefix(parts[1], "v"))
+	if err != nil || v <= 0 {
+		return 0, errors.Errorf("Invalid wrapped key version: %s", parts[1])
+	}
+
+	return v, nil
+}
+
+// RewrapRequired returns true when the data key is not wrapped with the current version of the key-encryption key
+func RewrapRequired(ctx context.Context, provider Provider, wrapped string) (bool, error) {
+	current, err := provider.Version(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	v, err := WrappedKeyVersion(wrapped)
+	if err != nil {
+		return false, err
+	}
+
+	return v < current, nil
+}
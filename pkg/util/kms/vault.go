@@ -0,0 +1,192 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package kms
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	goHttp "net/http"
+	"net/url"
+	"strings"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	operatorHTTP "github.com/arangodb/kube-arangodb/pkg/util/http"
+)
+
+const (
+	// VaultTokenHeader is the header with the Vault token
+	VaultTokenHeader = "X-Vault-Token"
+	// VaultNamespaceHeader is the header with the Vault Enterprise namespace
+	VaultNamespaceHeader = "X-Vault-Namespace"
+
+	// DefaultVaultMountPath is the default mount path of the Transit secrets engine
+	DefaultVaultMountPath = "transit"
+)
+
+// VaultConfig defines the connection to the HashiCorp Vault Transit secrets engine
+type VaultConfig struct {
+	// Address of the Vault server
+	Address string
+	// MountPath of the Transit secrets engine
+	MountPath string
+	// KeyName of the Transit key
+	KeyName string
+	// Namespace of Vault Enterprise, optional
+	Namespace string
+	// Token used to authenticate requests
+	Token string
+	// CA in PEM format used to verify the Vault server, optional
+	CA []byte
+}
+
+// NewVaultProvider returns the provider which wraps data keys with the HashiCorp Vault Transit secrets engine
+func NewVaultProvider(cfg VaultConfig) (Provider, error) {
+	if cfg.Address == "" {
+		return nil, errors.Errorf("Vault address cannot be empty")
+	}
+
+	if cfg.KeyName == "" {
+		return nil, errors.Errorf("Vault key name cannot be empty")
+	}
+
+	if cfg.Token == "" {
+		return nil, errors.Errorf("Vault token cannot be empty")
+	}
+
+	if _, err := url.Parse(cfg.Address); err != nil {
+		return nil, errors.Wrapf(err, "Invalid Vault address")
+	}
+
+	if cfg.MountPath == "" {
+		cfg.MountPath = DefaultVaultMountPath
+	}
+
+	var transport []util.Mod[goHttp.Transport]
+
+	if len(cfg.CA) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(cfg.CA) {
+			return nil, errors.Errorf("Unable to load Vault CA")
+		}
+
+		transport = append(transport, operatorHTTP.WithTransportTLS(operatorHTTP.WithRootCA(pool)))
+	}
+
+	return vaultProvider{
+		config: cfg,
+		client: operatorHTTP.NewHTTPClient(func(in *goHttp.Client) {
+			in.Transport = operatorHTTP.RoundTripper(transport...)
+		}),
+	}, nil
+}
+
+type vaultProvider struct {
+	config VaultConfig
+
+	client operatorHTTP.HTTPClient
+}
+
+type vaultRequest struct {
+	Plaintext  string `json:"plaintext,omitempty"`
+	Ciphertext string `json:"ciphertext,omitempty"`
+}
+
+type vaultResponse[T any] struct {
+	Data T `json:"data"`
+}
+
+type vaultCipherData struct {
+	Plaintext  string `json:"plaintext,omitempty"`
+	Ciphertext string `json:"ciphertext,omitempty"`
+}
+
+type vaultKeyData struct {
+	LatestVersion int `json:"latest_version"`
+}
+
+func (v vaultProvider) Wrap(ctx context.Context, key []byte) (string, error) {
+	resp, err := v.post(ctx, "encrypt", vaultRequest{Plaintext: base64.StdEncoding.EncodeToString(key)})
+	if err != nil {
+		return "", errors.Wrapf(err, "Unable to wrap key")
+	}
+
+	return resp.Ciphertext, nil
+}
+
+func (v vaultProvider) Unwrap(ctx context.Context, wrapped string) ([]byte, error) {
+	resp, err := v.post(ctx, "decrypt", vaultRequest{Ciphertext: strings.TrimSpace(wrapped)})
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to unwrap key")
+	}
+
+	key, err := base64.StdEncoding.DecodeString(resp.Plaintext)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to decode unwrapped key")
+	}
+
+	return key, nil
+}
+
+func (v vaultProvider) Rewrap(ctx context.Context, wrapped string) (string, error) {
+	resp, err := v.post(ctx, "rewrap", vaultRequest{Ciphertext: strings.TrimSpace(wrapped)})
+	if err != nil {
+		return "", errors.Wrapf(err, "Unable to rewrap key")
+	}
+
+	return resp.Ciphertext, nil
+}
+
+func (v vaultProvider) Version(ctx context.Context) (int, error) {
+	resp, err := operatorHTTP.Get[vaultResponse[vaultKeyData], *operatorHTTP.DataError](ctx, v.client, v.url("keys"), v.authenticate).WithCode(goHttp.StatusOK).Get()
+	if err != nil {
+		return 0, errors.Wrapf(err, "Unable to fetch key version")
+	}
+
+	if resp.Data.LatestVersion <= 0 {
+		return 0, errors.Errorf("Invalid key version: %d", resp.Data.LatestVersion)
+	}
+
+	return resp.Data.LatestVersion, nil
+}
+
+func (v vaultProvider) post(ctx context.Context, operation string, req vaultRequest) (vaultCipherData, error) {
+	resp, err := operatorHTTP.Post[vaultRequest, vaultResponse[vaultCipherData], *operatorHTTP.DataError](ctx, v.client, req, v.url(operation), v.authenticate).WithCode(goHttp.StatusOK).Get()
+	if err != nil {
+		return vaultCipherData{}, err
+	}
+
+	return resp.Data, nil
+}
+
+func (v vaultProvider) url(operation string) string {
+	return fmt.Sprintf("%s/v1/%s/%s/%s", strings.TrimSuffix(v.config.Address, "/"), strings.Trim(v.config.MountPath, "/"), operation, url.PathEscape(v.config.KeyName))
+}
+
+func (v vaultProvider) authenticate(in *goHttp.Request) {
+	in.Header.Set(VaultTokenHeader, v.config.Token)
+	if v.config.Namespace != "" {
+		in.Header.Set(VaultNamespaceHeader, v.config.Namespace)
+	}
+	in.Header.Set("Content-Type", "application/json")
+}
@@ -0,0 +1,184 @@
+//
+// DISCLAIMER
+//
+// Copyright 2026 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package kms
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	goHttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// vaultTransit emulates the Vault Transit secrets engine with the File provider
+type vaultTransit struct {
+	t *testing.T
+
+	token, namespace string
+
+	keys map[string][]byte
+}
+
+func (v *vaultTransit) provider() Provider {
+	p, err := NewFileProvider(v.keys)
+	require.NoError(v.t, err)
+	return p
+}
+
+func (v *vaultTransit) ServeHTTP(w goHttp.ResponseWriter, r *goHttp.Request) {
+	if r.Header.Get(VaultTokenHeader) != v.token || r.Header.Get(VaultNamespaceHeader) != v.namespace {
+		w.WriteHeader(goHttp.StatusForbidden)
+		return
+	}
+
+	var req vaultRequest
+	if r.Method == goHttp.MethodPost {
+		require.NoError(v.t, json.NewDecoder(r.Body).Decode(&req))
+	}
+
+	ctx := r.Context()
+	p := v.provider()
+
+	toVault := func(s string) string {
+		return "vault" + strings.TrimPrefix(s, FilePrefix)
+	}
+	fromVault := func(s string) string {
+		return FilePrefix + strings.TrimPrefix(s, "vault")
+	}
+
+	var data any
+
+	switch r.URL.Path {
+	case "/v1/transit/encrypt/arangodb":
+		key, err := base64.StdEncoding.DecodeString(req.Plaintext)
+		require.NoError(v.t, err)
+		c, err := p.Wrap(ctx, key)
+		require.NoError(v.t, err)
+		data = vaultCipherData{Ciphertext: toVault(c)}
+	case "/v1/transit/decrypt/arangodb":
+		key, err := p.Unwrap(ctx, fromVault(req.Ciphertext))
+		if err != nil {
+			w.WriteHeader(goHttp.StatusBadRequest)
+			return
+		}
+		data = vaultCipherData{Plaintext: base64.StdEncoding.EncodeToString(key)}
+	case "/v1/transit/rewrap/arangodb":
+		c, err := p.Rewrap(ctx, fromVault(req.Ciphertext))
+		require.NoError(v.t, err)
+		data = vaultCipherData{Ciphertext: toVault(c)}
+	case "/v1/transit/keys/arangodb":
+		version, err := p.Version(ctx)
+		require.NoError(v.t, err)
+		data = vaultKeyData{LatestVersion: version}
+	default:
+		w.WriteHeader(goHttp.StatusNotFound)
+		return
+	}
+
+	require.NoError(v.t, json.NewEncoder(w).Encode(map[string]any{"data": data}))
+}
+
+func Test_VaultProvider(t *testing.T) {
+	ctx := context.Background()
+
+	transit := &vaultTransit{
+		t:         t,
+		token:     "secret",
+		namespace: "admin",
+		keys:      map[string][]byte{"1": newKey(t)},
+	}
+
+	server := httptest.NewServer(transit)
+	defer server.Close()
+
+	p, err := NewVaultProvider(VaultConfig{
+		Address:   server.URL,
+		KeyName:   "arangodb",
+		Namespace: "admin",
+		Token:     "secret",
+	})
+	require.NoError(t, err)
+
+	dek := newKey(t)
+
+	wrapped, err := p.Wrap(ctx, dek)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(wrapped, "vault:v1:"))
+
+	key, err := p.Unwrap(ctx, wrapped)
+	require.NoError(t, err)
+	require.Equal(t, dek, key)
+
+	cached := WithVersionCache(p, time.Hour)
+
+	v, err := cached.Version(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, v)
+
+	t.Run("Rotate key-encryption key", func(t *testing.T) {
+		transit.keys["2"] = newKey(t)
+
+		v, err := cached.Version(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, v)
+
+		required, err := RewrapRequired(ctx, p, wrapped)
+		require.NoError(t, err)
+		require.True(t, required)
+
+		rewrapped, err := p.Rewrap(ctx, wrapped)
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(rewrapped, "vault:v2:"))
+
+		key, err := p.Unwrap(ctx, rewrapped)
+		require.NoError(t, err)
+		require.Equal(t, dek, key)
+	})
+
+	t.Run("Invalid token", func(t *testing.T) {
+		p, err := NewVaultProvider(VaultConfig{
+			Address:   server.URL,
+			KeyName:   "arangodb",
+			Namespace: "admin",
+			Token:     "invalid",
+		})
+		require.NoError(t, err)
+
+		_, err = p.Unwrap(ctx, wrapped)
+		require.Error(t, err)
+
+		_, err = p.Version(ctx)
+		require.Error(t, err)
+	})
+
+	t.Run("Invalid config", func(t *testing.T) {
+		_, err := NewVaultProvider(VaultConfig{Address: server.URL, KeyName: "arangodb"})
+		require.Error(t, err)
+
+		_, err = NewVaultProvider(VaultConfig{Address: server.URL, Token: "secret", KeyName: "arangodb", CA: []byte("invalid")})
+		require.Error(t, err)
+	})
+}